@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/account.ban
 func (vk *VK) AccountBan(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.ban", params, &response)
+	return vk.AccountBanContext(context.Background(), params)
+}
+
+// AccountBanContext is the same as AccountBan, but takes a context.
+func (vk *VK) AccountBanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.ban", params, &response)
 	return
 }
 
@@ -21,7 +28,12 @@ type AccountChangePasswordResponse struct {
 //
 // https://vk.com/dev/account.changePassword
 func (vk *VK) AccountChangePassword(params Params) (response AccountChangePasswordResponse, err error) {
-	err = vk.RequestUnmarshal("account.changePassword", params, &response)
+	return vk.AccountChangePasswordContext(context.Background(), params)
+}
+
+// AccountChangePasswordContext is the same as AccountChangePassword, but takes a context.
+func (vk *VK) AccountChangePasswordContext(ctx context.Context, params Params) (response AccountChangePasswordResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.changePassword", params, &response)
 	return
 }
 
@@ -37,7 +49,12 @@ type AccountGetActiveOffersResponse struct {
 //
 // https://vk.com/dev/account.getActiveOffers
 func (vk *VK) AccountGetActiveOffers(params Params) (response AccountGetActiveOffersResponse, err error) {
-	err = vk.RequestUnmarshal("account.getActiveOffers", params, &response)
+	return vk.AccountGetActiveOffersContext(context.Background(), params)
+}
+
+// AccountGetActiveOffersContext is the same as AccountGetActiveOffers, but takes a context.
+func (vk *VK) AccountGetActiveOffersContext(ctx context.Context, params Params) (response AccountGetActiveOffersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getActiveOffers", params, &response)
 	return
 }
 
@@ -45,7 +62,12 @@ func (vk *VK) AccountGetActiveOffers(params Params) (response AccountGetActiveOf
 //
 // https://vk.com/dev/account.getAppPermissions
 func (vk *VK) AccountGetAppPermissions(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.getAppPermissions", params, &response)
+	return vk.AccountGetAppPermissionsContext(context.Background(), params)
+}
+
+// AccountGetAppPermissionsContext is the same as AccountGetAppPermissions, but takes a context.
+func (vk *VK) AccountGetAppPermissionsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getAppPermissions", params, &response)
 	return
 }
 
@@ -60,7 +82,12 @@ type AccountGetBannedResponse struct {
 //
 // https://vk.com/dev/account.getBanned
 func (vk *VK) AccountGetBanned(params Params) (response AccountGetBannedResponse, err error) {
-	err = vk.RequestUnmarshal("account.getBanned", params, &response)
+	return vk.AccountGetBannedContext(context.Background(), params)
+}
+
+// AccountGetBannedContext is the same as AccountGetBanned, but takes a context.
+func (vk *VK) AccountGetBannedContext(ctx context.Context, params Params) (response AccountGetBannedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getBanned", params, &response)
 	return
 }
 
@@ -71,7 +98,12 @@ type AccountGetCountersResponse object.AccountAccountCounters
 //
 // https://vk.com/dev/account.getCounters
 func (vk *VK) AccountGetCounters(params Params) (response AccountGetCountersResponse, err error) {
-	err = vk.RequestUnmarshal("account.getCounters", params, &response)
+	return vk.AccountGetCountersContext(context.Background(), params)
+}
+
+// AccountGetCountersContext is the same as AccountGetCounters, but takes a context.
+func (vk *VK) AccountGetCountersContext(ctx context.Context, params Params) (response AccountGetCountersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getCounters", params, &response)
 	return
 }
 
@@ -82,7 +114,12 @@ type AccountGetInfoResponse object.AccountInfo
 //
 // https://vk.com/dev/account.getInfo
 func (vk *VK) AccountGetInfo(params Params) (response AccountGetInfoResponse, err error) {
-	err = vk.RequestUnmarshal("account.getInfo", params, &response)
+	return vk.AccountGetInfoContext(context.Background(), params)
+}
+
+// AccountGetInfoContext is the same as AccountGetInfo, but takes a context.
+func (vk *VK) AccountGetInfoContext(ctx context.Context, params Params) (response AccountGetInfoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getInfo", params, &response)
 	return
 }
 
@@ -93,7 +130,12 @@ type AccountGetProfileInfoResponse object.AccountUserSettings
 //
 // https://vk.com/dev/account.getProfileInfo
 func (vk *VK) AccountGetProfileInfo(params Params) (response AccountGetProfileInfoResponse, err error) {
-	err = vk.RequestUnmarshal("account.getProfileInfo", params, &response)
+	return vk.AccountGetProfileInfoContext(context.Background(), params)
+}
+
+// AccountGetProfileInfoContext is the same as AccountGetProfileInfo, but takes a context.
+func (vk *VK) AccountGetProfileInfoContext(ctx context.Context, params Params) (response AccountGetProfileInfoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getProfileInfo", params, &response)
 	return
 }
 
@@ -104,7 +146,12 @@ type AccountGetPushSettingsResponse object.AccountPushSettings
 //
 // https://vk.com/dev/account.getPushSettings
 func (vk *VK) AccountGetPushSettings(params Params) (response AccountGetPushSettingsResponse, err error) {
-	err = vk.RequestUnmarshal("account.getPushSettings", params, &response)
+	return vk.AccountGetPushSettingsContext(context.Background(), params)
+}
+
+// AccountGetPushSettingsContext is the same as AccountGetPushSettings, but takes a context.
+func (vk *VK) AccountGetPushSettingsContext(ctx context.Context, params Params) (response AccountGetPushSettingsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.getPushSettings", params, &response)
 	return
 }
 
@@ -112,7 +159,12 @@ func (vk *VK) AccountGetPushSettings(params Params) (response AccountGetPushSett
 //
 // https://vk.com/dev/account.registerDevice
 func (vk *VK) AccountRegisterDevice(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.registerDevice", params, &response)
+	return vk.AccountRegisterDeviceContext(context.Background(), params)
+}
+
+// AccountRegisterDeviceContext is the same as AccountRegisterDevice, but takes a context.
+func (vk *VK) AccountRegisterDeviceContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.registerDevice", params, &response)
 	return
 }
 
@@ -126,7 +178,12 @@ type AccountSaveProfileInfoResponse struct {
 //
 // https://vk.com/dev/account.saveProfileInfo
 func (vk *VK) AccountSaveProfileInfo(params Params) (response AccountSaveProfileInfoResponse, err error) {
-	err = vk.RequestUnmarshal("account.saveProfileInfo", params, &response)
+	return vk.AccountSaveProfileInfoContext(context.Background(), params)
+}
+
+// AccountSaveProfileInfoContext is the same as AccountSaveProfileInfo, but takes a context.
+func (vk *VK) AccountSaveProfileInfoContext(ctx context.Context, params Params) (response AccountSaveProfileInfoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.saveProfileInfo", params, &response)
 	return
 }
 
@@ -134,7 +191,12 @@ func (vk *VK) AccountSaveProfileInfo(params Params) (response AccountSaveProfile
 //
 // https://vk.com/dev/account.setInfo
 func (vk *VK) AccountSetInfo(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setInfo", params, &response)
+	return vk.AccountSetInfoContext(context.Background(), params)
+}
+
+// AccountSetInfoContext is the same as AccountSetInfo, but takes a context.
+func (vk *VK) AccountSetInfoContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setInfo", params, &response)
 	return
 }
 
@@ -143,7 +205,12 @@ func (vk *VK) AccountSetInfo(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.setNameInMenu
 func (vk *VK) AccountSetNameInMenu(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setNameInMenu", params, &response)
+	return vk.AccountSetNameInMenuContext(context.Background(), params)
+}
+
+// AccountSetNameInMenuContext is the same as AccountSetNameInMenu, but takes a context.
+func (vk *VK) AccountSetNameInMenuContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setNameInMenu", params, &response)
 	return
 }
 
@@ -151,7 +218,12 @@ func (vk *VK) AccountSetNameInMenu(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.setOffline
 func (vk *VK) AccountSetOffline(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setOffline", params, &response)
+	return vk.AccountSetOfflineContext(context.Background(), params)
+}
+
+// AccountSetOfflineContext is the same as AccountSetOffline, but takes a context.
+func (vk *VK) AccountSetOfflineContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setOffline", params, &response)
 	return
 }
 
@@ -159,7 +231,12 @@ func (vk *VK) AccountSetOffline(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.setOnline
 func (vk *VK) AccountSetOnline(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setOnline", params, &response)
+	return vk.AccountSetOnlineContext(context.Background(), params)
+}
+
+// AccountSetOnlineContext is the same as AccountSetOnline, but takes a context.
+func (vk *VK) AccountSetOnlineContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setOnline", params, &response)
 	return
 }
 
@@ -167,7 +244,12 @@ func (vk *VK) AccountSetOnline(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.setPushSettings
 func (vk *VK) AccountSetPushSettings(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setPushSettings", params, &response)
+	return vk.AccountSetPushSettingsContext(context.Background(), params)
+}
+
+// AccountSetPushSettingsContext is the same as AccountSetPushSettings, but takes a context.
+func (vk *VK) AccountSetPushSettingsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setPushSettings", params, &response)
 	return
 }
 
@@ -175,7 +257,12 @@ func (vk *VK) AccountSetPushSettings(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.setSilenceMode
 func (vk *VK) AccountSetSilenceMode(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.setSilenceMode", params, &response)
+	return vk.AccountSetSilenceModeContext(context.Background(), params)
+}
+
+// AccountSetSilenceModeContext is the same as AccountSetSilenceMode, but takes a context.
+func (vk *VK) AccountSetSilenceModeContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.setSilenceMode", params, &response)
 	return
 }
 
@@ -183,7 +270,12 @@ func (vk *VK) AccountSetSilenceMode(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.unban
 func (vk *VK) AccountUnban(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.unban", params, &response)
+	return vk.AccountUnbanContext(context.Background(), params)
+}
+
+// AccountUnbanContext is the same as AccountUnban, but takes a context.
+func (vk *VK) AccountUnbanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.unban", params, &response)
 	return
 }
 
@@ -191,6 +283,11 @@ func (vk *VK) AccountUnban(params Params) (response int, err error) {
 //
 // https://vk.com/dev/account.unregisterDevice
 func (vk *VK) AccountUnregisterDevice(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("account.unregisterDevice", params, &response)
+	return vk.AccountUnregisterDeviceContext(context.Background(), params)
+}
+
+// AccountUnregisterDeviceContext is the same as AccountUnregisterDevice, but takes a context.
+func (vk *VK) AccountUnregisterDeviceContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "account.unregisterDevice", params, &response)
 	return
 }
@@ -7,6 +7,7 @@ package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"mime"
@@ -14,7 +15,6 @@ import (
 	"net/url"
 	"reflect"
 	"sync"
-	"time"
 
 	"github.com/SevereCloud/vksdk/api/errors"
 	"github.com/SevereCloud/vksdk/internal"
@@ -78,6 +78,9 @@ const (
 	LimitGroupToken = 20
 )
 
+// HandlerFunc provides access to VK API methods with a context.
+type HandlerFunc func(ctx context.Context, method string, params Params) (Response, error)
+
 // VK struct.
 type VK struct {
 	MethodURL    string
@@ -85,14 +88,44 @@ type VK struct {
 	Version      string
 	Client       *http.Client
 	IsPoolClient bool
-	Limit        int
 	UserAgent    string
-	Handler      func(method string, params Params) (Response, error)
 
-	tokenPool internal.TokenPool
-	mux       sync.Mutex
-	lastTime  time.Time
-	rps       int
+	// Limit is the number of requests per second of regular methods for
+	// each access token, not for the whole VK. Zero or less disables only
+	// this limit: secure and ads methods are still limited to LimitSecure
+	// and LimitAds per second. Set RateLimiter to change them.
+	Limit int
+
+	// RetryPolicy determines which failed requests are repeated.
+	// If nil, only errors.TooMany is repeated up to Limit times.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter limits the rate of requests. If nil, the token bucket
+	// with Limit requests per second for each access token is used.
+	RateLimiter RateLimiter
+
+	// Handler is called by the default HandlerContext if it is replaced.
+	// It can also wrap the previous Handler.
+	//
+	// Deprecated: use HandlerContext.
+	Handler func(method string, params Params) (Response, error)
+
+	// HandlerContext is called by RequestContext and by all API methods.
+	HandlerContext HandlerFunc
+
+	// TokenPool is used instead of AccessToken if IsPoolClient is true.
+	TokenPool *TokenPool
+
+	// CaptchaSolver is called on errors.Captcha. If the captcha is solved,
+	// the request is repeated with captcha_sid and captcha_key.
+	CaptchaSolver CaptchaSolver
+
+	// ConfirmationHandler is called on errors.NeedConfirmation. If the
+	// action is confirmed, the request is repeated with confirm=1.
+	ConfirmationHandler ConfirmationHandler
+
+	limiter *TokenBucketLimiter
+	mux     sync.Mutex
 }
 
 // Error struct VK.
@@ -133,6 +166,7 @@ func NewVK(token string) *VK {
 	vk.Version = Version
 
 	vk.Handler = vk.defaultHandler
+	vk.HandlerContext = vk.defaultHandlerContext
 
 	// TODO: remove in v2
 	vk.MethodURL = APIMethodURL
@@ -146,10 +180,12 @@ func NewVK(token string) *VK {
 
 // NewVKWithPool is similar to NewVK but uses token pool for api calls.
 // Use this if you need to increase RPS limit.
+//
+// Limit is applied to each token, so the pool makes up to
+// LimitGroupToken * len(tokens) requests per second.
 func NewVKWithPool(tokens ...string) *VK {
 	vk := NewVK("pool")
-	vk.tokenPool = internal.NewTokenPool(tokens...)
-	vk.Limit = LimitGroupToken * len(tokens)
+	vk.TokenPool = NewTokenPool(tokens...)
 	vk.IsPoolClient = true
 
 	return vk
@@ -210,7 +246,67 @@ func (p Params) Confirm(v bool) Params {
 }
 
 // defaultHandler provides access to VK API methods.
+//
+// It calls handle directly, so VK.Handler can be wrapped by a function
+// which calls the previous VK.Handler.
 func (vk *VK) defaultHandler(method string, params Params) (Response, error) {
+	return vk.handle(context.Background(), method, params)
+}
+
+// wait blocks until the rate limit allows the next request
+// or the context is done.
+func (vk *VK) wait(ctx context.Context, token, method string) error {
+	if vk.RateLimiter != nil {
+		return vk.RateLimiter.Wait(ctx, token, method)
+	}
+
+	vk.mux.Lock()
+
+	if vk.limiter == nil {
+		vk.limiter = NewTokenBucketLimiter(vk.Limit)
+	}
+
+	limiter := vk.limiter
+
+	vk.mux.Unlock()
+
+	// Limit can be changed after NewVK. Zero or less disables only
+	// the limit of regular methods.
+	if rate := limiter.Rate(FamilyRegular); rate.PerSecond != float64(vk.Limit) {
+		rate.PerSecond = float64(vk.Limit)
+		limiter.SetRate(FamilyRegular, rate)
+	}
+
+	return limiter.Wait(ctx, token, method)
+}
+
+// customHandler reports whether VK.Handler is replaced by the user.
+func (vk *VK) customHandler() bool {
+	if vk.Handler == nil {
+		return false
+	}
+
+	return reflect.ValueOf(vk.Handler).Pointer() != reflect.ValueOf(vk.defaultHandler).Pointer()
+}
+
+// defaultHandlerContext provides access to VK API methods.
+//
+// If VK.Handler is replaced, requests are passed to it without a context.
+func (vk *VK) defaultHandlerContext(ctx context.Context, method string, params Params) (Response, error) {
+	if vk.customHandler() {
+		return vk.Handler(method, params)
+	}
+
+	return vk.handle(ctx, method, params)
+}
+
+// handle sends the request to VK.
+//
+// Failed requests are repeated according to VK.RetryPolicy. Captchas and
+// confirmations are resolved by VK.CaptchaSolver and VK.ConfirmationHandler
+// and do not count as attempts. Requests failed with errors.Auth are
+// repeated with another token of VK.TokenPool.
+func (vk *VK) handle(ctx context.Context, method string, params Params) (Response, error) {
 	u := vk.MethodURL + method
 	query := url.Values{}
 
@@ -218,83 +314,167 @@ func (vk *VK) defaultHandler(method string, params Params) (Response, error) {
 		query.Set(key, FmtValue(value, 0))
 	}
 
-	attempt := 0
+	ctx = context.WithValue(ctx, internal.HTTPClientKey, vk.Client)
+	ctx = context.WithValue(ctx, internal.UserAgentKey, vk.UserAgent)
 
-	for {
-		var response Response
-
-		attempt++
+	token := query.Get("access_token")
+	challenges := 0
+	failovers := 0
 
+	for attempt := 1; ; attempt++ {
 		// Rate limiting
-		if vk.Limit > 0 {
-			vk.mux.Lock()
-
-			sleepTime := time.Second - time.Since(vk.lastTime)
-			if sleepTime < 0 {
-				vk.lastTime = time.Now()
-				vk.rps = 0
-			} else if vk.rps == vk.Limit {
-				time.Sleep(sleepTime)
-				vk.lastTime = time.Now()
-				vk.rps = 0
-			}
-			vk.rps++
+		err := vk.wait(ctx, token, method)
+		if err != nil {
+			return Response{}, err
+		}
 
-			vk.mux.Unlock()
+		response, err := vk.do(ctx, u, query)
+
+		if vk.TokenPool != nil {
+			vk.TokenPool.Report(token, err)
 		}
 
-		rawBody := bytes.NewBufferString(query.Encode())
+		if err != nil && challenges < maxChallenges {
+			repeat, challengeErr := vk.resolveChallenge(ctx, query, err)
+			if challengeErr != nil {
+				return response, challengeErr
+			}
+
+			if repeat {
+				challenges++
+				attempt--
 
-		req, err := http.NewRequest("POST", u, rawBody)
-		if err != nil {
-			return response, err
+				continue
+			}
 		}
 
-		req.Header.Set("User-Agent", vk.UserAgent)
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		// The token is revoked, so the request is rejected by VK and can be
+		// repeated with another token from the pool.
+		if errors.GetType(err) == errors.Auth && vk.TokenPool != nil &&
+			vk.TokenPool.Has(token) && failovers < len(vk.TokenPool.Stats()) {
+			if next, poolErr := vk.TokenPool.Get(); poolErr == nil && next != token {
+				failovers++
+				attempt--
+				token = next
+				query.Set("access_token", token)
 
-		resp, err := vk.Client.Do(req)
-		if err != nil {
+				continue
+			}
+		}
+
+		if !vk.shouldRetry(attempt, method, params, err) {
 			return response, err
 		}
-		defer resp.Body.Close()
 
-		mediatype, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if mediatype != "application/json" {
-			return response, fmt.Errorf("invalid content-type")
+		// Repeat the request with another token from the pool
+		if vk.TokenPool != nil && vk.TokenPool.Has(token) {
+			if next, poolErr := vk.TokenPool.Get(); poolErr == nil {
+				token = next
+				query.Set("access_token", token)
+			}
+		}
+
+		if vk.RetryPolicy == nil {
+			continue
 		}
 
-		err = json.NewDecoder(resp.Body).Decode(&response)
+		err = sleepContext(ctx, vk.RetryPolicy.Backoff(attempt))
 		if err != nil {
 			return response, err
 		}
+	}
+}
 
-		err = errors.New(response.Error)
-		if err != nil {
-			if errors.GetType(err) == errors.TooMany && attempt < vk.Limit {
-				continue
-			}
+// shouldRetry reports whether the failed request should be repeated.
+//
+// Without RetryPolicy too many requests errors are repeated up to Limit
+// times, because the request is rejected by VK.
+func (vk *VK) shouldRetry(attempt int, method string, params Params, err error) bool {
+	if vk.RetryPolicy == nil {
+		return errors.GetType(err) == errors.TooMany && attempt < vk.Limit
+	}
 
-			return response, err
+	return vk.RetryPolicy.ShouldRetry(attempt, method, params, err)
+}
+
+// do makes a single request to VK API.
+func (vk *VK) do(ctx context.Context, u string, query url.Values) (Response, error) {
+	var response Response
+
+	rawBody := bytes.NewBufferString(query.Encode())
+
+	req, err := http.NewRequest("POST", u, rawBody)
+	if err != nil {
+		return response, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := internal.DoRequest(ctx, req)
+	if err != nil {
+		return response, err
+	}
+	defer resp.Body.Close()
+
+	mediatype, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediatype != "application/json" {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return response, &StatusError{
+				StatusCode: resp.StatusCode,
+				Status:     resp.Status,
+			}
 		}
 
-		return response, nil
+		return response, fmt.Errorf("invalid content-type")
 	}
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, errors.New(response.Error)
 }
 
-// Request provides access to VK API methods.
-//
-// TODO: remove in v2.
-func (vk *VK) Request(method string, params Params) ([]byte, error) {
+// token returns the access token for the next request.
+func (vk *VK) token() (string, error) {
+	if vk.IsPoolClient && vk.TokenPool != nil {
+		return vk.TokenPool.Get()
+	}
+
+	return vk.AccessToken, nil
+}
+
+// validateParams returns the first error of params which implement
+// object.Validator, e.g. an invalid keyboard.
+func validateParams(params Params) error {
+	for _, value := range params {
+		if v, ok := value.(object.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// prepareParams returns a copy of params with the access token
+// and the API version.
+func (vk *VK) prepareParams(params Params) (Params, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
 	copyParams := make(Params)
 	for key, value := range params {
 		copyParams[key] = FmtValue(value, 0)
 	}
 
 	if _, ok := copyParams["access_token"]; !ok {
-		token := vk.AccessToken
-		if vk.IsPoolClient {
-			token = vk.tokenPool.Get()
+		token, err := vk.token()
+		if err != nil {
+			return nil, err
 		}
 
 		copyParams["access_token"] = token
@@ -304,7 +484,34 @@ func (vk *VK) Request(method string, params Params) ([]byte, error) {
 		copyParams["v"] = vk.Version
 	}
 
-	resp, err := vk.Handler(method, copyParams)
+	return copyParams, nil
+}
+
+// Request provides access to VK API methods.
+//
+// TODO: remove in v2.
+func (vk *VK) Request(method string, params Params) ([]byte, error) {
+	copyParams, err := vk.prepareParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := vk.HandlerContext(context.Background(), method, copyParams)
+
+	return resp.Response, err
+}
+
+// RequestContext provides access to VK API methods with a context.
+//
+// The provided ctx must be non-nil. If it is canceled or times out,
+// ctx.Err() will be returned.
+func (vk *VK) RequestContext(ctx context.Context, method string, params Params) ([]byte, error) {
+	copyParams, err := vk.prepareParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := vk.HandlerContext(ctx, method, copyParams)
 
 	return resp.Response, err
 }
@@ -319,6 +526,16 @@ func (vk *VK) RequestUnmarshal(method string, params Params, obj interface{}) er
 	return json.Unmarshal(rawResponse, &obj)
 }
 
+// RequestUnmarshalContext provides access to VK API methods with a context.
+func (vk *VK) RequestUnmarshalContext(ctx context.Context, method string, params Params, obj interface{}) error {
+	rawResponse, err := vk.RequestContext(ctx, method, params)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(rawResponse, &obj)
+}
+
 // ExecuteWithArgs a universal method for calling a sequence of other methods
 // while saving and filtering interim results.
 //
@@ -330,13 +547,24 @@ func (vk *VK) RequestUnmarshal(method string, params Params, obj interface{}) er
 //
 // https://vk.com/dev/execute
 func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error {
-	token := vk.AccessToken
-	if vk.IsPoolClient {
-		token = vk.tokenPool.Get()
+	return vk.ExecuteWithArgsContext(context.Background(), code, params, obj)
+}
+
+// ExecuteWithArgsContext is the same as ExecuteWithArgs, but takes a context.
+func (vk *VK) ExecuteWithArgsContext(ctx context.Context, code string, params Params, obj interface{}) error {
+	token, err := vk.token()
+	if err != nil {
+		return err
 	}
 
 	copyParams := make(Params)
 	for key, value := range params {
+		if v, ok := value.(object.Validator); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+
 		copyParams[key] = FmtValue(value, 0)
 	}
 
@@ -344,11 +572,11 @@ func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error
 	copyParams["access_token"] = token
 	copyParams["v"] = vk.Version
 
-	resp, err := vk.Handler("execute", copyParams)
+	resp, err := vk.HandlerContext(ctx, "execute", copyParams)
 
 	// Add execute errors
 	for _, executeError := range resp.ExecuteErrors {
-		context := object.Error{
+		errContext := object.Error{
 			Code:    executeError.ErrorCode,
 			Message: executeError.ErrorMsg,
 			RequestParams: []object.BaseRequestParam{
@@ -359,7 +587,7 @@ func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error
 			},
 		}
 
-		err = errors.AddErrorContext(err, context)
+		err = errors.AddErrorContext(err, errContext)
 	}
 
 	jsonErr := json.Unmarshal(resp.Response, &obj)
@@ -375,7 +603,12 @@ func (vk *VK) ExecuteWithArgs(code string, params Params, obj interface{}) error
 //
 // https://vk.com/dev/execute
 func (vk *VK) Execute(code string, obj interface{}) error {
-	return vk.ExecuteWithArgs(code, Params{}, obj)
+	return vk.ExecuteWithArgsContext(context.Background(), code, Params{}, obj)
+}
+
+// ExecuteContext is the same as Execute, but takes a context.
+func (vk *VK) ExecuteContext(ctx context.Context, code string, obj interface{}) error {
+	return vk.ExecuteWithArgsContext(ctx, code, Params{}, obj)
 }
 
 func fmtBool(value bool) string {
@@ -440,6 +673,11 @@ func FmtValue(value interface{}, depth int) string {
 
 // CaptchaForce api method.
 func (vk *VK) CaptchaForce(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("captcha.force", params, &response)
+	return vk.CaptchaForceContext(context.Background(), params)
+}
+
+// CaptchaForceContext is the same as CaptchaForce, but takes a context.
+func (vk *VK) CaptchaForceContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "captcha.force", params, &response)
 	return
 }
@@ -1,12 +1,15 @@
 package api_test
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/SevereCloud/vksdk/api"
 	"github.com/SevereCloud/vksdk/api/errors"
@@ -162,6 +165,50 @@ func TestVK_Request(t *testing.T) {
 	})
 }
 
+func TestVK_Handler(t *testing.T) {
+	t.Parallel()
+
+	vk := api.NewVK("token")
+
+	var methods []string
+
+	vk.Handler = func(method string, params api.Params) (api.Response, error) {
+		methods = append(methods, method)
+		return api.Response{Response: []byte("1")}, nil
+	}
+
+	id, err := vk.MessagesSend(api.Params{"peer_id": 1, "random_id": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	err = vk.ExecuteContext(context.Background(), "return 1;", &id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"messages.send", "execute"}, methods)
+}
+
+func TestVK_Handler_wrap(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"response":1}`))
+	defer ts.Close()
+
+	var methods []string
+
+	next := vk.Handler
+	vk.Handler = func(method string, params api.Params) (api.Response, error) {
+		methods = append(methods, method)
+		return next(method, params)
+	}
+
+	id, err := vk.MessagesSend(api.Params{"peer_id": 1, "random_id": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	_, err = vk.Request("users.get", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"messages.send", "users.get"}, methods)
+}
+
 func TestVK_RequestLimit(t *testing.T) {
 	needUserToken(t)
 
@@ -331,3 +378,50 @@ func TestParams_methods(t *testing.T) {
 	assert.Equal(t, p["captcha_key"], "text")
 	assert.Equal(t, p["confirm"], true)
 }
+
+func TestVK_RequestContext(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users.get", r.URL.Path)
+		jsonHandler(`{"response":[{"id":1}]}`)(w, r)
+	})
+	defer ts.Close()
+
+	users, err := vk.UsersGetContext(context.Background(), api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, users[0].ID)
+}
+
+func TestVK_RequestContext_canceled(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := vk.RequestContext(ctx, "users.get", api.Params{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestVK_RequestContext_limit(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"response":1}`))
+	defer ts.Close()
+
+	vk.Limit = 1
+
+	_, err := vk.RequestContext(context.Background(), "users.get", api.Params{})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = vk.RequestContext(ctx, "users.get", api.Params{})
+	assert.Equal(t, context.Canceled, err)
+}
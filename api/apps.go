@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/apps.deleteAppRequests
 func (vk *VK) AppsDeleteAppRequests(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("apps.deleteAppRequests", params, &response)
+	return vk.AppsDeleteAppRequestsContext(context.Background(), params)
+}
+
+// AppsDeleteAppRequestsContext is the same as AppsDeleteAppRequests, but takes a context.
+func (vk *VK) AppsDeleteAppRequestsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.deleteAppRequests", params, &response)
 	return
 }
 
@@ -23,7 +30,12 @@ type AppsGetResponse struct {
 //
 // https://vk.com/dev/apps.get
 func (vk *VK) AppsGet(params Params) (response AppsGetResponse, err error) {
-	err = vk.RequestUnmarshal("apps.get", params, &response)
+	return vk.AppsGetContext(context.Background(), params)
+}
+
+// AppsGetContext is the same as AppsGet, but takes a context.
+func (vk *VK) AppsGetContext(ctx context.Context, params Params) (response AppsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.get", params, &response)
 	return
 }
 
@@ -38,7 +50,12 @@ type AppsGetCatalogResponse struct {
 //
 // https://vk.com/dev/apps.getCatalog
 func (vk *VK) AppsGetCatalog(params Params) (response AppsGetCatalogResponse, err error) {
-	err = vk.RequestUnmarshal("apps.getCatalog", params, &response)
+	return vk.AppsGetCatalogContext(context.Background(), params)
+}
+
+// AppsGetCatalogContext is the same as AppsGetCatalog, but takes a context.
+func (vk *VK) AppsGetCatalogContext(ctx context.Context, params Params) (response AppsGetCatalogResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.getCatalog", params, &response)
 	return
 }
 
@@ -54,8 +71,13 @@ type AppsGetFriendsListResponse struct {
 //
 // https://vk.com/dev/apps.getFriendsList
 func (vk *VK) AppsGetFriendsList(params Params) (response AppsGetFriendsListResponse, err error) {
+	return vk.AppsGetFriendsListContext(context.Background(), params)
+}
+
+// AppsGetFriendsListContext is the same as AppsGetFriendsList, but takes a context.
+func (vk *VK) AppsGetFriendsListContext(ctx context.Context, params Params) (response AppsGetFriendsListResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("apps.getFriendsList", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "apps.getFriendsList", params, &response)
 
 	return
 }
@@ -72,8 +94,13 @@ type AppsGetFriendsListExtendedResponse struct {
 //
 // https://vk.com/dev/apps.getFriendsList
 func (vk *VK) AppsGetFriendsListExtended(params Params) (response AppsGetFriendsListExtendedResponse, err error) {
+	return vk.AppsGetFriendsListExtendedContext(context.Background(), params)
+}
+
+// AppsGetFriendsListExtendedContext is the same as AppsGetFriendsListExtended, but takes a context.
+func (vk *VK) AppsGetFriendsListExtendedContext(ctx context.Context, params Params) (response AppsGetFriendsListExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("apps.getFriendsList", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "apps.getFriendsList", params, &response)
 
 	return
 }
@@ -90,8 +117,13 @@ type AppsGetLeaderboardResponse struct {
 //
 // https://vk.com/dev/apps.getLeaderboard
 func (vk *VK) AppsGetLeaderboard(params Params) (response AppsGetLeaderboardResponse, err error) {
+	return vk.AppsGetLeaderboardContext(context.Background(), params)
+}
+
+// AppsGetLeaderboardContext is the same as AppsGetLeaderboard, but takes a context.
+func (vk *VK) AppsGetLeaderboardContext(ctx context.Context, params Params) (response AppsGetLeaderboardResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("apps.getLeaderboard", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "apps.getLeaderboard", params, &response)
 
 	return
 }
@@ -112,8 +144,13 @@ type AppsGetLeaderboardExtendedResponse struct {
 //
 // https://vk.com/dev/apps.getLeaderboard
 func (vk *VK) AppsGetLeaderboardExtended(params Params) (response AppsGetLeaderboardExtendedResponse, err error) {
+	return vk.AppsGetLeaderboardExtendedContext(context.Background(), params)
+}
+
+// AppsGetLeaderboardExtendedContext is the same as AppsGetLeaderboardExtended, but takes a context.
+func (vk *VK) AppsGetLeaderboardExtendedContext(ctx context.Context, params Params) (response AppsGetLeaderboardExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("apps.getLeaderboard", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "apps.getLeaderboard", params, &response)
 
 	return
 }
@@ -128,7 +165,12 @@ type AppsGetScopesResponse struct {
 //
 // https://vk.com/dev/apps.getScopes
 func (vk *VK) AppsGetScopes(params Params) (response AppsGetScopesResponse, err error) {
-	err = vk.RequestUnmarshal("apps.getScopes", params, &response)
+	return vk.AppsGetScopesContext(context.Background(), params)
+}
+
+// AppsGetScopesContext is the same as AppsGetScopes, but takes a context.
+func (vk *VK) AppsGetScopesContext(ctx context.Context, params Params) (response AppsGetScopesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.getScopes", params, &response)
 	return
 }
 
@@ -138,7 +180,12 @@ func (vk *VK) AppsGetScopes(params Params) (response AppsGetScopesResponse, err
 //
 // https://vk.com/dev/apps.getScore
 func (vk *VK) AppsGetScore(params Params) (response string, err error) {
-	err = vk.RequestUnmarshal("apps.getScore", params, &response)
+	return vk.AppsGetScoreContext(context.Background(), params)
+}
+
+// AppsGetScoreContext is the same as AppsGetScore, but takes a context.
+func (vk *VK) AppsGetScoreContext(ctx context.Context, params Params) (response string, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.getScore", params, &response)
 	return
 }
 
@@ -146,6 +193,11 @@ func (vk *VK) AppsGetScore(params Params) (response string, err error) {
 //
 // https://vk.com/dev/apps.sendRequest
 func (vk *VK) AppsSendRequest(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("apps.sendRequest", params, &response)
+	return vk.AppsSendRequestContext(context.Background(), params)
+}
+
+// AppsSendRequestContext is the same as AppsSendRequest, but takes a context.
+func (vk *VK) AppsSendRequestContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "apps.sendRequest", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -14,7 +16,12 @@ type AppWidgetsGetAppImageUploadServerResponse struct {
 //
 // https://vk.com/dev/appWidgets.getAppImageUploadServer
 func (vk *VK) AppWidgetsGetAppImageUploadServer(params Params) (response AppWidgetsGetAppImageUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("appWidgets.getAppImageUploadServer", params, &response)
+	return vk.AppWidgetsGetAppImageUploadServerContext(context.Background(), params)
+}
+
+// AppWidgetsGetAppImageUploadServerContext is the same as AppWidgetsGetAppImageUploadServer, but takes a context.
+func (vk *VK) AppWidgetsGetAppImageUploadServerContext(ctx context.Context, params Params) (response AppWidgetsGetAppImageUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.getAppImageUploadServer", params, &response)
 	return
 }
 
@@ -28,7 +35,12 @@ type AppWidgetsGetAppImagesResponse struct {
 //
 // https://vk.com/dev/appWidgets.getAppImages
 func (vk *VK) AppWidgetsGetAppImages(params Params) (response AppWidgetsGetAppImagesResponse, err error) {
-	err = vk.RequestUnmarshal("appWidgets.getAppImages", params, &response)
+	return vk.AppWidgetsGetAppImagesContext(context.Background(), params)
+}
+
+// AppWidgetsGetAppImagesContext is the same as AppWidgetsGetAppImages, but takes a context.
+func (vk *VK) AppWidgetsGetAppImagesContext(ctx context.Context, params Params) (response AppWidgetsGetAppImagesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.getAppImages", params, &response)
 	return
 }
 
@@ -42,7 +54,12 @@ type AppWidgetsGetGroupImageUploadServerResponse struct {
 //
 // https://vk.com/dev/appWidgets.getGroupImageUploadServer
 func (vk *VK) AppWidgetsGetGroupImageUploadServer(params Params) (response AppWidgetsGetGroupImageUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("appWidgets.getGroupImageUploadServer", params, &response)
+	return vk.AppWidgetsGetGroupImageUploadServerContext(context.Background(), params)
+}
+
+// AppWidgetsGetGroupImageUploadServerContext is the same as AppWidgetsGetGroupImageUploadServer, but takes a context.
+func (vk *VK) AppWidgetsGetGroupImageUploadServerContext(ctx context.Context, params Params) (response AppWidgetsGetGroupImageUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.getGroupImageUploadServer", params, &response)
 	return
 }
 
@@ -56,7 +73,12 @@ type AppWidgetsGetGroupImagesResponse struct {
 //
 // https://vk.com/dev/appWidgets.getGroupImages
 func (vk *VK) AppWidgetsGetGroupImages(params Params) (response AppWidgetsGetGroupImagesResponse, err error) {
-	err = vk.RequestUnmarshal("appWidgets.getGroupImages", params, &response)
+	return vk.AppWidgetsGetGroupImagesContext(context.Background(), params)
+}
+
+// AppWidgetsGetGroupImagesContext is the same as AppWidgetsGetGroupImages, but takes a context.
+func (vk *VK) AppWidgetsGetGroupImagesContext(ctx context.Context, params Params) (response AppWidgetsGetGroupImagesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.getGroupImages", params, &response)
 	return
 }
 
@@ -64,7 +86,12 @@ func (vk *VK) AppWidgetsGetGroupImages(params Params) (response AppWidgetsGetGro
 //
 // https://vk.com/dev/appWidgets.getImagesById
 func (vk *VK) AppWidgetsGetImagesByID(params Params) (response object.AppWidgetsImage, err error) {
-	err = vk.RequestUnmarshal("appWidgets.getImagesById", params, &response)
+	return vk.AppWidgetsGetImagesByIDContext(context.Background(), params)
+}
+
+// AppWidgetsGetImagesByIDContext is the same as AppWidgetsGetImagesByID, but takes a context.
+func (vk *VK) AppWidgetsGetImagesByIDContext(ctx context.Context, params Params) (response object.AppWidgetsImage, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.getImagesById", params, &response)
 	return
 }
 
@@ -72,7 +99,12 @@ func (vk *VK) AppWidgetsGetImagesByID(params Params) (response object.AppWidgets
 //
 // https://vk.com/dev/appWidgets.saveAppImage
 func (vk *VK) AppWidgetsSaveAppImage(params Params) (response object.AppWidgetsImage, err error) {
-	err = vk.RequestUnmarshal("appWidgets.saveAppImage", params, &response)
+	return vk.AppWidgetsSaveAppImageContext(context.Background(), params)
+}
+
+// AppWidgetsSaveAppImageContext is the same as AppWidgetsSaveAppImage, but takes a context.
+func (vk *VK) AppWidgetsSaveAppImageContext(ctx context.Context, params Params) (response object.AppWidgetsImage, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.saveAppImage", params, &response)
 	return
 }
 
@@ -80,7 +112,12 @@ func (vk *VK) AppWidgetsSaveAppImage(params Params) (response object.AppWidgetsI
 //
 // https://vk.com/dev/appWidgets.saveGroupImage
 func (vk *VK) AppWidgetsSaveGroupImage(params Params) (response object.AppWidgetsImage, err error) {
-	err = vk.RequestUnmarshal("appWidgets.saveGroupImage", params, &response)
+	return vk.AppWidgetsSaveGroupImageContext(context.Background(), params)
+}
+
+// AppWidgetsSaveGroupImageContext is the same as AppWidgetsSaveGroupImage, but takes a context.
+func (vk *VK) AppWidgetsSaveGroupImageContext(ctx context.Context, params Params) (response object.AppWidgetsImage, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.saveGroupImage", params, &response)
 	return
 }
 
@@ -88,7 +125,12 @@ func (vk *VK) AppWidgetsSaveGroupImage(params Params) (response object.AppWidget
 //
 // https://vk.com/dev/appWidgets.update
 func (vk *VK) AppWidgetsUpdate(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("appWidgets.update", params, &response)
+	return vk.AppWidgetsUpdateContext(context.Background(), params)
+}
+
+// AppWidgetsUpdateContext is the same as AppWidgetsUpdate, but takes a context.
+func (vk *VK) AppWidgetsUpdateContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "appWidgets.update", params, &response)
 
 	return
 }
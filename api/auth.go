@@ -1,10 +1,19 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
+import (
+	"context"
+)
+
 // AuthCheckPhone checks a user's phone number for correctness.
 //
 // https://vk.com/dev/auth.checkPhone
 func (vk *VK) AuthCheckPhone(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("auth.checkPhone", params, &response)
+	return vk.AuthCheckPhoneContext(context.Background(), params)
+}
+
+// AuthCheckPhoneContext is the same as AuthCheckPhone, but takes a context.
+func (vk *VK) AuthCheckPhoneContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "auth.checkPhone", params, &response)
 	return
 }
 
@@ -18,6 +27,11 @@ type AuthRestoreResponse struct {
 //
 // https://vk.com/dev/auth.restore
 func (vk *VK) AuthRestore(params Params) (response AuthRestoreResponse, err error) {
-	err = vk.RequestUnmarshal("auth.restore", params, &response)
+	return vk.AuthRestoreContext(context.Background(), params)
+}
+
+// AuthRestoreContext is the same as AuthRestore, but takes a context.
+func (vk *VK) AuthRestoreContext(ctx context.Context, params Params) (response AuthRestoreResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "auth.restore", params, &response)
 	return
 }
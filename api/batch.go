@@ -0,0 +1,436 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+//go:generate go run gen_batch.go
+
+// MaxExecuteCalls is the maximum number of API calls in one execute.
+const MaxExecuteCalls = 25
+
+// BatchCall is an API call added to a Batch.
+//
+// Err is set after Batch.Execute.
+type BatchCall struct {
+	Method string
+	Params Params
+	Err    error
+
+	obj interface{}
+}
+
+// Batch collects independent API calls and sends them in a single
+// execute request.
+//
+// execute is not idempotent, so RetryPolicy repeats batched calls only on
+// errors which are always retried, e.g. errors.TooMany.
+//
+// 	b := vk.NewBatch()
+// 	var users api.UsersGetResponse
+// 	usersCall := b.UsersGet(api.Params{"user_ids": 1}, &users)
+// 	err := b.Execute()
+type Batch struct {
+	vk    *VK
+	calls []*BatchCall
+}
+
+// NewBatch returns a new Batch.
+func (vk *VK) NewBatch() *Batch {
+	return &Batch{vk: vk}
+}
+
+// Add adds the call of the method to the batch. The result of the call
+// is unmarshaled to obj.
+func (b *Batch) Add(method string, params Params, obj interface{}) *BatchCall {
+	call := &BatchCall{
+		Method: method,
+		Params: params,
+		obj:    obj,
+	}
+	b.calls = append(b.calls, call)
+
+	return call
+}
+
+// Len returns the number of calls in the batch.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Execute sends all calls in a single execute request.
+//
+// The returned error is an error of the execute request itself, errors
+// of the calls are set to BatchCall.Err. Calls with invalid params, e.g.
+// an invalid keyboard, are not sent, as if they were made directly.
+func (b *Batch) Execute() error {
+	return b.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is the same as Execute, but takes a context.
+func (b *Batch) ExecuteContext(ctx context.Context) error {
+	if len(b.calls) == 0 {
+		return nil
+	}
+
+	if len(b.calls) > MaxExecuteCalls {
+		return fmt.Errorf("api: batch has %d calls, maximum is %d", len(b.calls), MaxExecuteCalls)
+	}
+
+	var (
+		sent  []*BatchCall
+		calls []executeCall
+	)
+
+	for _, call := range b.calls {
+		call.Err = validateParams(call.Params)
+		if call.Err != nil {
+			continue
+		}
+
+		sent = append(sent, call)
+		calls = append(calls, executeCall{call.Method, call.Params})
+	}
+
+	if len(calls) == 0 {
+		return nil
+	}
+
+	code, err := executeCode(calls)
+	if err != nil {
+		return err
+	}
+
+	params, err := b.vk.prepareParams(Params{"code": code})
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.vk.HandlerContext(ctx, "execute", params)
+
+	results, errs := splitExecuteResponse(resp, calls, err)
+	for i, call := range sent {
+		call.Err = errs[i]
+		if call.Err == nil && call.obj != nil {
+			call.Err = json.Unmarshal(results[i], call.obj)
+		}
+	}
+
+	return err
+}
+
+type executeCall struct {
+	method string
+	params Params
+}
+
+// executeCode returns VKScript code which calls the methods
+// and returns an array of results.
+func executeCode(calls []executeCall) (string, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("return [")
+
+	for i, call := range calls {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		args := make(map[string]string, len(call.params))
+
+		for key, value := range call.params {
+			switch key {
+			case "access_token", "v":
+				continue
+			}
+
+			args[key] = FmtValue(value, 0)
+		}
+
+		rawArgs, err := json.Marshal(args)
+		if err != nil {
+			return "", err
+		}
+
+		buf.WriteString("API.")
+		buf.WriteString(call.method)
+		buf.WriteString("(")
+		buf.Write(rawArgs)
+		buf.WriteString(")")
+	}
+
+	buf.WriteString("];")
+
+	return buf.String(), nil
+}
+
+// splitExecuteResponse returns the result and the error of each call.
+//
+// A failed call returns false and adds an execute error with the name of
+// the method. Errors are matched with failed calls of the same method in
+// the order of execution. If calls of the same method return false both
+// with and without an error, e.g. groups.isMember, errors can be matched
+// with wrong calls.
+func splitExecuteResponse(resp Response, calls []executeCall, err error) ([]json.RawMessage, []error) {
+	results := make([]json.RawMessage, len(calls))
+	errs := make([]error, len(calls))
+
+	if err == nil {
+		err = json.Unmarshal(resp.Response, &results)
+	}
+
+	if err == nil && len(results) != len(calls) {
+		err = fmt.Errorf("api: execute returned %d results for %d calls", len(results), len(calls))
+	}
+
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+
+		return results, errs
+	}
+
+	// Errors without a method are matched in the order of execution
+	byMethod := make(map[string][]object.ExecuteError)
+
+	for _, executeError := range resp.ExecuteErrors {
+		byMethod[executeError.Method] = append(byMethod[executeError.Method], executeError)
+	}
+
+	for i, result := range results {
+		if string(result) != "false" {
+			continue
+		}
+
+		method := calls[i].method
+		if len(byMethod[method]) == 0 {
+			method = ""
+		}
+
+		if len(byMethod[method]) == 0 {
+			continue
+		}
+
+		executeError := byMethod[method][0]
+		byMethod[method] = byMethod[method][1:]
+
+		errs[i] = errors.New(object.Error{
+			Code:    executeError.ErrorCode,
+			Message: executeError.ErrorMsg,
+			RequestParams: []object.BaseRequestParam{
+				{
+					Key:   "method",
+					Value: executeError.Method,
+				},
+			},
+		})
+	}
+
+	return results, errs
+}
+
+type pendingCall struct {
+	ctx    context.Context
+	call   executeCall
+	result chan batchResult
+}
+
+type batchResult struct {
+	response json.RawMessage
+	err      error
+}
+
+type batchKey struct {
+	token   string
+	version string
+	pooled  bool
+}
+
+type pendingBatch []*pendingCall
+
+// AutoBatcher coalesces API calls made concurrently from different
+// goroutines into execute requests.
+//
+// Calls made within Window after the first one are sent together. A batch
+// is sent immediately when it has MaxExecuteCalls calls. Calls with
+// different access tokens or API versions are never mixed.
+//
+// With NewVKWithPool every call gets another token of the pool, so set
+// TokenPool to batch calls with any token of the pool together. Such
+// a batch is sent with the token of its first call.
+//
+// The execute request is canceled when contexts of all its callers are
+// done. execute is not idempotent, so RetryPolicy repeats batched calls
+// only on errors which are always retried, e.g. errors.TooMany.
+type AutoBatcher struct {
+	Window    time.Duration
+	TokenPool *TokenPool
+
+	pending map[batchKey]*pendingBatch
+	mux     sync.Mutex
+}
+
+// NewAutoBatcher returns a new AutoBatcher.
+func NewAutoBatcher(window time.Duration) *AutoBatcher {
+	return &AutoBatcher{
+		Window:  window,
+		pending: make(map[batchKey]*pendingBatch),
+	}
+}
+
+// Middleware returns a Middleware which batches requests.
+//
+// 	vk.Use(api.NewAutoBatcher(10 * time.Millisecond).Middleware())
+func (a *AutoBatcher) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, method string, params Params) (Response, error) {
+			if method == "execute" {
+				return next(ctx, method, params)
+			}
+
+			p := &pendingCall{
+				ctx:    ctx,
+				call:   executeCall{method, params},
+				result: make(chan batchResult, 1),
+			}
+
+			a.add(next, a.key(params), p)
+
+			select {
+			case <-ctx.Done():
+				return Response{}, ctx.Err()
+			case r := <-p.result:
+				return Response{Response: r.response}, r.err
+			}
+		}
+	}
+}
+
+// key returns the key of the batch for the call.
+func (a *AutoBatcher) key(params Params) batchKey {
+	key := batchKey{
+		token:   FmtValue(params["access_token"], 0),
+		version: FmtValue(params["v"], 0),
+	}
+
+	if a.TokenPool != nil && a.TokenPool.Has(key.token) {
+		key.token = ""
+		key.pooled = true
+	}
+
+	return key
+}
+
+func (a *AutoBatcher) add(next HandlerFunc, key batchKey, p *pendingCall) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	b, ok := a.pending[key]
+	if !ok {
+		b = new(pendingBatch)
+		a.pending[key] = b
+
+		time.AfterFunc(a.Window, func() {
+			a.mux.Lock()
+
+			// The batch could be already sent because it is full
+			sent := a.pending[key] != b
+			if !sent {
+				delete(a.pending, key)
+			}
+
+			a.mux.Unlock()
+
+			if !sent {
+				send(next, key.version, *b)
+			}
+		})
+	}
+
+	*b = append(*b, p)
+
+	if len(*b) == MaxExecuteCalls {
+		delete(a.pending, key)
+
+		go send(next, key.version, *b)
+	}
+}
+
+// send sends the calls in a single execute request with the token
+// of the first call.
+func send(next HandlerFunc, version string, pending []*pendingCall) {
+	// Calls with invalid params fail as if they were made directly
+	valid := make([]*pendingCall, 0, len(pending))
+
+	for _, p := range pending {
+		if err := validateParams(p.call.params); err != nil {
+			p.result <- batchResult{nil, err}
+			continue
+		}
+
+		valid = append(valid, p)
+	}
+
+	pending = valid
+
+	if len(pending) == 0 {
+		return
+	}
+
+	// A single call does not need execute
+	if len(pending) == 1 {
+		p := pending[0]
+		resp, err := next(p.ctx, p.call.method, p.call.params)
+		p.result <- batchResult{resp.Response, err}
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go cancelWhenDone(ctx, cancel, pending)
+
+	calls := make([]executeCall, len(pending))
+	for i, p := range pending {
+		calls[i] = p.call
+	}
+
+	code, err := executeCode(calls)
+
+	var resp Response
+	if err == nil {
+		resp, err = next(ctx, "execute", Params{
+			"code":         code,
+			"access_token": pending[0].call.params["access_token"],
+			"v":            version,
+		})
+	}
+
+	results, errs := splitExecuteResponse(resp, calls, err)
+	for i, p := range pending {
+		p.result <- batchResult{results[i], errs[i]}
+	}
+}
+
+// cancelWhenDone calls cancel when contexts of all calls are done.
+func cancelWhenDone(ctx context.Context, cancel context.CancelFunc, pending []*pendingCall) {
+	for _, p := range pending {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.ctx.Done():
+		}
+	}
+
+	cancel()
+}
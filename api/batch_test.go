@@ -0,0 +1,363 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	var code string
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/execute", r.URL.Path)
+
+		code = r.FormValue("code")
+
+		jsonHandler(`{
+			"response": [[{"id":1}], false, 10],
+			"execute_errors": [
+				{"method":"wall.get","error_code":15,"error_msg":"Access denied"}
+			]
+		}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	b := vk.NewBatch()
+
+	var (
+		users api.UsersGetResponse
+		wall  api.WallGetResponse
+		count int
+	)
+
+	usersCall := b.UsersGet(api.Params{"user_ids": 1}, &users)
+	wallCall := b.WallGet(api.Params{"owner_id": -1}, &wall)
+	countCall := b.Add("friends.getOnline", api.Params{}, &count)
+
+	assert.Equal(t, 3, b.Len())
+	assert.NoError(t, b.Execute())
+
+	assert.Equal(t,
+		`return [API.users.get({"user_ids":"1"}),API.wall.get({"extended":"0","owner_id":"-1"}),API.friends.getOnline({})];`,
+		code,
+	)
+
+	assert.NoError(t, usersCall.Err)
+	assert.Equal(t, 1, users[0].ID)
+
+	assert.Equal(t, errors.Access, errors.GetType(wallCall.Err))
+	assert.Equal(t, "wall.get", errors.GetErrorContext(wallCall.Err).RequestParams[0].Value)
+
+	assert.NoError(t, countCall.Err)
+	assert.Equal(t, 10, count)
+}
+
+// invalidKeyboard returns a keyboard with too many buttons in a row.
+func invalidKeyboard() object.MessagesKeyboard {
+	keyboard := object.NewMessagesKeyboard(false)
+	keyboard.AddRow()
+
+	for i := 0; i <= object.KeyboardMaxRowButtons; i++ {
+		keyboard.AddTextButton("label", "", "")
+	}
+
+	return keyboard
+}
+
+func TestBatch_validate(t *testing.T) {
+	t.Parallel()
+
+	var code string
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		code = r.FormValue("code")
+		jsonHandler(`{"response":[[{"id":1}]]}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	b := vk.NewBatch()
+	usersCall := b.Add("users.get", api.Params{}, nil)
+	sendCall := b.Add("messages.send", api.Params{"keyboard": invalidKeyboard()}, nil)
+
+	assert.NoError(t, b.Execute())
+	assert.NoError(t, usersCall.Err)
+	assert.IsType(t, &object.KeyboardError{}, sendCall.Err)
+	assert.Equal(t, `return [API.users.get({})];`, code)
+}
+
+func TestBatch_error(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"error":{"error_code":5}}`))
+	defer ts.Close()
+
+	b := vk.NewBatch()
+	call := b.Add("users.get", api.Params{}, nil)
+
+	err := b.Execute()
+	assert.Equal(t, errors.Auth, errors.GetType(err))
+	assert.Equal(t, err, call.Err)
+
+	assert.NoError(t, vk.NewBatch().Execute())
+
+	b = vk.NewBatch()
+	for i := 0; i <= api.MaxExecuteCalls; i++ {
+		b.Add("users.get", api.Params{}, nil)
+	}
+
+	assert.Error(t, b.Execute())
+}
+
+func TestBatch_executeErrors(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{
+		"response": [false, false, false],
+		"execute_errors": [
+			{"method":"users.get","error_code":5,"error_msg":"User authorization failed"},
+			{"method":"wall.get","error_code":15,"error_msg":"Access denied"}
+		]
+	}`))
+	defer ts.Close()
+
+	b := vk.NewBatch()
+	memberCall := b.Add("groups.isMember", api.Params{}, nil)
+	wallCall := b.Add("wall.get", api.Params{}, nil)
+	usersCall := b.Add("users.get", api.Params{}, nil)
+
+	assert.NoError(t, b.Execute())
+
+	// groups.isMember returns false without an error
+	assert.NoError(t, memberCall.Err)
+	assert.Equal(t, errors.Access, errors.GetType(wallCall.Err))
+	assert.Equal(t, errors.Auth, errors.GetType(usersCall.Err))
+}
+
+// TestBatch_methods checks that batch_methods.go is regenerated after
+// adding API methods.
+func TestBatch_methods(t *testing.T) {
+	t.Parallel()
+
+	// Methods which can not be called in execute
+	skip := map[string]bool{
+		"UtilsResolveScreenName": true,
+	}
+
+	vkType := reflect.TypeOf(&api.VK{})
+	batchType := reflect.TypeOf(&api.Batch{})
+	ctxType := reflect.TypeOf((*context.Context)(nil)).Elem()
+	paramsType := reflect.TypeOf(api.Params{})
+
+	for i := 0; i < vkType.NumMethod(); i++ {
+		m := vkType.Method(i)
+		name := strings.TrimSuffix(m.Name, "Context")
+
+		if name == m.Name || skip[name] || m.Type.NumIn() != 3 || m.Type.NumOut() != 2 ||
+			m.Type.In(1) != ctxType || m.Type.In(2) != paramsType {
+			continue
+		}
+
+		batchMethod, ok := batchType.MethodByName(name)
+		if !assert.True(t, ok, "Batch.%s is missing, run go generate", name) {
+			continue
+		}
+
+		assert.Equal(t, reflect.PtrTo(m.Type.Out(0)), batchMethod.Type.In(2), name)
+	}
+}
+
+func TestAutoBatcher(t *testing.T) {
+	t.Parallel()
+
+	var executes, requests int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		if r.URL.Path != "/execute" {
+			jsonHandler(`{"response":[{"id":1}]}`)(w, r)
+			return
+		}
+
+		atomic.AddInt32(&executes, 1)
+
+		n := strings.Count(r.FormValue("code"), "API.users.get")
+		jsonHandler(`{"response":[` + strings.Repeat(`[{"id":1}],`, n-1) + `[{"id":1}]]}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	vk.Use(api.NewAutoBatcher(50 * time.Millisecond).Middleware())
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			users, err := vk.UsersGet(api.Params{})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, users[0].ID)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(2), executes)
+	assert.Equal(t, int32(2), requests)
+
+	// a single call is sent without execute
+	_, err := vk.UsersGet(api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), executes)
+}
+
+func TestAutoBatcher_TokenPool(t *testing.T) {
+	t.Parallel()
+
+	var executes int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/execute", r.URL.Path)
+		atomic.AddInt32(&executes, 1)
+
+		n := strings.Count(r.FormValue("code"), "API.users.get")
+		jsonHandler(`{"response":[` + strings.Repeat(`[{"id":1}],`, n-1) + `[{"id":1}]]}`)(w, r)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	defer ts.Close()
+
+	vk := api.NewVKWithPool("token1", "token2", "token3")
+	vk.MethodURL = ts.URL + "/"
+
+	batcher := api.NewAutoBatcher(50 * time.Millisecond)
+	batcher.TokenPool = vk.TokenPool
+	vk.Use(batcher.Middleware())
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vk.UsersGet(api.Params{})
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), executes)
+}
+
+func TestAutoBatcher_validate(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, "/users.get", r.URL.Path)
+		jsonHandler(`{"response":[{"id":1}]}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	vk.Use(api.NewAutoBatcher(50 * time.Millisecond).Middleware())
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		_, err := vk.UsersGet(api.Params{})
+		assert.NoError(t, err)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// params passed to HandlerContext are not validated by prepareParams
+		_, err := vk.HandlerContext(context.Background(), "messages.send", api.Params{
+			"access_token": "secret-token",
+			"v":            api.Version,
+			"keyboard":     invalidKeyboard(),
+		})
+		assert.IsType(t, &object.KeyboardError{}, err)
+	}()
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests)
+}
+
+func TestAutoBatcher_cancel(t *testing.T) {
+	t.Parallel()
+
+	canceled := make(chan bool, 1)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		// the server detects closed connections after the body is read
+		_ = r.ParseForm()
+
+		select {
+		case <-r.Context().Done():
+			canceled <- true
+		case <-time.After(time.Second):
+			canceled <- false
+		}
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	vk.Use(api.NewAutoBatcher(10 * time.Millisecond).Middleware())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vk.UsersGetContext(ctx, api.Params{})
+			assert.Equal(t, context.DeadlineExceeded, err)
+		}()
+	}
+
+	wg.Wait()
+
+	// the execute request is canceled after all callers left
+	assert.True(t, <-canceled)
+}
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/board.addTopic
 func (vk *VK) BoardAddTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.addTopic", params, &response)
+	return vk.BoardAddTopicContext(context.Background(), params)
+}
+
+// BoardAddTopicContext is the same as BoardAddTopic, but takes a context.
+func (vk *VK) BoardAddTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.addTopic", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) BoardAddTopic(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.closeTopic
 func (vk *VK) BoardCloseTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.closeTopic", params, &response)
+	return vk.BoardCloseTopicContext(context.Background(), params)
+}
+
+// BoardCloseTopicContext is the same as BoardCloseTopic, but takes a context.
+func (vk *VK) BoardCloseTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.closeTopic", params, &response)
 	return
 }
 
@@ -24,7 +36,12 @@ func (vk *VK) BoardCloseTopic(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.createComment
 func (vk *VK) BoardCreateComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.createComment", params, &response)
+	return vk.BoardCreateCommentContext(context.Background(), params)
+}
+
+// BoardCreateCommentContext is the same as BoardCreateComment, but takes a context.
+func (vk *VK) BoardCreateCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.createComment", params, &response)
 	return
 }
 
@@ -32,7 +49,12 @@ func (vk *VK) BoardCreateComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.deleteComment
 func (vk *VK) BoardDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.deleteComment", params, &response)
+	return vk.BoardDeleteCommentContext(context.Background(), params)
+}
+
+// BoardDeleteCommentContext is the same as BoardDeleteComment, but takes a context.
+func (vk *VK) BoardDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.deleteComment", params, &response)
 	return
 }
 
@@ -40,7 +62,12 @@ func (vk *VK) BoardDeleteComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.deleteTopic
 func (vk *VK) BoardDeleteTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.deleteTopic", params, &response)
+	return vk.BoardDeleteTopicContext(context.Background(), params)
+}
+
+// BoardDeleteTopicContext is the same as BoardDeleteTopic, but takes a context.
+func (vk *VK) BoardDeleteTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.deleteTopic", params, &response)
 	return
 }
 
@@ -48,7 +75,12 @@ func (vk *VK) BoardDeleteTopic(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.editComment
 func (vk *VK) BoardEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.editComment", params, &response)
+	return vk.BoardEditCommentContext(context.Background(), params)
+}
+
+// BoardEditCommentContext is the same as BoardEditComment, but takes a context.
+func (vk *VK) BoardEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.editComment", params, &response)
 	return
 }
 
@@ -56,7 +88,12 @@ func (vk *VK) BoardEditComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.editTopic
 func (vk *VK) BoardEditTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.editTopic", params, &response)
+	return vk.BoardEditTopicContext(context.Background(), params)
+}
+
+// BoardEditTopicContext is the same as BoardEditTopic, but takes a context.
+func (vk *VK) BoardEditTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.editTopic", params, &response)
 	return
 }
 
@@ -64,7 +101,12 @@ func (vk *VK) BoardEditTopic(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.fixTopic
 func (vk *VK) BoardFixTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.fixTopic", params, &response)
+	return vk.BoardFixTopicContext(context.Background(), params)
+}
+
+// BoardFixTopicContext is the same as BoardFixTopic, but takes a context.
+func (vk *VK) BoardFixTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.fixTopic", params, &response)
 	return
 }
 
@@ -82,8 +124,13 @@ type BoardGetCommentsResponse struct {
 //
 // https://vk.com/dev/board.getComments
 func (vk *VK) BoardGetComments(params Params) (response BoardGetCommentsResponse, err error) {
+	return vk.BoardGetCommentsContext(context.Background(), params)
+}
+
+// BoardGetCommentsContext is the same as BoardGetComments, but takes a context.
+func (vk *VK) BoardGetCommentsContext(ctx context.Context, params Params) (response BoardGetCommentsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("board.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "board.getComments", params, &response)
 
 	return
 }
@@ -104,8 +151,13 @@ type BoardGetCommentsExtendedResponse struct {
 //
 // https://vk.com/dev/board.getComments
 func (vk *VK) BoardGetCommentsExtended(params Params) (response BoardGetCommentsExtendedResponse, err error) {
+	return vk.BoardGetCommentsExtendedContext(context.Background(), params)
+}
+
+// BoardGetCommentsExtendedContext is the same as BoardGetCommentsExtended, but takes a context.
+func (vk *VK) BoardGetCommentsExtendedContext(ctx context.Context, params Params) (response BoardGetCommentsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("board.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "board.getComments", params, &response)
 
 	return
 }
@@ -124,8 +176,13 @@ type BoardGetTopicsResponse struct {
 //
 // https://vk.com/dev/board.getTopics
 func (vk *VK) BoardGetTopics(params Params) (response BoardGetTopicsResponse, err error) {
+	return vk.BoardGetTopicsContext(context.Background(), params)
+}
+
+// BoardGetTopicsContext is the same as BoardGetTopics, but takes a context.
+func (vk *VK) BoardGetTopicsContext(ctx context.Context, params Params) (response BoardGetTopicsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("board.getTopics", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "board.getTopics", params, &response)
 
 	return
 }
@@ -146,8 +203,13 @@ type BoardGetTopicsExtendedResponse struct {
 //
 // https://vk.com/dev/board.getTopics
 func (vk *VK) BoardGetTopicsExtended(params Params) (response BoardGetTopicsExtendedResponse, err error) {
+	return vk.BoardGetTopicsExtendedContext(context.Background(), params)
+}
+
+// BoardGetTopicsExtendedContext is the same as BoardGetTopicsExtended, but takes a context.
+func (vk *VK) BoardGetTopicsExtendedContext(ctx context.Context, params Params) (response BoardGetTopicsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("board.getTopics", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "board.getTopics", params, &response)
 
 	return
 }
@@ -156,7 +218,12 @@ func (vk *VK) BoardGetTopicsExtended(params Params) (response BoardGetTopicsExte
 //
 // https://vk.com/dev/board.openTopic
 func (vk *VK) BoardOpenTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.openTopic", params, &response)
+	return vk.BoardOpenTopicContext(context.Background(), params)
+}
+
+// BoardOpenTopicContext is the same as BoardOpenTopic, but takes a context.
+func (vk *VK) BoardOpenTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.openTopic", params, &response)
 	return
 }
 
@@ -164,7 +231,12 @@ func (vk *VK) BoardOpenTopic(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.restoreComment
 func (vk *VK) BoardRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.restoreComment", params, &response)
+	return vk.BoardRestoreCommentContext(context.Background(), params)
+}
+
+// BoardRestoreCommentContext is the same as BoardRestoreComment, but takes a context.
+func (vk *VK) BoardRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.restoreComment", params, &response)
 	return
 }
 
@@ -172,6 +244,11 @@ func (vk *VK) BoardRestoreComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/board.unfixTopic
 func (vk *VK) BoardUnfixTopic(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("board.unfixTopic", params, &response)
+	return vk.BoardUnfixTopicContext(context.Background(), params)
+}
+
+// BoardUnfixTopicContext is the same as BoardUnfixTopic, but takes a context.
+func (vk *VK) BoardUnfixTopicContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "board.unfixTopic", params, &response)
 	return
 }
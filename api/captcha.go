@@ -0,0 +1,97 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/SevereCloud/vksdk/api/errors"
+)
+
+// maxChallenges is the maximum number of captchas and confirmations
+// handled for one request.
+const maxChallenges = 3
+
+// CaptchaSolver solves captchas required by VK.
+//
+// See https://vk.com/dev/captcha_error
+type CaptchaSolver interface {
+	// SolveCaptcha returns the text from the image captchaImg.
+	// If the key is empty, the captcha error is returned to the caller.
+	SolveCaptcha(ctx context.Context, captchaSID, captchaImg string) (key string, err error)
+}
+
+// CaptchaSolverFunc is an adapter to allow the use of ordinary functions
+// as CaptchaSolver.
+type CaptchaSolverFunc func(ctx context.Context, captchaSID, captchaImg string) (string, error)
+
+// SolveCaptcha calls f(ctx, captchaSID, captchaImg).
+func (f CaptchaSolverFunc) SolveCaptcha(ctx context.Context, captchaSID, captchaImg string) (string, error) {
+	return f(ctx, captchaSID, captchaImg)
+}
+
+// ConfirmationHandler asks the user to confirm an action.
+//
+// See https://vk.com/dev/need_confirmation
+type ConfirmationHandler interface {
+	// Confirm shows the text to the user and reports whether
+	// the action is confirmed.
+	Confirm(ctx context.Context, confirmationText string) (bool, error)
+}
+
+// ConfirmationHandlerFunc is an adapter to allow the use of ordinary
+// functions as ConfirmationHandler.
+type ConfirmationHandlerFunc func(ctx context.Context, confirmationText string) (bool, error)
+
+// Confirm calls f(ctx, confirmationText).
+func (f ConfirmationHandlerFunc) Confirm(ctx context.Context, confirmationText string) (bool, error) {
+	return f(ctx, confirmationText)
+}
+
+// resolveChallenge handles errors.Captcha with VK.CaptchaSolver and
+// errors.NeedConfirmation with VK.ConfirmationHandler.
+//
+// It reports whether the request must be repeated with the updated query.
+// The returned error is an error of the solver or the handler.
+func (vk *VK) resolveChallenge(ctx context.Context, query url.Values, err error) (bool, error) {
+	vkErr := errors.GetErrorContext(err)
+
+	switch errors.GetType(err) {
+	case errors.Captcha:
+		if vk.CaptchaSolver == nil {
+			return false, nil
+		}
+
+		key, solveErr := vk.CaptchaSolver.SolveCaptcha(ctx, vkErr.CaptchaSID, vkErr.CaptchaImg)
+		if solveErr != nil {
+			return false, solveErr
+		}
+
+		if key == "" {
+			return false, nil
+		}
+
+		query.Set("captcha_sid", vkErr.CaptchaSID)
+		query.Set("captcha_key", key)
+
+		return true, nil
+	case errors.NeedConfirmation:
+		if vk.ConfirmationHandler == nil {
+			return false, nil
+		}
+
+		ok, confirmErr := vk.ConfirmationHandler.Confirm(ctx, vkErr.ConfirmationText)
+		if confirmErr != nil {
+			return false, confirmErr
+		}
+
+		if !ok {
+			return false, nil
+		}
+
+		query.Set("confirm", "1")
+
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -0,0 +1,114 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_CaptchaSolver(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("captcha_key") != "key" {
+			jsonHandler(`{"error":{"error_code":14,"captcha_sid":"123","captcha_img":"img"}}`)(w, r)
+			return
+		}
+
+		assert.Equal(t, "123", r.FormValue("captcha_sid"))
+		jsonHandler(`{"response":1}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	_, err := vk.Request("messages.send", api.Params{})
+	assert.Equal(t, errors.Captcha, errors.GetType(err))
+
+	calls := 0
+	vk.CaptchaSolver = api.CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+		calls++
+
+		assert.Equal(t, "123", sid)
+		assert.Equal(t, "img", img)
+
+		return "key", nil
+	})
+
+	resp, err := vk.Request("messages.send", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", string(resp))
+	assert.Equal(t, 1, calls)
+
+	// the solver gives up
+	vk.CaptchaSolver = api.CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+		return "", nil
+	})
+
+	_, err = vk.Request("messages.send", api.Params{})
+	assert.Equal(t, errors.Captcha, errors.GetType(err))
+
+	vk.CaptchaSolver = api.CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+		return "", context.Canceled
+	})
+
+	_, err = vk.Request("messages.send", api.Params{})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestVK_CaptchaSolver_limit(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		jsonHandler(`{"error":{"error_code":14,"captcha_sid":"123"}}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	vk.CaptchaSolver = api.CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+		return "wrong", nil
+	})
+
+	_, err := vk.Request("messages.send", api.Params{})
+	assert.Equal(t, errors.Captcha, errors.GetType(err))
+	assert.Equal(t, 4, requests)
+}
+
+func TestVK_ConfirmationHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("confirm") != "1" {
+			jsonHandler(`{"error":{"error_code":24,"confirmation_text":"Are you sure?"}}`)(w, r)
+			return
+		}
+
+		jsonHandler(`{"response":1}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	confirm := false
+	vk.ConfirmationHandler = api.ConfirmationHandlerFunc(func(ctx context.Context, text string) (bool, error) {
+		assert.Equal(t, "Are you sure?", text)
+		return confirm, nil
+	})
+
+	_, err := vk.Request("friends.delete", api.Params{})
+	assert.Equal(t, errors.NeedConfirmation, errors.GetType(err))
+
+	confirm = true
+
+	resp, err := vk.Request("friends.delete", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", string(resp))
+}
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -14,7 +16,12 @@ type DatabaseGetChairsResponse struct {
 //
 // https://vk.com/dev/database.getChairs
 func (vk *VK) DatabaseGetChairs(params Params) (response DatabaseGetChairsResponse, err error) {
-	err = vk.RequestUnmarshal("database.getChairs", params, &response)
+	return vk.DatabaseGetChairsContext(context.Background(), params)
+}
+
+// DatabaseGetChairsContext is the same as DatabaseGetChairs, but takes a context.
+func (vk *VK) DatabaseGetChairsContext(ctx context.Context, params Params) (response DatabaseGetChairsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getChairs", params, &response)
 	return
 }
 
@@ -28,7 +35,12 @@ type DatabaseGetCitiesResponse struct {
 //
 // https://vk.com/dev/database.getCities
 func (vk *VK) DatabaseGetCities(params Params) (response DatabaseGetCitiesResponse, err error) {
-	err = vk.RequestUnmarshal("database.getCities", params, &response)
+	return vk.DatabaseGetCitiesContext(context.Background(), params)
+}
+
+// DatabaseGetCitiesContext is the same as DatabaseGetCities, but takes a context.
+func (vk *VK) DatabaseGetCitiesContext(ctx context.Context, params Params) (response DatabaseGetCitiesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getCities", params, &response)
 	return
 }
 
@@ -39,7 +51,12 @@ type DatabaseGetCitiesByIDResponse []object.DatabaseCity
 //
 // https://vk.com/dev/database.getCitiesByID
 func (vk *VK) DatabaseGetCitiesByID(params Params) (response DatabaseGetCitiesByIDResponse, err error) {
-	err = vk.RequestUnmarshal("database.getCitiesById", params, &response)
+	return vk.DatabaseGetCitiesByIDContext(context.Background(), params)
+}
+
+// DatabaseGetCitiesByIDContext is the same as DatabaseGetCitiesByID, but takes a context.
+func (vk *VK) DatabaseGetCitiesByIDContext(ctx context.Context, params Params) (response DatabaseGetCitiesByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getCitiesById", params, &response)
 	return
 }
 
@@ -53,7 +70,12 @@ type DatabaseGetCountriesResponse struct {
 //
 // https://vk.com/dev/database.getCountries
 func (vk *VK) DatabaseGetCountries(params Params) (response DatabaseGetCountriesResponse, err error) {
-	err = vk.RequestUnmarshal("database.getCountries", params, &response)
+	return vk.DatabaseGetCountriesContext(context.Background(), params)
+}
+
+// DatabaseGetCountriesContext is the same as DatabaseGetCountries, but takes a context.
+func (vk *VK) DatabaseGetCountriesContext(ctx context.Context, params Params) (response DatabaseGetCountriesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getCountries", params, &response)
 	return
 }
 
@@ -64,7 +86,12 @@ type DatabaseGetCountriesByIDResponse []object.BaseObject
 //
 // https://vk.com/dev/database.getCountriesByID
 func (vk *VK) DatabaseGetCountriesByID(params Params) (response DatabaseGetCountriesByIDResponse, err error) {
-	err = vk.RequestUnmarshal("database.getCountriesById", params, &response)
+	return vk.DatabaseGetCountriesByIDContext(context.Background(), params)
+}
+
+// DatabaseGetCountriesByIDContext is the same as DatabaseGetCountriesByID, but takes a context.
+func (vk *VK) DatabaseGetCountriesByIDContext(ctx context.Context, params Params) (response DatabaseGetCountriesByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getCountriesById", params, &response)
 	return
 }
 
@@ -78,7 +105,12 @@ type DatabaseGetFacultiesResponse struct {
 //
 // https://vk.com/dev/database.getFaculties
 func (vk *VK) DatabaseGetFaculties(params Params) (response DatabaseGetFacultiesResponse, err error) {
-	err = vk.RequestUnmarshal("database.getFaculties", params, &response)
+	return vk.DatabaseGetFacultiesContext(context.Background(), params)
+}
+
+// DatabaseGetFacultiesContext is the same as DatabaseGetFaculties, but takes a context.
+func (vk *VK) DatabaseGetFacultiesContext(ctx context.Context, params Params) (response DatabaseGetFacultiesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getFaculties", params, &response)
 	return
 }
 
@@ -92,7 +124,12 @@ type DatabaseGetMetroStationsResponse struct {
 //
 // https://vk.com/dev/database.getMetroStations
 func (vk *VK) DatabaseGetMetroStations(params Params) (response DatabaseGetMetroStationsResponse, err error) {
-	err = vk.RequestUnmarshal("database.getMetroStations", params, &response)
+	return vk.DatabaseGetMetroStationsContext(context.Background(), params)
+}
+
+// DatabaseGetMetroStationsContext is the same as DatabaseGetMetroStations, but takes a context.
+func (vk *VK) DatabaseGetMetroStationsContext(ctx context.Context, params Params) (response DatabaseGetMetroStationsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getMetroStations", params, &response)
 	return
 }
 
@@ -103,7 +140,12 @@ type DatabaseGetMetroStationsByIDResponse []object.DatabaseMetroStation
 //
 // https://vk.com/dev/database.getMetroStationsById
 func (vk *VK) DatabaseGetMetroStationsByID(params Params) (response DatabaseGetMetroStationsByIDResponse, err error) {
-	err = vk.RequestUnmarshal("database.getMetroStationsById", params, &response)
+	return vk.DatabaseGetMetroStationsByIDContext(context.Background(), params)
+}
+
+// DatabaseGetMetroStationsByIDContext is the same as DatabaseGetMetroStationsByID, but takes a context.
+func (vk *VK) DatabaseGetMetroStationsByIDContext(ctx context.Context, params Params) (response DatabaseGetMetroStationsByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getMetroStationsById", params, &response)
 	return
 }
 
@@ -117,7 +159,12 @@ type DatabaseGetRegionsResponse struct {
 //
 // https://vk.com/dev/database.getRegions
 func (vk *VK) DatabaseGetRegions(params Params) (response DatabaseGetRegionsResponse, err error) {
-	err = vk.RequestUnmarshal("database.getRegions", params, &response)
+	return vk.DatabaseGetRegionsContext(context.Background(), params)
+}
+
+// DatabaseGetRegionsContext is the same as DatabaseGetRegions, but takes a context.
+func (vk *VK) DatabaseGetRegionsContext(ctx context.Context, params Params) (response DatabaseGetRegionsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getRegions", params, &response)
 	return
 }
 
@@ -130,7 +177,12 @@ type DatabaseGetSchoolClassesResponse [][]interface{}
 //
 // https://vk.com/dev/database.getSchoolClasses
 func (vk *VK) DatabaseGetSchoolClasses(params Params) (response DatabaseGetSchoolClassesResponse, err error) {
-	err = vk.RequestUnmarshal("database.getSchoolClasses", params, &response)
+	return vk.DatabaseGetSchoolClassesContext(context.Background(), params)
+}
+
+// DatabaseGetSchoolClassesContext is the same as DatabaseGetSchoolClasses, but takes a context.
+func (vk *VK) DatabaseGetSchoolClassesContext(ctx context.Context, params Params) (response DatabaseGetSchoolClassesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getSchoolClasses", params, &response)
 	return
 }
 
@@ -144,7 +196,12 @@ type DatabaseGetSchoolsResponse struct {
 //
 // https://vk.com/dev/database.getSchools
 func (vk *VK) DatabaseGetSchools(params Params) (response DatabaseGetSchoolsResponse, err error) {
-	err = vk.RequestUnmarshal("database.getSchools", params, &response)
+	return vk.DatabaseGetSchoolsContext(context.Background(), params)
+}
+
+// DatabaseGetSchoolsContext is the same as DatabaseGetSchools, but takes a context.
+func (vk *VK) DatabaseGetSchoolsContext(ctx context.Context, params Params) (response DatabaseGetSchoolsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getSchools", params, &response)
 	return
 }
 
@@ -158,6 +215,11 @@ type DatabaseGetUniversitiesResponse struct {
 //
 // https://vk.com/dev/database.getUniversities
 func (vk *VK) DatabaseGetUniversities(params Params) (response DatabaseGetUniversitiesResponse, err error) {
-	err = vk.RequestUnmarshal("database.getUniversities", params, &response)
+	return vk.DatabaseGetUniversitiesContext(context.Background(), params)
+}
+
+// DatabaseGetUniversitiesContext is the same as DatabaseGetUniversities, but takes a context.
+func (vk *VK) DatabaseGetUniversitiesContext(ctx context.Context, params Params) (response DatabaseGetUniversitiesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "database.getUniversities", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/docs.add
 func (vk *VK) DocsAdd(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("docs.add", params, &response)
+	return vk.DocsAddContext(context.Background(), params)
+}
+
+// DocsAddContext is the same as DocsAdd, but takes a context.
+func (vk *VK) DocsAddContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.add", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) DocsAdd(params Params) (response int, err error) {
 //
 // https://vk.com/dev/docs.delete
 func (vk *VK) DocsDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("docs.delete", params, &response)
+	return vk.DocsDeleteContext(context.Background(), params)
+}
+
+// DocsDeleteContext is the same as DocsDelete, but takes a context.
+func (vk *VK) DocsDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.delete", params, &response)
 	return
 }
 
@@ -24,7 +36,12 @@ func (vk *VK) DocsDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/docs.edit
 func (vk *VK) DocsEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("docs.edit", params, &response)
+	return vk.DocsEditContext(context.Background(), params)
+}
+
+// DocsEditContext is the same as DocsEdit, but takes a context.
+func (vk *VK) DocsEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.edit", params, &response)
 	return
 }
 
@@ -38,7 +55,12 @@ type DocsGetResponse struct {
 //
 // https://vk.com/dev/docs.get
 func (vk *VK) DocsGet(params Params) (response DocsGetResponse, err error) {
-	err = vk.RequestUnmarshal("docs.get", params, &response)
+	return vk.DocsGetContext(context.Background(), params)
+}
+
+// DocsGetContext is the same as DocsGet, but takes a context.
+func (vk *VK) DocsGetContext(ctx context.Context, params Params) (response DocsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.get", params, &response)
 	return
 }
 
@@ -49,7 +71,12 @@ type DocsGetByIDResponse []object.DocsDoc
 //
 // https://vk.com/dev/docs.getById
 func (vk *VK) DocsGetByID(params Params) (response DocsGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("docs.getById", params, &response)
+	return vk.DocsGetByIDContext(context.Background(), params)
+}
+
+// DocsGetByIDContext is the same as DocsGetByID, but takes a context.
+func (vk *VK) DocsGetByIDContext(ctx context.Context, params Params) (response DocsGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.getById", params, &response)
 	return
 }
 
@@ -62,7 +89,12 @@ type DocsGetMessagesUploadServerResponse struct {
 //
 // https://vk.com/dev/docs.getMessagesUploadServer
 func (vk *VK) DocsGetMessagesUploadServer(params Params) (response DocsGetMessagesUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("docs.getMessagesUploadServer", params, &response)
+	return vk.DocsGetMessagesUploadServerContext(context.Background(), params)
+}
+
+// DocsGetMessagesUploadServerContext is the same as DocsGetMessagesUploadServer, but takes a context.
+func (vk *VK) DocsGetMessagesUploadServerContext(ctx context.Context, params Params) (response DocsGetMessagesUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.getMessagesUploadServer", params, &response)
 	return
 }
 
@@ -76,7 +108,12 @@ type DocsGetTypesResponse struct {
 //
 // https://vk.com/dev/docs.getTypes
 func (vk *VK) DocsGetTypes(params Params) (response DocsGetTypesResponse, err error) {
-	err = vk.RequestUnmarshal("docs.getTypes", params, &response)
+	return vk.DocsGetTypesContext(context.Background(), params)
+}
+
+// DocsGetTypesContext is the same as DocsGetTypes, but takes a context.
+func (vk *VK) DocsGetTypesContext(ctx context.Context, params Params) (response DocsGetTypesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.getTypes", params, &response)
 	return
 }
 
@@ -89,7 +126,12 @@ type DocsGetUploadServerResponse struct {
 //
 // https://vk.com/dev/docs.getUploadServer
 func (vk *VK) DocsGetUploadServer(params Params) (response DocsGetUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("docs.getUploadServer", params, &response)
+	return vk.DocsGetUploadServerContext(context.Background(), params)
+}
+
+// DocsGetUploadServerContext is the same as DocsGetUploadServer, but takes a context.
+func (vk *VK) DocsGetUploadServerContext(ctx context.Context, params Params) (response DocsGetUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.getUploadServer", params, &response)
 	return
 }
 
@@ -102,7 +144,12 @@ type DocsGetWallUploadServerResponse struct {
 //
 // https://vk.com/dev/docs.getWallUploadServer
 func (vk *VK) DocsGetWallUploadServer(params Params) (response DocsGetWallUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("docs.getWallUploadServer", params, &response)
+	return vk.DocsGetWallUploadServerContext(context.Background(), params)
+}
+
+// DocsGetWallUploadServerContext is the same as DocsGetWallUploadServer, but takes a context.
+func (vk *VK) DocsGetWallUploadServerContext(ctx context.Context, params Params) (response DocsGetWallUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.getWallUploadServer", params, &response)
 	return
 }
 
@@ -118,7 +165,12 @@ type DocsSaveResponse struct {
 //
 // https://vk.com/dev/docs.save
 func (vk *VK) DocsSave(params Params) (response DocsSaveResponse, err error) {
-	err = vk.RequestUnmarshal("docs.save", params, &response)
+	return vk.DocsSaveContext(context.Background(), params)
+}
+
+// DocsSaveContext is the same as DocsSave, but takes a context.
+func (vk *VK) DocsSaveContext(ctx context.Context, params Params) (response DocsSaveResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.save", params, &response)
 	return
 }
 
@@ -132,6 +184,11 @@ type DocsSearchResponse struct {
 //
 // https://vk.com/dev/docs.search
 func (vk *VK) DocsSearch(params Params) (response DocsSearchResponse, err error) {
-	err = vk.RequestUnmarshal("docs.search", params, &response)
+	return vk.DocsSearchContext(context.Background(), params)
+}
+
+// DocsSearchContext is the same as DocsSearch, but takes a context.
+func (vk *VK) DocsSearchContext(ctx context.Context, params Params) (response DocsSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "docs.search", params, &response)
 	return
 }
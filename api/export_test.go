@@ -0,0 +1,19 @@
+package api
+
+import "time"
+
+// Buckets returns the number of buckets of the limiter.
+func (l *TokenBucketLimiter) Buckets() int {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return len(l.buckets)
+}
+
+// Sweep removes idle buckets as if the current time is now.
+func (l *TokenBucketLimiter) Sweep(now time.Time) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.sweep(now)
+}
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/fave.addArticle
 func (vk *VK) FaveAddArticle(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addArticle", params, &response)
+	return vk.FaveAddArticleContext(context.Background(), params)
+}
+
+// FaveAddArticleContext is the same as FaveAddArticle, but takes a context.
+func (vk *VK) FaveAddArticleContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addArticle", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) FaveAddArticle(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.addLink
 func (vk *VK) FaveAddLink(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addLink", params, &response)
+	return vk.FaveAddLinkContext(context.Background(), params)
+}
+
+// FaveAddLinkContext is the same as FaveAddLink, but takes a context.
+func (vk *VK) FaveAddLinkContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addLink", params, &response)
 	return
 }
 
@@ -24,7 +36,12 @@ func (vk *VK) FaveAddLink(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.addPage
 func (vk *VK) FaveAddPage(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addPage", params, &response)
+	return vk.FaveAddPageContext(context.Background(), params)
+}
+
+// FaveAddPageContext is the same as FaveAddPage, but takes a context.
+func (vk *VK) FaveAddPageContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addPage", params, &response)
 	return
 }
 
@@ -32,7 +49,12 @@ func (vk *VK) FaveAddPage(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.addPost
 func (vk *VK) FaveAddPost(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addPost", params, &response)
+	return vk.FaveAddPostContext(context.Background(), params)
+}
+
+// FaveAddPostContext is the same as FaveAddPost, but takes a context.
+func (vk *VK) FaveAddPostContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addPost", params, &response)
 	return
 }
 
@@ -40,7 +62,12 @@ func (vk *VK) FaveAddPost(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.addProduct
 func (vk *VK) FaveAddProduct(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addProduct", params, &response)
+	return vk.FaveAddProductContext(context.Background(), params)
+}
+
+// FaveAddProductContext is the same as FaveAddProduct, but takes a context.
+func (vk *VK) FaveAddProductContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addProduct", params, &response)
 	return
 }
 
@@ -51,7 +78,12 @@ type FaveAddTagResponse object.FaveTag
 //
 // https://vk.com/dev/fave.addTag
 func (vk *VK) FaveAddTag(params Params) (response FaveAddTagResponse, err error) {
-	err = vk.RequestUnmarshal("fave.addTag", params, &response)
+	return vk.FaveAddTagContext(context.Background(), params)
+}
+
+// FaveAddTagContext is the same as FaveAddTag, but takes a context.
+func (vk *VK) FaveAddTagContext(ctx context.Context, params Params) (response FaveAddTagResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addTag", params, &response)
 	return
 }
 
@@ -59,7 +91,12 @@ func (vk *VK) FaveAddTag(params Params) (response FaveAddTagResponse, err error)
 //
 // https://vk.com/dev/fave.addVideo
 func (vk *VK) FaveAddVideo(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.addVideo", params, &response)
+	return vk.FaveAddVideoContext(context.Background(), params)
+}
+
+// FaveAddVideoContext is the same as FaveAddVideo, but takes a context.
+func (vk *VK) FaveAddVideoContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.addVideo", params, &response)
 	return
 }
 
@@ -67,7 +104,12 @@ func (vk *VK) FaveAddVideo(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.editTag
 func (vk *VK) FaveEditTag(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.editTag", params, &response)
+	return vk.FaveEditTagContext(context.Background(), params)
+}
+
+// FaveEditTagContext is the same as FaveEditTag, but takes a context.
+func (vk *VK) FaveEditTagContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.editTag", params, &response)
 	return
 }
 
@@ -83,8 +125,13 @@ type FaveGetResponse struct {
 //
 // https://vk.com/dev/fave.get
 func (vk *VK) FaveGet(params Params) (response FaveGetResponse, err error) {
+	return vk.FaveGetContext(context.Background(), params)
+}
+
+// FaveGetContext is the same as FaveGet, but takes a context.
+func (vk *VK) FaveGetContext(ctx context.Context, params Params) (response FaveGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("fave.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "fave.get", params, &response)
 
 	return
 }
@@ -102,8 +149,13 @@ type FaveGetExtendedResponse struct {
 //
 // https://vk.com/dev/fave.get
 func (vk *VK) FaveGetExtended(params Params) (response FaveGetExtendedResponse, err error) {
+	return vk.FaveGetExtendedContext(context.Background(), params)
+}
+
+// FaveGetExtendedContext is the same as FaveGetExtended, but takes a context.
+func (vk *VK) FaveGetExtendedContext(ctx context.Context, params Params) (response FaveGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("fave.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "fave.get", params, &response)
 
 	return
 }
@@ -118,7 +170,12 @@ type FaveGetPagesResponse struct {
 //
 // https://vk.com/dev/fave.getPages
 func (vk *VK) FaveGetPages(params Params) (response FaveGetPagesResponse, err error) {
-	err = vk.RequestUnmarshal("fave.getPages", params, &response)
+	return vk.FaveGetPagesContext(context.Background(), params)
+}
+
+// FaveGetPagesContext is the same as FaveGetPages, but takes a context.
+func (vk *VK) FaveGetPagesContext(ctx context.Context, params Params) (response FaveGetPagesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.getPages", params, &response)
 	return
 }
 
@@ -132,7 +189,12 @@ type FaveGetTagsResponse struct {
 //
 // https://vk.com/dev/fave.getTags
 func (vk *VK) FaveGetTags(params Params) (response FaveGetTagsResponse, err error) {
-	err = vk.RequestUnmarshal("fave.getTags", params, &response)
+	return vk.FaveGetTagsContext(context.Background(), params)
+}
+
+// FaveGetTagsContext is the same as FaveGetTags, but takes a context.
+func (vk *VK) FaveGetTagsContext(ctx context.Context, params Params) (response FaveGetTagsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.getTags", params, &response)
 	return
 }
 
@@ -140,7 +202,12 @@ func (vk *VK) FaveGetTags(params Params) (response FaveGetTagsResponse, err erro
 //
 // https://vk.com/dev/fave.markSeen
 func (vk *VK) FaveMarkSeen(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.markSeen", params, &response)
+	return vk.FaveMarkSeenContext(context.Background(), params)
+}
+
+// FaveMarkSeenContext is the same as FaveMarkSeen, but takes a context.
+func (vk *VK) FaveMarkSeenContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.markSeen", params, &response)
 	return
 }
 
@@ -148,7 +215,12 @@ func (vk *VK) FaveMarkSeen(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removeArticle
 func (vk *VK) FaveRemoveArticle(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removeArticle", params, &response)
+	return vk.FaveRemoveArticleContext(context.Background(), params)
+}
+
+// FaveRemoveArticleContext is the same as FaveRemoveArticle, but takes a context.
+func (vk *VK) FaveRemoveArticleContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removeArticle", params, &response)
 	return
 }
 
@@ -156,7 +228,12 @@ func (vk *VK) FaveRemoveArticle(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removeLink
 func (vk *VK) FaveRemoveLink(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removeLink", params, &response)
+	return vk.FaveRemoveLinkContext(context.Background(), params)
+}
+
+// FaveRemoveLinkContext is the same as FaveRemoveLink, but takes a context.
+func (vk *VK) FaveRemoveLinkContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removeLink", params, &response)
 	return
 }
 
@@ -164,7 +241,12 @@ func (vk *VK) FaveRemoveLink(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removePage
 func (vk *VK) FaveRemovePage(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removePage", params, &response)
+	return vk.FaveRemovePageContext(context.Background(), params)
+}
+
+// FaveRemovePageContext is the same as FaveRemovePage, but takes a context.
+func (vk *VK) FaveRemovePageContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removePage", params, &response)
 	return
 }
 
@@ -172,7 +254,12 @@ func (vk *VK) FaveRemovePage(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removePost
 func (vk *VK) FaveRemovePost(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removePost", params, &response)
+	return vk.FaveRemovePostContext(context.Background(), params)
+}
+
+// FaveRemovePostContext is the same as FaveRemovePost, but takes a context.
+func (vk *VK) FaveRemovePostContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removePost", params, &response)
 	return
 }
 
@@ -180,7 +267,12 @@ func (vk *VK) FaveRemovePost(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removeProduct
 func (vk *VK) FaveRemoveProduct(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removeProduct", params, &response)
+	return vk.FaveRemoveProductContext(context.Background(), params)
+}
+
+// FaveRemoveProductContext is the same as FaveRemoveProduct, but takes a context.
+func (vk *VK) FaveRemoveProductContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removeProduct", params, &response)
 	return
 }
 
@@ -188,7 +280,12 @@ func (vk *VK) FaveRemoveProduct(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removeTag
 func (vk *VK) FaveRemoveTag(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removeTag", params, &response)
+	return vk.FaveRemoveTagContext(context.Background(), params)
+}
+
+// FaveRemoveTagContext is the same as FaveRemoveTag, but takes a context.
+func (vk *VK) FaveRemoveTagContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removeTag", params, &response)
 	return
 }
 
@@ -196,7 +293,12 @@ func (vk *VK) FaveRemoveTag(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.removeVideo
 func (vk *VK) FaveRemoveVideo(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.removeVideo", params, &response)
+	return vk.FaveRemoveVideoContext(context.Background(), params)
+}
+
+// FaveRemoveVideoContext is the same as FaveRemoveVideo, but takes a context.
+func (vk *VK) FaveRemoveVideoContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.removeVideo", params, &response)
 	return
 }
 
@@ -204,7 +306,12 @@ func (vk *VK) FaveRemoveVideo(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.reorderTags
 func (vk *VK) FaveReorderTags(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.reorderTags", params, &response)
+	return vk.FaveReorderTagsContext(context.Background(), params)
+}
+
+// FaveReorderTagsContext is the same as FaveReorderTags, but takes a context.
+func (vk *VK) FaveReorderTagsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.reorderTags", params, &response)
 	return
 }
 
@@ -212,7 +319,12 @@ func (vk *VK) FaveReorderTags(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.setPageTags
 func (vk *VK) FaveSetPageTags(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.setPageTags", params, &response)
+	return vk.FaveSetPageTagsContext(context.Background(), params)
+}
+
+// FaveSetPageTagsContext is the same as FaveSetPageTags, but takes a context.
+func (vk *VK) FaveSetPageTagsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.setPageTags", params, &response)
 	return
 }
 
@@ -220,7 +332,12 @@ func (vk *VK) FaveSetPageTags(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.setTags
 func (vk *VK) FaveSetTags(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.setTags", params, &response)
+	return vk.FaveSetTagsContext(context.Background(), params)
+}
+
+// FaveSetTagsContext is the same as FaveSetTags, but takes a context.
+func (vk *VK) FaveSetTagsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.setTags", params, &response)
 	return
 }
 
@@ -228,6 +345,11 @@ func (vk *VK) FaveSetTags(params Params) (response int, err error) {
 //
 // https://vk.com/dev/fave.trackPageInteraction
 func (vk *VK) FaveTrackPageInteraction(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("fave.trackPageInteraction", params, &response)
+	return vk.FaveTrackPageInteractionContext(context.Background(), params)
+}
+
+// FaveTrackPageInteractionContext is the same as FaveTrackPageInteraction, but takes a context.
+func (vk *VK) FaveTrackPageInteractionContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "fave.trackPageInteraction", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/friends.add
 func (vk *VK) FriendsAdd(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("friends.add", params, &response)
+	return vk.FriendsAddContext(context.Background(), params)
+}
+
+// FriendsAddContext is the same as FriendsAdd, but takes a context.
+func (vk *VK) FriendsAddContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.add", params, &response)
 	return
 }
 
@@ -21,7 +28,12 @@ type FriendsAddListResponse struct {
 //
 // https://vk.com/dev/friends.addList
 func (vk *VK) FriendsAddList(params Params) (response FriendsAddListResponse, err error) {
-	err = vk.RequestUnmarshal("friends.addList", params, &response)
+	return vk.FriendsAddListContext(context.Background(), params)
+}
+
+// FriendsAddListContext is the same as FriendsAddList, but takes a context.
+func (vk *VK) FriendsAddListContext(ctx context.Context, params Params) (response FriendsAddListResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.addList", params, &response)
 	return
 }
 
@@ -32,7 +44,12 @@ type FriendsAreFriendsResponse []object.FriendsFriendStatus
 //
 // https://vk.com/dev/friends.areFriends
 func (vk *VK) FriendsAreFriends(params Params) (response FriendsAreFriendsResponse, err error) {
-	err = vk.RequestUnmarshal("friends.areFriends", params, &response)
+	return vk.FriendsAreFriendsContext(context.Background(), params)
+}
+
+// FriendsAreFriendsContext is the same as FriendsAreFriends, but takes a context.
+func (vk *VK) FriendsAreFriendsContext(ctx context.Context, params Params) (response FriendsAreFriendsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.areFriends", params, &response)
 	return
 }
 
@@ -49,7 +66,12 @@ type FriendsDeleteResponse struct {
 //
 // https://vk.com/dev/friends.delete
 func (vk *VK) FriendsDelete(params Params) (response FriendsDeleteResponse, err error) {
-	err = vk.RequestUnmarshal("friends.delete", params, &response)
+	return vk.FriendsDeleteContext(context.Background(), params)
+}
+
+// FriendsDeleteContext is the same as FriendsDelete, but takes a context.
+func (vk *VK) FriendsDeleteContext(ctx context.Context, params Params) (response FriendsDeleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.delete", params, &response)
 	return
 }
 
@@ -57,7 +79,12 @@ func (vk *VK) FriendsDelete(params Params) (response FriendsDeleteResponse, err
 //
 // https://vk.com/dev/friends.deleteAllRequests
 func (vk *VK) FriendsDeleteAllRequests(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("friends.deleteAllRequests", params, &response)
+	return vk.FriendsDeleteAllRequestsContext(context.Background(), params)
+}
+
+// FriendsDeleteAllRequestsContext is the same as FriendsDeleteAllRequests, but takes a context.
+func (vk *VK) FriendsDeleteAllRequestsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.deleteAllRequests", params, &response)
 	return
 }
 
@@ -65,7 +92,12 @@ func (vk *VK) FriendsDeleteAllRequests(params Params) (response int, err error)
 //
 // https://vk.com/dev/friends.deleteList
 func (vk *VK) FriendsDeleteList(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("friends.deleteList", params, &response)
+	return vk.FriendsDeleteListContext(context.Background(), params)
+}
+
+// FriendsDeleteListContext is the same as FriendsDeleteList, but takes a context.
+func (vk *VK) FriendsDeleteListContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.deleteList", params, &response)
 	return
 }
 
@@ -73,7 +105,12 @@ func (vk *VK) FriendsDeleteList(params Params) (response int, err error) {
 //
 // https://vk.com/dev/friends.edit
 func (vk *VK) FriendsEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("friends.edit", params, &response)
+	return vk.FriendsEditContext(context.Background(), params)
+}
+
+// FriendsEditContext is the same as FriendsEdit, but takes a context.
+func (vk *VK) FriendsEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.edit", params, &response)
 	return
 }
 
@@ -81,7 +118,12 @@ func (vk *VK) FriendsEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/friends.editList
 func (vk *VK) FriendsEditList(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("friends.editList", params, &response)
+	return vk.FriendsEditListContext(context.Background(), params)
+}
+
+// FriendsEditListContext is the same as FriendsEditList, but takes a context.
+func (vk *VK) FriendsEditListContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.editList", params, &response)
 	return
 }
 
@@ -95,7 +137,12 @@ type FriendsGetResponse struct {
 //
 // https://vk.com/dev/friends.get
 func (vk *VK) FriendsGet(params Params) (response FriendsGetResponse, err error) {
-	err = vk.RequestUnmarshal("friends.get", params, &response)
+	return vk.FriendsGetContext(context.Background(), params)
+}
+
+// FriendsGetContext is the same as FriendsGet, but takes a context.
+func (vk *VK) FriendsGetContext(ctx context.Context, params Params) (response FriendsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.get", params, &response)
 	return
 }
 
@@ -109,11 +156,16 @@ type FriendsGetFieldsResponse struct {
 //
 // https://vk.com/dev/friends.get
 func (vk *VK) FriendsGetFields(params Params) (response FriendsGetFieldsResponse, err error) {
+	return vk.FriendsGetFieldsContext(context.Background(), params)
+}
+
+// FriendsGetFieldsContext is the same as FriendsGetFields, but takes a context.
+func (vk *VK) FriendsGetFieldsContext(ctx context.Context, params Params) (response FriendsGetFieldsResponse, err error) {
 	if v, prs := params["fields"]; v == "" || !prs {
 		params["fields"] = "id"
 	}
 
-	err = vk.RequestUnmarshal("friends.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.get", params, &response)
 
 	return
 }
@@ -125,7 +177,12 @@ type FriendsGetAppUsersResponse []int
 //
 // https://vk.com/dev/friends.getAppUsers
 func (vk *VK) FriendsGetAppUsers(params Params) (response FriendsGetAppUsersResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getAppUsers", params, &response)
+	return vk.FriendsGetAppUsersContext(context.Background(), params)
+}
+
+// FriendsGetAppUsersContext is the same as FriendsGetAppUsers, but takes a context.
+func (vk *VK) FriendsGetAppUsersContext(ctx context.Context, params Params) (response FriendsGetAppUsersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getAppUsers", params, &response)
 	return
 }
 
@@ -137,7 +194,12 @@ type FriendsGetByPhonesResponse []object.FriendsUserXtrPhone
 //
 // https://vk.com/dev/friends.getByPhones
 func (vk *VK) FriendsGetByPhones(params Params) (response FriendsGetByPhonesResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getByPhones", params, &response)
+	return vk.FriendsGetByPhonesContext(context.Background(), params)
+}
+
+// FriendsGetByPhonesContext is the same as FriendsGetByPhones, but takes a context.
+func (vk *VK) FriendsGetByPhonesContext(ctx context.Context, params Params) (response FriendsGetByPhonesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getByPhones", params, &response)
 	return
 }
 
@@ -151,7 +213,12 @@ type FriendsGetListsResponse struct {
 //
 // https://vk.com/dev/friends.getLists
 func (vk *VK) FriendsGetLists(params Params) (response FriendsGetListsResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getLists", params, &response)
+	return vk.FriendsGetListsContext(context.Background(), params)
+}
+
+// FriendsGetListsContext is the same as FriendsGetLists, but takes a context.
+func (vk *VK) FriendsGetListsContext(ctx context.Context, params Params) (response FriendsGetListsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getLists", params, &response)
 	return
 }
 
@@ -162,7 +229,12 @@ type FriendsGetMutualResponse []int
 //
 // https://vk.com/dev/friends.getMutual
 func (vk *VK) FriendsGetMutual(params Params) (response FriendsGetMutualResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getMutual", params, &response)
+	return vk.FriendsGetMutualContext(context.Background(), params)
+}
+
+// FriendsGetMutualContext is the same as FriendsGetMutual, but takes a context.
+func (vk *VK) FriendsGetMutualContext(ctx context.Context, params Params) (response FriendsGetMutualResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getMutual", params, &response)
 	return
 }
 
@@ -172,8 +244,13 @@ func (vk *VK) FriendsGetMutual(params Params) (response FriendsGetMutualResponse
 //
 // https://vk.com/dev/friends.getOnline
 func (vk *VK) FriendsGetOnline(params Params) (response []int, err error) {
+	return vk.FriendsGetOnlineContext(context.Background(), params)
+}
+
+// FriendsGetOnlineContext is the same as FriendsGetOnline, but takes a context.
+func (vk *VK) FriendsGetOnlineContext(ctx context.Context, params Params) (response []int, err error) {
 	params["online_mobile"] = false
-	err = vk.RequestUnmarshal("friends.getOnline", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.getOnline", params, &response)
 
 	return
 }
@@ -190,8 +267,13 @@ type FriendsGetOnlineOnlineMobileResponse struct {
 //
 // https://vk.com/dev/friends.getOnline
 func (vk *VK) FriendsGetOnlineOnlineMobile(params Params) (response FriendsGetOnlineOnlineMobileResponse, err error) {
+	return vk.FriendsGetOnlineOnlineMobileContext(context.Background(), params)
+}
+
+// FriendsGetOnlineOnlineMobileContext is the same as FriendsGetOnlineOnlineMobile, but takes a context.
+func (vk *VK) FriendsGetOnlineOnlineMobileContext(ctx context.Context, params Params) (response FriendsGetOnlineOnlineMobileResponse, err error) {
 	params["online_mobile"] = true
-	err = vk.RequestUnmarshal("friends.getOnline", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.getOnline", params, &response)
 
 	return
 }
@@ -203,7 +285,12 @@ type FriendsGetRecentResponse []int
 //
 // https://vk.com/dev/friends.getRecent
 func (vk *VK) FriendsGetRecent(params Params) (response FriendsGetRecentResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getRecent", params, &response)
+	return vk.FriendsGetRecentContext(context.Background(), params)
+}
+
+// FriendsGetRecentContext is the same as FriendsGetRecent, but takes a context.
+func (vk *VK) FriendsGetRecentContext(ctx context.Context, params Params) (response FriendsGetRecentResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getRecent", params, &response)
 	return
 }
 
@@ -217,9 +304,14 @@ type FriendsGetRequestsResponse struct {
 //
 // https://vk.com/dev/friends.getRequests
 func (vk *VK) FriendsGetRequests(params Params) (response FriendsGetRequestsResponse, err error) {
+	return vk.FriendsGetRequestsContext(context.Background(), params)
+}
+
+// FriendsGetRequestsContext is the same as FriendsGetRequests, but takes a context.
+func (vk *VK) FriendsGetRequestsContext(ctx context.Context, params Params) (response FriendsGetRequestsResponse, err error) {
 	params["need_mutual"] = false
 	params["extended"] = false
-	err = vk.RequestUnmarshal("friends.getRequests", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.getRequests", params, &response)
 
 	return
 }
@@ -234,9 +326,14 @@ type FriendsGetRequestsNeedMutualResponse struct {
 //
 // https://vk.com/dev/friends.getRequests
 func (vk *VK) FriendsGetRequestsNeedMutual(params Params) (response FriendsGetRequestsNeedMutualResponse, err error) {
+	return vk.FriendsGetRequestsNeedMutualContext(context.Background(), params)
+}
+
+// FriendsGetRequestsNeedMutualContext is the same as FriendsGetRequestsNeedMutual, but takes a context.
+func (vk *VK) FriendsGetRequestsNeedMutualContext(ctx context.Context, params Params) (response FriendsGetRequestsNeedMutualResponse, err error) {
 	params["need_mutual"] = true
 	params["extended"] = false
-	err = vk.RequestUnmarshal("friends.getRequests", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.getRequests", params, &response)
 
 	return
 }
@@ -251,9 +348,14 @@ type FriendsGetRequestsExtendedResponse struct {
 //
 // https://vk.com/dev/friends.getRequests
 func (vk *VK) FriendsGetRequestsExtended(params Params) (response FriendsGetRequestsExtendedResponse, err error) {
+	return vk.FriendsGetRequestsExtendedContext(context.Background(), params)
+}
+
+// FriendsGetRequestsExtendedContext is the same as FriendsGetRequestsExtended, but takes a context.
+func (vk *VK) FriendsGetRequestsExtendedContext(ctx context.Context, params Params) (response FriendsGetRequestsExtendedResponse, err error) {
 	params["need_mutual"] = false
 	params["extended"] = true
-	err = vk.RequestUnmarshal("friends.getRequests", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "friends.getRequests", params, &response)
 
 	return
 }
@@ -268,7 +370,12 @@ type FriendsGetSuggestionsResponse struct {
 //
 // https://vk.com/dev/friends.getSuggestions
 func (vk *VK) FriendsGetSuggestions(params Params) (response FriendsGetSuggestionsResponse, err error) {
-	err = vk.RequestUnmarshal("friends.getSuggestions", params, &response)
+	return vk.FriendsGetSuggestionsContext(context.Background(), params)
+}
+
+// FriendsGetSuggestionsContext is the same as FriendsGetSuggestions, but takes a context.
+func (vk *VK) FriendsGetSuggestionsContext(ctx context.Context, params Params) (response FriendsGetSuggestionsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.getSuggestions", params, &response)
 	return
 }
 
@@ -282,6 +389,11 @@ type FriendsSearchResponse struct {
 //
 // https://vk.com/dev/friends.search
 func (vk *VK) FriendsSearch(params Params) (response FriendsSearchResponse, err error) {
-	err = vk.RequestUnmarshal("friends.search", params, &response)
+	return vk.FriendsSearchContext(context.Background(), params)
+}
+
+// FriendsSearchContext is the same as FriendsSearch, but takes a context.
+func (vk *VK) FriendsSearchContext(ctx context.Context, params Params) (response FriendsSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "friends.search", params, &response)
 	return
 }
@@ -0,0 +1,176 @@
+//go:build ignore
+// +build ignore
+
+// gen_batch generates batch_methods.go from API methods of VK.
+//
+// For every method
+//
+// 	func (vk *VK) XContext(ctx context.Context, params Params) (response T, err error)
+//
+// which calls vk.RequestUnmarshalContext, it generates
+//
+// 	func (b *Batch) X(params Params, response *T) *BatchCall
+//
+// Statements before the request, e.g. default params, are copied.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type method struct {
+	name     string
+	apiName  string
+	response string
+	stmts    []string
+}
+
+func main() {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "gen_batch.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+
+	for _, file := range pkgs["api"].Files {
+		for _, decl := range file.Decls {
+			if m, ok := parseMethod(fset, decl); ok {
+				methods = append(methods, m)
+			}
+		}
+	}
+
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by gen_batch.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package api // import \"github.com/SevereCloud/vksdk/api\"\n\n")
+	buf.WriteString("import (\n\t\"github.com/SevereCloud/vksdk/object\"\n)\n")
+
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\n// %s adds %s to the batch.\n", m.name, m.apiName)
+		fmt.Fprintf(&buf, "func (b *Batch) %s(params Params, response *%s) *BatchCall {\n", m.name, m.response)
+
+		for _, stmt := range m.stmts {
+			fmt.Fprintf(&buf, "\t%s\n", stmt)
+		}
+
+		if len(m.stmts) > 0 {
+			buf.WriteString("\n")
+		}
+
+		fmt.Fprintf(&buf, "\treturn b.Add(%q, params, response)\n}\n", m.apiName)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(".", "batch_methods.go"), src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseMethod returns the method if decl is an API method of VK.
+func parseMethod(fset *token.FileSet, decl ast.Decl) (method, bool) {
+	f, ok := decl.(*ast.FuncDecl)
+	if !ok || f.Recv == nil || f.Body == nil || !strings.HasSuffix(f.Name.Name, "Context") {
+		return method{}, false
+	}
+
+	if f.Type.Params.NumFields() != 2 || f.Type.Results.NumFields() != 2 ||
+		exprString(fset, f.Type.Params.List[len(f.Type.Params.List)-1].Type) != "Params" {
+		return method{}, false
+	}
+
+	m := method{
+		name:     strings.TrimSuffix(f.Name.Name, "Context"),
+		response: exprString(fset, f.Type.Results.List[0].Type),
+	}
+
+	for _, stmt := range f.Body.List {
+		switch s := stmt.(type) {
+		case *ast.ReturnStmt:
+			continue
+		case *ast.AssignStmt:
+			if call, ok := s.Rhs[0].(*ast.CallExpr); ok && m.apiName == "" {
+				apiName, ok := requestMethod(call)
+				if !ok {
+					return method{}, false
+				}
+
+				m.apiName = apiName
+
+				continue
+			}
+		}
+
+		// Statements after the request handle the response
+		if m.apiName != "" {
+			return method{}, false
+		}
+
+		m.stmts = append(m.stmts, stmtString(fset, stmt))
+	}
+
+	return m, m.apiName != ""
+}
+
+// requestMethod returns the name of the API method requested by
+// vk.RequestUnmarshalContext(ctx, "name", params, &response).
+func requestMethod(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "RequestUnmarshalContext" || len(call.Args) != 4 {
+		return "", false
+	}
+
+	if params, ok := call.Args[2].(*ast.Ident); !ok || params.Name != "params" {
+		return "", false
+	}
+
+	lit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	name, err := strconv.Unquote(lit.Value)
+
+	return name, err == nil
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+
+	_ = printer.Fprint(&buf, fset, expr)
+
+	return buf.String()
+}
+
+func stmtString(fset *token.FileSet, stmt ast.Stmt) string {
+	var buf bytes.Buffer
+
+	_ = printer.Fprint(&buf, fset, stmt)
+
+	return buf.String()
+}
@@ -1,6 +1,10 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
-import "github.com/SevereCloud/vksdk/object"
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
 
 // GiftsGetResponse struct.
 type GiftsGetResponse struct {
@@ -12,7 +16,12 @@ type GiftsGetResponse struct {
 //
 // https://vk.com/dev/gifts.get
 func (vk *VK) GiftsGet(params Params) (response GiftsGetResponse, err error) {
-	err = vk.RequestUnmarshal("gifts.get", params, &response)
+	return vk.GiftsGetContext(context.Background(), params)
+}
+
+// GiftsGetContext is the same as GiftsGet, but takes a context.
+func (vk *VK) GiftsGetContext(ctx context.Context, params Params) (response GiftsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "gifts.get", params, &response)
 	return
 }
 
@@ -27,6 +36,11 @@ type GiftsGetCatalogResponse []struct {
 //
 // https://vk.com/dev/gifts.get
 func (vk *VK) GiftsGetCatalog(params Params) (response GiftsGetCatalogResponse, err error) {
-	err = vk.RequestUnmarshal("gifts.getCatalog", params, &response)
+	return vk.GiftsGetCatalogContext(context.Background(), params)
+}
+
+// GiftsGetCatalogContext is the same as GiftsGetCatalog, but takes a context.
+func (vk *VK) GiftsGetCatalogContext(ctx context.Context, params Params) (response GiftsGetCatalogResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "gifts.getCatalog", params, &response)
 	return
 }
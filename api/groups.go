@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -11,7 +13,12 @@ type GroupsAddAddressResponse object.GroupsAddress
 //
 // https://vk.com/dev/groups.addAddress
 func (vk *VK) GroupsAddAddress(params Params) (response GroupsAddAddressResponse, err error) {
-	err = vk.RequestUnmarshal("groups.addAddress", params, &response)
+	return vk.GroupsAddAddressContext(context.Background(), params)
+}
+
+// GroupsAddAddressContext is the same as GroupsAddAddress, but takes a context.
+func (vk *VK) GroupsAddAddressContext(ctx context.Context, params Params) (response GroupsAddAddressResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.addAddress", params, &response)
 	return
 }
 
@@ -24,7 +31,12 @@ type GroupsAddCallbackServerResponse struct {
 //
 // https://vk.com/dev/groups.addCallbackServer
 func (vk *VK) GroupsAddCallbackServer(params Params) (response GroupsAddCallbackServerResponse, err error) {
-	err = vk.RequestUnmarshal("groups.addCallbackServer", params, &response)
+	return vk.GroupsAddCallbackServerContext(context.Background(), params)
+}
+
+// GroupsAddCallbackServerContext is the same as GroupsAddCallbackServer, but takes a context.
+func (vk *VK) GroupsAddCallbackServerContext(ctx context.Context, params Params) (response GroupsAddCallbackServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.addCallbackServer", params, &response)
 	return
 }
 
@@ -35,7 +47,12 @@ type GroupsAddLinkResponse object.GroupsGroupLink
 //
 // https://vk.com/dev/groups.addLink
 func (vk *VK) GroupsAddLink(params Params) (response GroupsAddLinkResponse, err error) {
-	err = vk.RequestUnmarshal("groups.addLink", params, &response)
+	return vk.GroupsAddLinkContext(context.Background(), params)
+}
+
+// GroupsAddLinkContext is the same as GroupsAddLink, but takes a context.
+func (vk *VK) GroupsAddLinkContext(ctx context.Context, params Params) (response GroupsAddLinkResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.addLink", params, &response)
 	return
 }
 
@@ -43,7 +60,12 @@ func (vk *VK) GroupsAddLink(params Params) (response GroupsAddLinkResponse, err
 //
 // https://vk.com/dev/groups.approveRequest
 func (vk *VK) GroupsApproveRequest(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.approveRequest", params, &response)
+	return vk.GroupsApproveRequestContext(context.Background(), params)
+}
+
+// GroupsApproveRequestContext is the same as GroupsApproveRequest, but takes a context.
+func (vk *VK) GroupsApproveRequestContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.approveRequest", params, &response)
 	return
 }
 
@@ -51,7 +73,12 @@ func (vk *VK) GroupsApproveRequest(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.ban
 func (vk *VK) GroupsBan(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.ban", params, &response)
+	return vk.GroupsBanContext(context.Background(), params)
+}
+
+// GroupsBanContext is the same as GroupsBan, but takes a context.
+func (vk *VK) GroupsBanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.ban", params, &response)
 	return
 }
 
@@ -62,7 +89,12 @@ type GroupsCreateResponse object.GroupsGroup
 //
 // https://vk.com/dev/groups.create
 func (vk *VK) GroupsCreate(params Params) (response GroupsCreateResponse, err error) {
-	err = vk.RequestUnmarshal("groups.create", params, &response)
+	return vk.GroupsCreateContext(context.Background(), params)
+}
+
+// GroupsCreateContext is the same as GroupsCreate, but takes a context.
+func (vk *VK) GroupsCreateContext(ctx context.Context, params Params) (response GroupsCreateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.create", params, &response)
 	return
 }
 
@@ -70,7 +102,12 @@ func (vk *VK) GroupsCreate(params Params) (response GroupsCreateResponse, err er
 //
 // https://vk.com/dev/groups.deleteAddress
 func (vk *VK) GroupsDeleteAddress(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.deleteAddress", params, &response)
+	return vk.GroupsDeleteAddressContext(context.Background(), params)
+}
+
+// GroupsDeleteAddressContext is the same as GroupsDeleteAddress, but takes a context.
+func (vk *VK) GroupsDeleteAddressContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.deleteAddress", params, &response)
 	return
 }
 
@@ -78,7 +115,12 @@ func (vk *VK) GroupsDeleteAddress(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.deleteCallbackServer
 func (vk *VK) GroupsDeleteCallbackServer(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.deleteCallbackServer", params, &response)
+	return vk.GroupsDeleteCallbackServerContext(context.Background(), params)
+}
+
+// GroupsDeleteCallbackServerContext is the same as GroupsDeleteCallbackServer, but takes a context.
+func (vk *VK) GroupsDeleteCallbackServerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.deleteCallbackServer", params, &response)
 	return
 }
 
@@ -86,7 +128,12 @@ func (vk *VK) GroupsDeleteCallbackServer(params Params) (response int, err error
 //
 // https://vk.com/dev/groups.deleteLink
 func (vk *VK) GroupsDeleteLink(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.deleteLink", params, &response)
+	return vk.GroupsDeleteLinkContext(context.Background(), params)
+}
+
+// GroupsDeleteLinkContext is the same as GroupsDeleteLink, but takes a context.
+func (vk *VK) GroupsDeleteLinkContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.deleteLink", params, &response)
 	return
 }
 
@@ -94,7 +141,12 @@ func (vk *VK) GroupsDeleteLink(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.disableOnline
 func (vk *VK) GroupsDisableOnline(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.disableOnline", params, &response)
+	return vk.GroupsDisableOnlineContext(context.Background(), params)
+}
+
+// GroupsDisableOnlineContext is the same as GroupsDisableOnline, but takes a context.
+func (vk *VK) GroupsDisableOnlineContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.disableOnline", params, &response)
 	return
 }
 
@@ -102,7 +154,12 @@ func (vk *VK) GroupsDisableOnline(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.edit
 func (vk *VK) GroupsEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.edit", params, &response)
+	return vk.GroupsEditContext(context.Background(), params)
+}
+
+// GroupsEditContext is the same as GroupsEdit, but takes a context.
+func (vk *VK) GroupsEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.edit", params, &response)
 	return
 }
 
@@ -113,7 +170,12 @@ type GroupsEditAddressResponse object.GroupsAddress
 //
 // https://vk.com/dev/groups.editAddress
 func (vk *VK) GroupsEditAddress(params Params) (response GroupsEditAddressResponse, err error) {
-	err = vk.RequestUnmarshal("groups.editAddress", params, &response)
+	return vk.GroupsEditAddressContext(context.Background(), params)
+}
+
+// GroupsEditAddressContext is the same as GroupsEditAddress, but takes a context.
+func (vk *VK) GroupsEditAddressContext(ctx context.Context, params Params) (response GroupsEditAddressResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.editAddress", params, &response)
 	return
 }
 
@@ -121,7 +183,12 @@ func (vk *VK) GroupsEditAddress(params Params) (response GroupsEditAddressRespon
 //
 // https://vk.com/dev/groups.editCallbackServer
 func (vk *VK) GroupsEditCallbackServer(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.editCallbackServer", params, &response)
+	return vk.GroupsEditCallbackServerContext(context.Background(), params)
+}
+
+// GroupsEditCallbackServerContext is the same as GroupsEditCallbackServer, but takes a context.
+func (vk *VK) GroupsEditCallbackServerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.editCallbackServer", params, &response)
 	return
 }
 
@@ -129,7 +196,12 @@ func (vk *VK) GroupsEditCallbackServer(params Params) (response int, err error)
 //
 // https://vk.com/dev/groups.editLink
 func (vk *VK) GroupsEditLink(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.editLink", params, &response)
+	return vk.GroupsEditLinkContext(context.Background(), params)
+}
+
+// GroupsEditLinkContext is the same as GroupsEditLink, but takes a context.
+func (vk *VK) GroupsEditLinkContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.editLink", params, &response)
 	return
 }
 
@@ -137,7 +209,12 @@ func (vk *VK) GroupsEditLink(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.editManager
 func (vk *VK) GroupsEditManager(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.editManager", params, &response)
+	return vk.GroupsEditManagerContext(context.Background(), params)
+}
+
+// GroupsEditManagerContext is the same as GroupsEditManager, but takes a context.
+func (vk *VK) GroupsEditManagerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.editManager", params, &response)
 	return
 }
 
@@ -145,7 +222,12 @@ func (vk *VK) GroupsEditManager(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.enableOnline
 func (vk *VK) GroupsEnableOnline(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.enableOnline", params, &response)
+	return vk.GroupsEnableOnlineContext(context.Background(), params)
+}
+
+// GroupsEnableOnlineContext is the same as GroupsEnableOnline, but takes a context.
+func (vk *VK) GroupsEnableOnlineContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.enableOnline", params, &response)
 	return
 }
 
@@ -161,8 +243,13 @@ type GroupsGetResponse struct {
 //
 // https://vk.com/dev/groups.get
 func (vk *VK) GroupsGet(params Params) (response GroupsGetResponse, err error) {
+	return vk.GroupsGetContext(context.Background(), params)
+}
+
+// GroupsGetContext is the same as GroupsGet, but takes a context.
+func (vk *VK) GroupsGetContext(ctx context.Context, params Params) (response GroupsGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("groups.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.get", params, &response)
 
 	return
 }
@@ -179,8 +266,13 @@ type GroupsGetExtendedResponse struct {
 //
 // https://vk.com/dev/groups.get
 func (vk *VK) GroupsGetExtended(params Params) (response GroupsGetExtendedResponse, err error) {
+	return vk.GroupsGetExtendedContext(context.Background(), params)
+}
+
+// GroupsGetExtendedContext is the same as GroupsGetExtended, but takes a context.
+func (vk *VK) GroupsGetExtendedContext(ctx context.Context, params Params) (response GroupsGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("groups.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.get", params, &response)
 
 	return
 }
@@ -195,7 +287,12 @@ type GroupsGetAddressesResponse struct {
 //
 // https://vk.com/dev/groups.getAddresses
 func (vk *VK) GroupsGetAddresses(params Params) (response GroupsGetAddressesResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getAddresses", params, &response)
+	return vk.GroupsGetAddressesContext(context.Background(), params)
+}
+
+// GroupsGetAddressesContext is the same as GroupsGetAddresses, but takes a context.
+func (vk *VK) GroupsGetAddressesContext(ctx context.Context, params Params) (response GroupsGetAddressesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getAddresses", params, &response)
 	return
 }
 
@@ -209,7 +306,12 @@ type GroupsGetBannedResponse struct {
 //
 // https://vk.com/dev/groups.getBanned
 func (vk *VK) GroupsGetBanned(params Params) (response GroupsGetBannedResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getBanned", params, &response)
+	return vk.GroupsGetBannedContext(context.Background(), params)
+}
+
+// GroupsGetBannedContext is the same as GroupsGetBanned, but takes a context.
+func (vk *VK) GroupsGetBannedContext(ctx context.Context, params Params) (response GroupsGetBannedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getBanned", params, &response)
 	return
 }
 
@@ -220,7 +322,12 @@ type GroupsGetByIDResponse []object.GroupsGroup
 //
 // https://vk.com/dev/groups.getById
 func (vk *VK) GroupsGetByID(params Params) (response GroupsGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getById", params, &response)
+	return vk.GroupsGetByIDContext(context.Background(), params)
+}
+
+// GroupsGetByIDContext is the same as GroupsGetByID, but takes a context.
+func (vk *VK) GroupsGetByIDContext(ctx context.Context, params Params) (response GroupsGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getById", params, &response)
 	return
 }
 
@@ -233,7 +340,12 @@ type GroupsGetCallbackConfirmationCodeResponse struct {
 //
 // https://vk.com/dev/groups.getCallbackConfirmationCode
 func (vk *VK) GroupsGetCallbackConfirmationCode(params Params) (response GroupsGetCallbackConfirmationCodeResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getCallbackConfirmationCode", params, &response)
+	return vk.GroupsGetCallbackConfirmationCodeContext(context.Background(), params)
+}
+
+// GroupsGetCallbackConfirmationCodeContext is the same as GroupsGetCallbackConfirmationCode, but takes a context.
+func (vk *VK) GroupsGetCallbackConfirmationCodeContext(ctx context.Context, params Params) (response GroupsGetCallbackConfirmationCodeResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCallbackConfirmationCode", params, &response)
 	return
 }
 
@@ -247,7 +359,12 @@ type GroupsGetCallbackServersResponse struct {
 //
 // https://vk.com/dev/groups.getCallbackServers
 func (vk *VK) GroupsGetCallbackServers(params Params) (response GroupsGetCallbackServersResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getCallbackServers", params, &response)
+	return vk.GroupsGetCallbackServersContext(context.Background(), params)
+}
+
+// GroupsGetCallbackServersContext is the same as GroupsGetCallbackServers, but takes a context.
+func (vk *VK) GroupsGetCallbackServersContext(ctx context.Context, params Params) (response GroupsGetCallbackServersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCallbackServers", params, &response)
 	return
 }
 
@@ -260,7 +377,12 @@ type GroupsGetCallbackSettingsResponse object.GroupsCallbackSettings
 //
 // https://vk.com/dev/groups.getCallbackSettings
 func (vk *VK) GroupsGetCallbackSettings(params Params) (response GroupsGetCallbackSettingsResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getCallbackSettings", params, &response)
+	return vk.GroupsGetCallbackSettingsContext(context.Background(), params)
+}
+
+// GroupsGetCallbackSettingsContext is the same as GroupsGetCallbackSettings, but takes a context.
+func (vk *VK) GroupsGetCallbackSettingsContext(ctx context.Context, params Params) (response GroupsGetCallbackSettingsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCallbackSettings", params, &response)
 	return
 }
 
@@ -274,7 +396,12 @@ type GroupsGetCatalogResponse struct {
 //
 // https://vk.com/dev/groups.getCatalog
 func (vk *VK) GroupsGetCatalog(params Params) (response GroupsGetCatalogResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getCatalog", params, &response)
+	return vk.GroupsGetCatalogContext(context.Background(), params)
+}
+
+// GroupsGetCatalogContext is the same as GroupsGetCatalog, but takes a context.
+func (vk *VK) GroupsGetCatalogContext(ctx context.Context, params Params) (response GroupsGetCatalogResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCatalog", params, &response)
 	return
 }
 
@@ -290,8 +417,13 @@ type GroupsGetCatalogInfoResponse struct {
 //
 // https://vk.com/dev/groups.getCatalogInfo
 func (vk *VK) GroupsGetCatalogInfo(params Params) (response GroupsGetCatalogInfoResponse, err error) {
+	return vk.GroupsGetCatalogInfoContext(context.Background(), params)
+}
+
+// GroupsGetCatalogInfoContext is the same as GroupsGetCatalogInfo, but takes a context.
+func (vk *VK) GroupsGetCatalogInfoContext(ctx context.Context, params Params) (response GroupsGetCatalogInfoResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("groups.getCatalogInfo", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCatalogInfo", params, &response)
 
 	return
 }
@@ -308,8 +440,13 @@ type GroupsGetCatalogInfoExtendedResponse struct {
 //
 // https://vk.com/dev/groups.getCatalogInfo
 func (vk *VK) GroupsGetCatalogInfoExtended(params Params) (response GroupsGetCatalogInfoExtendedResponse, err error) {
+	return vk.GroupsGetCatalogInfoExtendedContext(context.Background(), params)
+}
+
+// GroupsGetCatalogInfoExtendedContext is the same as GroupsGetCatalogInfoExtended, but takes a context.
+func (vk *VK) GroupsGetCatalogInfoExtendedContext(ctx context.Context, params Params) (response GroupsGetCatalogInfoExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("groups.getCatalogInfo", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getCatalogInfo", params, &response)
 
 	return
 }
@@ -324,7 +461,12 @@ type GroupsGetInvitedUsersResponse struct {
 //
 // https://vk.com/dev/groups.getInvitedUsers
 func (vk *VK) GroupsGetInvitedUsers(params Params) (response GroupsGetInvitedUsersResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getInvitedUsers", params, &response)
+	return vk.GroupsGetInvitedUsersContext(context.Background(), params)
+}
+
+// GroupsGetInvitedUsersContext is the same as GroupsGetInvitedUsers, but takes a context.
+func (vk *VK) GroupsGetInvitedUsersContext(ctx context.Context, params Params) (response GroupsGetInvitedUsersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getInvitedUsers", params, &response)
 	return
 }
 
@@ -338,7 +480,12 @@ type GroupsGetInvitesResponse struct {
 //
 // https://vk.com/dev/groups.getInvites
 func (vk *VK) GroupsGetInvites(params Params) (response GroupsGetInvitesResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getInvites", params, &response)
+	return vk.GroupsGetInvitesContext(context.Background(), params)
+}
+
+// GroupsGetInvitesContext is the same as GroupsGetInvites, but takes a context.
+func (vk *VK) GroupsGetInvitesContext(ctx context.Context, params Params) (response GroupsGetInvitesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getInvites", params, &response)
 	return
 }
 
@@ -353,7 +500,12 @@ type GroupsGetInvitesExtendedResponse struct {
 //
 // https://vk.com/dev/groups.getInvites
 func (vk *VK) GroupsGetInvitesExtended(params Params) (response GroupsGetInvitesExtendedResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getInvites", params, &response)
+	return vk.GroupsGetInvitesExtendedContext(context.Background(), params)
+}
+
+// GroupsGetInvitesExtendedContext is the same as GroupsGetInvitesExtended, but takes a context.
+func (vk *VK) GroupsGetInvitesExtendedContext(ctx context.Context, params Params) (response GroupsGetInvitesExtendedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getInvites", params, &response)
 	return
 }
 
@@ -364,7 +516,12 @@ type GroupsGetLongPollServerResponse object.GroupsLongPollServer
 //
 // https://vk.com/dev/groups.getLongPollServer
 func (vk *VK) GroupsGetLongPollServer(params Params) (response GroupsGetLongPollServerResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getLongPollServer", params, &response)
+	return vk.GroupsGetLongPollServerContext(context.Background(), params)
+}
+
+// GroupsGetLongPollServerContext is the same as GroupsGetLongPollServer, but takes a context.
+func (vk *VK) GroupsGetLongPollServerContext(ctx context.Context, params Params) (response GroupsGetLongPollServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getLongPollServer", params, &response)
 	return
 }
 
@@ -375,7 +532,12 @@ type GroupsGetLongPollSettingsResponse object.GroupsLongPollSettings
 //
 // https://vk.com/dev/groups.getLongPollSettings
 func (vk *VK) GroupsGetLongPollSettings(params Params) (response GroupsGetLongPollSettingsResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getLongPollSettings", params, &response)
+	return vk.GroupsGetLongPollSettingsContext(context.Background(), params)
+}
+
+// GroupsGetLongPollSettingsContext is the same as GroupsGetLongPollSettings, but takes a context.
+func (vk *VK) GroupsGetLongPollSettingsContext(ctx context.Context, params Params) (response GroupsGetLongPollSettingsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getLongPollSettings", params, &response)
 	return
 }
 
@@ -389,9 +551,14 @@ type GroupsGetMembersResponse struct {
 //
 // https://vk.com/dev/groups.getMembers
 func (vk *VK) GroupsGetMembers(params Params) (response GroupsGetMembersResponse, err error) {
+	return vk.GroupsGetMembersContext(context.Background(), params)
+}
+
+// GroupsGetMembersContext is the same as GroupsGetMembers, but takes a context.
+func (vk *VK) GroupsGetMembersContext(ctx context.Context, params Params) (response GroupsGetMembersResponse, err error) {
 	params["fields"] = ""
 	params["filter"] = ""
-	err = vk.RequestUnmarshal("groups.getMembers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getMembers", params, &response)
 
 	return
 }
@@ -406,11 +573,16 @@ type GroupsGetMembersFieldsResponse struct {
 //
 // https://vk.com/dev/groups.getMembers
 func (vk *VK) GroupsGetMembersFields(params Params) (response GroupsGetMembersFieldsResponse, err error) {
+	return vk.GroupsGetMembersFieldsContext(context.Background(), params)
+}
+
+// GroupsGetMembersFieldsContext is the same as GroupsGetMembersFields, but takes a context.
+func (vk *VK) GroupsGetMembersFieldsContext(ctx context.Context, params Params) (response GroupsGetMembersFieldsResponse, err error) {
 	if v, prs := params["fields"]; v == "" || !prs {
 		params["fields"] = "id"
 	}
 
-	err = vk.RequestUnmarshal("groups.getMembers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getMembers", params, &response)
 
 	return
 }
@@ -426,8 +598,13 @@ type GroupsGetMembersFilterManagersResponse struct {
 //
 // https://vk.com/dev/groups.getMembers
 func (vk *VK) GroupsGetMembersFilterManagers(params Params) (response GroupsGetMembersFilterManagersResponse, err error) {
+	return vk.GroupsGetMembersFilterManagersContext(context.Background(), params)
+}
+
+// GroupsGetMembersFilterManagersContext is the same as GroupsGetMembersFilterManagers, but takes a context.
+func (vk *VK) GroupsGetMembersFilterManagersContext(ctx context.Context, params Params) (response GroupsGetMembersFilterManagersResponse, err error) {
 	params["filter"] = "managers"
-	err = vk.RequestUnmarshal("groups.getMembers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getMembers", params, &response)
 
 	return
 }
@@ -439,7 +616,12 @@ type GroupsGetOnlineStatusResponse object.GroupsOnlineStatus
 //
 // https://vk.com/dev/groups.getOnlineStatus
 func (vk *VK) GroupsGetOnlineStatus(params Params) (response GroupsGetOnlineStatusResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getOnlineStatus", params, &response)
+	return vk.GroupsGetOnlineStatusContext(context.Background(), params)
+}
+
+// GroupsGetOnlineStatusContext is the same as GroupsGetOnlineStatus, but takes a context.
+func (vk *VK) GroupsGetOnlineStatusContext(ctx context.Context, params Params) (response GroupsGetOnlineStatusResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getOnlineStatus", params, &response)
 	return
 }
 
@@ -453,8 +635,13 @@ type GroupsGetRequestsResponse struct {
 //
 // https://vk.com/dev/groups.getRequests
 func (vk *VK) GroupsGetRequests(params Params) (response GroupsGetRequestsResponse, err error) {
+	return vk.GroupsGetRequestsContext(context.Background(), params)
+}
+
+// GroupsGetRequestsContext is the same as GroupsGetRequests, but takes a context.
+func (vk *VK) GroupsGetRequestsContext(ctx context.Context, params Params) (response GroupsGetRequestsResponse, err error) {
 	params["fields"] = ""
-	err = vk.RequestUnmarshal("groups.getRequests", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getRequests", params, &response)
 
 	return
 }
@@ -469,11 +656,16 @@ type GroupsGetRequestsFieldsResponse struct {
 //
 // https://vk.com/dev/groups.getRequests
 func (vk *VK) GroupsGetRequestsFields(params Params) (response GroupsGetRequestsFieldsResponse, err error) {
+	return vk.GroupsGetRequestsFieldsContext(context.Background(), params)
+}
+
+// GroupsGetRequestsFieldsContext is the same as GroupsGetRequestsFields, but takes a context.
+func (vk *VK) GroupsGetRequestsFieldsContext(ctx context.Context, params Params) (response GroupsGetRequestsFieldsResponse, err error) {
 	if v, prs := params["fields"]; v == "" || !prs {
 		params["fields"] = "id"
 	}
 
-	err = vk.RequestUnmarshal("groups.getRequests", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.getRequests", params, &response)
 
 	return
 }
@@ -485,7 +677,12 @@ type GroupsGetSettingsResponse object.GroupsGroupSettings
 //
 // https://vk.com/dev/groups.getSettings
 func (vk *VK) GroupsGetSettings(params Params) (response GroupsGetSettingsResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getSettings", params, &response)
+	return vk.GroupsGetSettingsContext(context.Background(), params)
+}
+
+// GroupsGetSettingsContext is the same as GroupsGetSettings, but takes a context.
+func (vk *VK) GroupsGetSettingsContext(ctx context.Context, params Params) (response GroupsGetSettingsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getSettings", params, &response)
 	return
 }
 
@@ -496,7 +693,12 @@ type GroupsGetTokenPermissionsResponse object.GroupsTokenPermissions
 //
 // https://vk.com/dev/groups.getTokenPermissions
 func (vk *VK) GroupsGetTokenPermissions(params Params) (response GroupsGetTokenPermissionsResponse, err error) {
-	err = vk.RequestUnmarshal("groups.getTokenPermissions", params, &response)
+	return vk.GroupsGetTokenPermissionsContext(context.Background(), params)
+}
+
+// GroupsGetTokenPermissionsContext is the same as GroupsGetTokenPermissions, but takes a context.
+func (vk *VK) GroupsGetTokenPermissionsContext(ctx context.Context, params Params) (response GroupsGetTokenPermissionsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.getTokenPermissions", params, &response)
 	return
 }
 
@@ -504,7 +706,12 @@ func (vk *VK) GroupsGetTokenPermissions(params Params) (response GroupsGetTokenP
 //
 // https://vk.com/dev/groups.invite
 func (vk *VK) GroupsInvite(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.invite", params, &response)
+	return vk.GroupsInviteContext(context.Background(), params)
+}
+
+// GroupsInviteContext is the same as GroupsInvite, but takes a context.
+func (vk *VK) GroupsInviteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.invite", params, &response)
 	return
 }
 
@@ -514,8 +721,13 @@ func (vk *VK) GroupsInvite(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.isMember
 func (vk *VK) GroupsIsMember(params Params) (response int, err error) {
+	return vk.GroupsIsMemberContext(context.Background(), params)
+}
+
+// GroupsIsMemberContext is the same as GroupsIsMember, but takes a context.
+func (vk *VK) GroupsIsMemberContext(ctx context.Context, params Params) (response int, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("groups.isMember", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.isMember", params, &response)
 
 	return
 }
@@ -535,8 +747,13 @@ type GroupsIsMemberExtendedResponse struct {
 //
 // https://vk.com/dev/groups.isMember
 func (vk *VK) GroupsIsMemberExtended(params Params) (response GroupsIsMemberExtendedResponse, err error) {
+	return vk.GroupsIsMemberExtendedContext(context.Background(), params)
+}
+
+// GroupsIsMemberExtendedContext is the same as GroupsIsMemberExtended, but takes a context.
+func (vk *VK) GroupsIsMemberExtendedContext(ctx context.Context, params Params) (response GroupsIsMemberExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("groups.isMember", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.isMember", params, &response)
 
 	return
 }
@@ -551,8 +768,13 @@ type GroupsIsMemberUserIDsExtendedResponse []object.GroupsMemberStatusFull
 //
 // https://vk.com/dev/groups.isMember
 func (vk *VK) GroupsIsMemberUserIDsExtended(params Params) (response GroupsIsMemberUserIDsExtendedResponse, err error) {
+	return vk.GroupsIsMemberUserIDsExtendedContext(context.Background(), params)
+}
+
+// GroupsIsMemberUserIDsExtendedContext is the same as GroupsIsMemberUserIDsExtended, but takes a context.
+func (vk *VK) GroupsIsMemberUserIDsExtendedContext(ctx context.Context, params Params) (response GroupsIsMemberUserIDsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("groups.isMember", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.isMember", params, &response)
 
 	return
 }
@@ -567,8 +789,13 @@ type GroupsIsMemberUserIDsResponse []object.GroupsMemberStatus
 //
 // https://vk.com/dev/groups.isMember
 func (vk *VK) GroupsIsMemberUserIDs(params Params) (response GroupsIsMemberUserIDsResponse, err error) {
+	return vk.GroupsIsMemberUserIDsContext(context.Background(), params)
+}
+
+// GroupsIsMemberUserIDsContext is the same as GroupsIsMemberUserIDs, but takes a context.
+func (vk *VK) GroupsIsMemberUserIDsContext(ctx context.Context, params Params) (response GroupsIsMemberUserIDsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("groups.isMember", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "groups.isMember", params, &response)
 
 	return
 }
@@ -577,7 +804,12 @@ func (vk *VK) GroupsIsMemberUserIDs(params Params) (response GroupsIsMemberUserI
 //
 // https://vk.com/dev/groups.join
 func (vk *VK) GroupsJoin(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.join", params, &response)
+	return vk.GroupsJoinContext(context.Background(), params)
+}
+
+// GroupsJoinContext is the same as GroupsJoin, but takes a context.
+func (vk *VK) GroupsJoinContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.join", params, &response)
 	return
 }
 
@@ -585,7 +817,12 @@ func (vk *VK) GroupsJoin(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.leave
 func (vk *VK) GroupsLeave(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.leave", params, &response)
+	return vk.GroupsLeaveContext(context.Background(), params)
+}
+
+// GroupsLeaveContext is the same as GroupsLeave, but takes a context.
+func (vk *VK) GroupsLeaveContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.leave", params, &response)
 	return
 }
 
@@ -593,7 +830,12 @@ func (vk *VK) GroupsLeave(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.removeUser
 func (vk *VK) GroupsRemoveUser(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.removeUser", params, &response)
+	return vk.GroupsRemoveUserContext(context.Background(), params)
+}
+
+// GroupsRemoveUserContext is the same as GroupsRemoveUser, but takes a context.
+func (vk *VK) GroupsRemoveUserContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.removeUser", params, &response)
 	return
 }
 
@@ -601,7 +843,12 @@ func (vk *VK) GroupsRemoveUser(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.reorderLink
 func (vk *VK) GroupsReorderLink(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.reorderLink", params, &response)
+	return vk.GroupsReorderLinkContext(context.Background(), params)
+}
+
+// GroupsReorderLinkContext is the same as GroupsReorderLink, but takes a context.
+func (vk *VK) GroupsReorderLinkContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.reorderLink", params, &response)
 	return
 }
 
@@ -615,7 +862,12 @@ type GroupsSearchResponse struct {
 //
 // https://vk.com/dev/groups.search
 func (vk *VK) GroupsSearch(params Params) (response GroupsSearchResponse, err error) {
-	err = vk.RequestUnmarshal("groups.search", params, &response)
+	return vk.GroupsSearchContext(context.Background(), params)
+}
+
+// GroupsSearchContext is the same as GroupsSearch, but takes a context.
+func (vk *VK) GroupsSearchContext(ctx context.Context, params Params) (response GroupsSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.search", params, &response)
 	return
 }
 
@@ -623,7 +875,12 @@ func (vk *VK) GroupsSearch(params Params) (response GroupsSearchResponse, err er
 //
 // https://vk.com/dev/groups.setCallbackSettings
 func (vk *VK) GroupsSetCallbackSettings(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.setCallbackSettings", params, &response)
+	return vk.GroupsSetCallbackSettingsContext(context.Background(), params)
+}
+
+// GroupsSetCallbackSettingsContext is the same as GroupsSetCallbackSettings, but takes a context.
+func (vk *VK) GroupsSetCallbackSettingsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.setCallbackSettings", params, &response)
 	return
 }
 
@@ -631,7 +888,12 @@ func (vk *VK) GroupsSetCallbackSettings(params Params) (response int, err error)
 //
 // https://vk.com/dev/groups.setLongPollSettings
 func (vk *VK) GroupsSetLongPollSettings(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.setLongPollSettings", params, &response)
+	return vk.GroupsSetLongPollSettingsContext(context.Background(), params)
+}
+
+// GroupsSetLongPollSettingsContext is the same as GroupsSetLongPollSettings, but takes a context.
+func (vk *VK) GroupsSetLongPollSettingsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.setLongPollSettings", params, &response)
 	return
 }
 
@@ -639,7 +901,12 @@ func (vk *VK) GroupsSetLongPollSettings(params Params) (response int, err error)
 //
 // https://vk.com/dev/groups.setSettings
 func (vk *VK) GroupsSetSettings(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.setSettings", params, &response)
+	return vk.GroupsSetSettingsContext(context.Background(), params)
+}
+
+// GroupsSetSettingsContext is the same as GroupsSetSettings, but takes a context.
+func (vk *VK) GroupsSetSettingsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.setSettings", params, &response)
 	return
 }
 
@@ -647,6 +914,11 @@ func (vk *VK) GroupsSetSettings(params Params) (response int, err error) {
 //
 // https://vk.com/dev/groups.unban
 func (vk *VK) GroupsUnban(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("groups.unban", params, &response)
+	return vk.GroupsUnbanContext(context.Background(), params)
+}
+
+// GroupsUnbanContext is the same as GroupsUnban, but takes a context.
+func (vk *VK) GroupsUnbanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "groups.unban", params, &response)
 	return
 }
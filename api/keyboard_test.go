@@ -0,0 +1,62 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVK_keyboardValidation(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+
+	vk, ts := newTestVK(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		jsonHandler(`{"response":1}`)(w, r)
+	})
+	defer ts.Close()
+
+	keyboard := object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton("", "", "")
+
+	_, err := vk.MessagesSend(api.Params{"peer_id": 1, "keyboard": keyboard})
+	assert.IsType(t, &object.KeyboardError{}, err)
+
+	_, err = vk.MessagesSend(api.Params{"peer_id": 1, "keyboard": &keyboard})
+	assert.IsType(t, &object.KeyboardError{}, err)
+
+	assert.Equal(t, 0, requests)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton("label", "", "")
+
+	_, err = vk.MessagesSend(api.Params{"peer_id": 1, "keyboard": keyboard})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	_, err = vk.MessagesSendMessageEventAnswer(api.Params{
+		"event_id":   "abc",
+		"user_id":    1,
+		"peer_id":    1,
+		"event_data": object.MessagesEventData{Type: object.EventDataShowSnackbar, Text: "ok"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestBatch_MessagesSendMessageEventAnswer(t *testing.T) {
+	t.Parallel()
+
+	vk := api.NewVK("")
+	b := vk.NewBatch()
+
+	var response int
+
+	call := b.MessagesSendMessageEventAnswer(api.Params{"event_id": "abc"}, &response)
+	assert.Equal(t, "messages.sendMessageEventAnswer", call.Method)
+	assert.Equal(t, 1, b.Len())
+}
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -14,7 +16,12 @@ type LeadFormsCreateResponse struct {
 //
 // https://vk.com/dev/leadForms.create
 func (vk *VK) LeadFormsCreate(params Params) (response LeadFormsCreateResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.create", params, &response)
+	return vk.LeadFormsCreateContext(context.Background(), params)
+}
+
+// LeadFormsCreateContext is the same as LeadFormsCreate, but takes a context.
+func (vk *VK) LeadFormsCreateContext(ctx context.Context, params Params) (response LeadFormsCreateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.create", params, &response)
 	return
 }
 
@@ -27,7 +34,12 @@ type LeadFormsDeleteResponse struct {
 //
 // https://vk.com/dev/leadForms.delete
 func (vk *VK) LeadFormsDelete(params Params) (response LeadFormsDeleteResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.delete", params, &response)
+	return vk.LeadFormsDeleteContext(context.Background(), params)
+}
+
+// LeadFormsDeleteContext is the same as LeadFormsDelete, but takes a context.
+func (vk *VK) LeadFormsDeleteContext(ctx context.Context, params Params) (response LeadFormsDeleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.delete", params, &response)
 	return
 }
 
@@ -38,7 +50,12 @@ type LeadFormsGetResponse object.LeadFormsForm
 //
 // https://vk.com/dev/leadForms.get
 func (vk *VK) LeadFormsGet(params Params) (response LeadFormsGetResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.get", params, &response)
+	return vk.LeadFormsGetContext(context.Background(), params)
+}
+
+// LeadFormsGetContext is the same as LeadFormsGet, but takes a context.
+func (vk *VK) LeadFormsGetContext(ctx context.Context, params Params) (response LeadFormsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.get", params, &response)
 	return
 }
 
@@ -51,7 +68,12 @@ type LeadFormsGetLeadsResponse struct {
 //
 // https://vk.com/dev/leadForms.getLeads
 func (vk *VK) LeadFormsGetLeads(params Params) (response LeadFormsGetLeadsResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.getLeads", params, &response)
+	return vk.LeadFormsGetLeadsContext(context.Background(), params)
+}
+
+// LeadFormsGetLeadsContext is the same as LeadFormsGetLeads, but takes a context.
+func (vk *VK) LeadFormsGetLeadsContext(ctx context.Context, params Params) (response LeadFormsGetLeadsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.getLeads", params, &response)
 	return
 }
 
@@ -59,7 +81,12 @@ func (vk *VK) LeadFormsGetLeads(params Params) (response LeadFormsGetLeadsRespon
 //
 // https://vk.com/dev/leadForms.getUploadURL
 func (vk *VK) LeadFormsGetUploadURL(params Params) (response string, err error) {
-	err = vk.RequestUnmarshal("leadForms.getUploadURL", params, &response)
+	return vk.LeadFormsGetUploadURLContext(context.Background(), params)
+}
+
+// LeadFormsGetUploadURLContext is the same as LeadFormsGetUploadURL, but takes a context.
+func (vk *VK) LeadFormsGetUploadURLContext(ctx context.Context, params Params) (response string, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.getUploadURL", params, &response)
 	return
 }
 
@@ -70,7 +97,12 @@ type LeadFormsListResponse []object.LeadFormsForm
 //
 // https://vk.com/dev/leadForms.list
 func (vk *VK) LeadFormsList(params Params) (response LeadFormsListResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.list", params, &response)
+	return vk.LeadFormsListContext(context.Background(), params)
+}
+
+// LeadFormsListContext is the same as LeadFormsList, but takes a context.
+func (vk *VK) LeadFormsListContext(ctx context.Context, params Params) (response LeadFormsListResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.list", params, &response)
 	return
 }
 
@@ -84,6 +116,11 @@ type LeadFormsUpdateResponse struct {
 //
 // https://vk.com/dev/leadForms.update
 func (vk *VK) LeadFormsUpdate(params Params) (response LeadFormsUpdateResponse, err error) {
-	err = vk.RequestUnmarshal("leadForms.update", params, &response)
+	return vk.LeadFormsUpdateContext(context.Background(), params)
+}
+
+// LeadFormsUpdateContext is the same as LeadFormsUpdate, but takes a context.
+func (vk *VK) LeadFormsUpdateContext(ctx context.Context, params Params) (response LeadFormsUpdateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leadForms.update", params, &response)
 	return
 }
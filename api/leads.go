@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -11,7 +13,12 @@ type LeadsCheckUserResponse object.LeadsChecked
 //
 // https://vk.com/dev/leads.checkUser
 func (vk *VK) LeadsCheckUser(params Params) (response LeadsCheckUserResponse, err error) {
-	err = vk.RequestUnmarshal("leads.checkUser", params, &response)
+	return vk.LeadsCheckUserContext(context.Background(), params)
+}
+
+// LeadsCheckUserContext is the same as LeadsCheckUser, but takes a context.
+func (vk *VK) LeadsCheckUserContext(ctx context.Context, params Params) (response LeadsCheckUserResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.checkUser", params, &response)
 	return
 }
 
@@ -22,7 +29,12 @@ type LeadsCompleteResponse object.LeadsComplete
 //
 // https://vk.com/dev/leads.complete
 func (vk *VK) LeadsComplete(params Params) (response LeadsCompleteResponse, err error) {
-	err = vk.RequestUnmarshal("leads.complete", params, &response)
+	return vk.LeadsCompleteContext(context.Background(), params)
+}
+
+// LeadsCompleteContext is the same as LeadsComplete, but takes a context.
+func (vk *VK) LeadsCompleteContext(ctx context.Context, params Params) (response LeadsCompleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.complete", params, &response)
 	return
 }
 
@@ -33,7 +45,12 @@ type LeadsGetStatsResponse object.LeadsLead
 //
 // https://vk.com/dev/leads.getStats
 func (vk *VK) LeadsGetStats(params Params) (response LeadsGetStatsResponse, err error) {
-	err = vk.RequestUnmarshal("leads.getStats", params, &response)
+	return vk.LeadsGetStatsContext(context.Background(), params)
+}
+
+// LeadsGetStatsContext is the same as LeadsGetStats, but takes a context.
+func (vk *VK) LeadsGetStatsContext(ctx context.Context, params Params) (response LeadsGetStatsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.getStats", params, &response)
 	return
 }
 
@@ -44,7 +61,12 @@ type LeadsGetUsersResponse object.LeadsEntry
 //
 // https://vk.com/dev/leads.getUsers
 func (vk *VK) LeadsGetUsers(params Params) (response LeadsGetUsersResponse, err error) {
-	err = vk.RequestUnmarshal("leads.getUsers", params, &response)
+	return vk.LeadsGetUsersContext(context.Background(), params)
+}
+
+// LeadsGetUsersContext is the same as LeadsGetUsers, but takes a context.
+func (vk *VK) LeadsGetUsersContext(ctx context.Context, params Params) (response LeadsGetUsersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.getUsers", params, &response)
 	return
 }
 
@@ -58,7 +80,12 @@ type LeadsMetricHitResponse struct {
 //
 // https://vk.com/dev/leads.metricHit
 func (vk *VK) LeadsMetricHit(params Params) (response LeadsMetricHitResponse, err error) {
-	err = vk.RequestUnmarshal("leads.metricHit", params, &response)
+	return vk.LeadsMetricHitContext(context.Background(), params)
+}
+
+// LeadsMetricHitContext is the same as LeadsMetricHit, but takes a context.
+func (vk *VK) LeadsMetricHitContext(ctx context.Context, params Params) (response LeadsMetricHitResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.metricHit", params, &response)
 	return
 }
 
@@ -69,6 +96,11 @@ type LeadsStartResponse object.LeadsStart
 //
 // https://vk.com/dev/leads.start
 func (vk *VK) LeadsStart(params Params) (response LeadsStartResponse, err error) {
-	err = vk.RequestUnmarshal("leads.start", params, &response)
+	return vk.LeadsStartContext(context.Background(), params)
+}
+
+// LeadsStartContext is the same as LeadsStart, but takes a context.
+func (vk *VK) LeadsStartContext(ctx context.Context, params Params) (response LeadsStartResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "leads.start", params, &response)
 	return
 }
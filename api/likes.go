@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -13,7 +15,12 @@ type LikesAddResponse struct {
 //
 // https://vk.com/dev/likes.add
 func (vk *VK) LikesAdd(params Params) (response LikesAddResponse, err error) {
-	err = vk.RequestUnmarshal("likes.add", params, &response)
+	return vk.LikesAddContext(context.Background(), params)
+}
+
+// LikesAddContext is the same as LikesAdd, but takes a context.
+func (vk *VK) LikesAddContext(ctx context.Context, params Params) (response LikesAddResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "likes.add", params, &response)
 	return
 }
 
@@ -26,7 +33,12 @@ type LikesDeleteResponse struct {
 //
 // https://vk.com/dev/likes.delete
 func (vk *VK) LikesDelete(params Params) (response LikesDeleteResponse, err error) {
-	err = vk.RequestUnmarshal("likes.delete", params, &response)
+	return vk.LikesDeleteContext(context.Background(), params)
+}
+
+// LikesDeleteContext is the same as LikesDelete, but takes a context.
+func (vk *VK) LikesDeleteContext(ctx context.Context, params Params) (response LikesDeleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "likes.delete", params, &response)
 	return
 }
 
@@ -42,8 +54,13 @@ type LikesGetListResponse struct {
 //
 // https://vk.com/dev/likes.getList
 func (vk *VK) LikesGetList(params Params) (response LikesGetListResponse, err error) {
+	return vk.LikesGetListContext(context.Background(), params)
+}
+
+// LikesGetListContext is the same as LikesGetList, but takes a context.
+func (vk *VK) LikesGetListContext(ctx context.Context, params Params) (response LikesGetListResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("likes.getList", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "likes.getList", params, &response)
 
 	return
 }
@@ -60,8 +77,13 @@ type LikesGetListExtendedResponse struct {
 //
 // https://vk.com/dev/likes.getList
 func (vk *VK) LikesGetListExtended(params Params) (response LikesGetListExtendedResponse, err error) {
+	return vk.LikesGetListExtendedContext(context.Background(), params)
+}
+
+// LikesGetListExtendedContext is the same as LikesGetListExtended, but takes a context.
+func (vk *VK) LikesGetListExtendedContext(ctx context.Context, params Params) (response LikesGetListExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("likes.getList", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "likes.getList", params, &response)
 
 	return
 }
@@ -76,6 +98,11 @@ type LikesIsLikedResponse struct {
 //
 // https://vk.com/dev/likes.isLiked
 func (vk *VK) LikesIsLiked(params Params) (response LikesIsLikedResponse, err error) {
-	err = vk.RequestUnmarshal("likes.isLiked", params, &response)
+	return vk.LikesIsLikedContext(context.Background(), params)
+}
+
+// LikesIsLikedContext is the same as LikesIsLiked, but takes a context.
+func (vk *VK) LikesIsLikedContext(ctx context.Context, params Params) (response LikesIsLikedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "likes.isLiked", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -13,7 +15,12 @@ type MarketAddResponse struct {
 //
 // https://vk.com/dev/market.add
 func (vk *VK) MarketAdd(params Params) (response MarketAddResponse, err error) {
-	err = vk.RequestUnmarshal("market.add", params, &response)
+	return vk.MarketAddContext(context.Background(), params)
+}
+
+// MarketAddContext is the same as MarketAdd, but takes a context.
+func (vk *VK) MarketAddContext(ctx context.Context, params Params) (response MarketAddResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.add", params, &response)
 	return
 }
 
@@ -26,7 +33,12 @@ type MarketAddAlbumResponse struct {
 //
 // https://vk.com/dev/market.addAlbum
 func (vk *VK) MarketAddAlbum(params Params) (response MarketAddAlbumResponse, err error) {
-	err = vk.RequestUnmarshal("market.addAlbum", params, &response)
+	return vk.MarketAddAlbumContext(context.Background(), params)
+}
+
+// MarketAddAlbumContext is the same as MarketAddAlbum, but takes a context.
+func (vk *VK) MarketAddAlbumContext(ctx context.Context, params Params) (response MarketAddAlbumResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.addAlbum", params, &response)
 	return
 }
 
@@ -34,7 +46,12 @@ func (vk *VK) MarketAddAlbum(params Params) (response MarketAddAlbumResponse, er
 //
 // https://vk.com/dev/market.addToAlbum
 func (vk *VK) MarketAddToAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.addToAlbum", params, &response)
+	return vk.MarketAddToAlbumContext(context.Background(), params)
+}
+
+// MarketAddToAlbumContext is the same as MarketAddToAlbum, but takes a context.
+func (vk *VK) MarketAddToAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.addToAlbum", params, &response)
 	return
 }
 
@@ -42,7 +59,12 @@ func (vk *VK) MarketAddToAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.createComment
 func (vk *VK) MarketCreateComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.createComment", params, &response)
+	return vk.MarketCreateCommentContext(context.Background(), params)
+}
+
+// MarketCreateCommentContext is the same as MarketCreateComment, but takes a context.
+func (vk *VK) MarketCreateCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.createComment", params, &response)
 	return
 }
 
@@ -50,7 +72,12 @@ func (vk *VK) MarketCreateComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.delete
 func (vk *VK) MarketDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.delete", params, &response)
+	return vk.MarketDeleteContext(context.Background(), params)
+}
+
+// MarketDeleteContext is the same as MarketDelete, but takes a context.
+func (vk *VK) MarketDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.delete", params, &response)
 	return
 }
 
@@ -58,7 +85,12 @@ func (vk *VK) MarketDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.deleteAlbum
 func (vk *VK) MarketDeleteAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.deleteAlbum", params, &response)
+	return vk.MarketDeleteAlbumContext(context.Background(), params)
+}
+
+// MarketDeleteAlbumContext is the same as MarketDeleteAlbum, but takes a context.
+func (vk *VK) MarketDeleteAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.deleteAlbum", params, &response)
 	return
 }
 
@@ -66,7 +98,12 @@ func (vk *VK) MarketDeleteAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.deleteComment
 func (vk *VK) MarketDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.deleteComment", params, &response)
+	return vk.MarketDeleteCommentContext(context.Background(), params)
+}
+
+// MarketDeleteCommentContext is the same as MarketDeleteComment, but takes a context.
+func (vk *VK) MarketDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.deleteComment", params, &response)
 	return
 }
 
@@ -74,7 +111,12 @@ func (vk *VK) MarketDeleteComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.edit
 func (vk *VK) MarketEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.edit", params, &response)
+	return vk.MarketEditContext(context.Background(), params)
+}
+
+// MarketEditContext is the same as MarketEdit, but takes a context.
+func (vk *VK) MarketEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.edit", params, &response)
 	return
 }
 
@@ -82,7 +124,12 @@ func (vk *VK) MarketEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.editAlbum
 func (vk *VK) MarketEditAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.editAlbum", params, &response)
+	return vk.MarketEditAlbumContext(context.Background(), params)
+}
+
+// MarketEditAlbumContext is the same as MarketEditAlbum, but takes a context.
+func (vk *VK) MarketEditAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.editAlbum", params, &response)
 	return
 }
 
@@ -90,7 +137,12 @@ func (vk *VK) MarketEditAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.editComment
 func (vk *VK) MarketEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.editComment", params, &response)
+	return vk.MarketEditCommentContext(context.Background(), params)
+}
+
+// MarketEditCommentContext is the same as MarketEditComment, but takes a context.
+func (vk *VK) MarketEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.editComment", params, &response)
 	return
 }
 
@@ -98,7 +150,12 @@ func (vk *VK) MarketEditComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.editOrder
 func (vk *VK) MarketEditOrder(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.editOrder", params, &response)
+	return vk.MarketEditOrderContext(context.Background(), params)
+}
+
+// MarketEditOrderContext is the same as MarketEditOrder, but takes a context.
+func (vk *VK) MarketEditOrderContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.editOrder", params, &response)
 	return
 }
 
@@ -112,7 +169,12 @@ type MarketGetResponse struct {
 //
 // https://vk.com/dev/market.get
 func (vk *VK) MarketGet(params Params) (response MarketGetResponse, err error) {
-	err = vk.RequestUnmarshal("market.get", params, &response)
+	return vk.MarketGetContext(context.Background(), params)
+}
+
+// MarketGetContext is the same as MarketGet, but takes a context.
+func (vk *VK) MarketGetContext(ctx context.Context, params Params) (response MarketGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.get", params, &response)
 	return
 }
 
@@ -126,7 +188,12 @@ type MarketGetAlbumByIDResponse struct {
 //
 // https://vk.com/dev/market.getAlbumById
 func (vk *VK) MarketGetAlbumByID(params Params) (response MarketGetAlbumByIDResponse, err error) {
-	err = vk.RequestUnmarshal("market.getAlbumById", params, &response)
+	return vk.MarketGetAlbumByIDContext(context.Background(), params)
+}
+
+// MarketGetAlbumByIDContext is the same as MarketGetAlbumByID, but takes a context.
+func (vk *VK) MarketGetAlbumByIDContext(ctx context.Context, params Params) (response MarketGetAlbumByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getAlbumById", params, &response)
 	return
 }
 
@@ -140,7 +207,12 @@ type MarketGetAlbumsResponse struct {
 //
 // https://vk.com/dev/market.getAlbums
 func (vk *VK) MarketGetAlbums(params Params) (response MarketGetAlbumsResponse, err error) {
-	err = vk.RequestUnmarshal("market.getAlbums", params, &response)
+	return vk.MarketGetAlbumsContext(context.Background(), params)
+}
+
+// MarketGetAlbumsContext is the same as MarketGetAlbums, but takes a context.
+func (vk *VK) MarketGetAlbumsContext(ctx context.Context, params Params) (response MarketGetAlbumsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getAlbums", params, &response)
 	return
 }
 
@@ -154,7 +226,12 @@ type MarketGetByIDResponse struct {
 //
 // https://vk.com/dev/market.getById
 func (vk *VK) MarketGetByID(params Params) (response MarketGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("market.getById", params, &response)
+	return vk.MarketGetByIDContext(context.Background(), params)
+}
+
+// MarketGetByIDContext is the same as MarketGetByID, but takes a context.
+func (vk *VK) MarketGetByIDContext(ctx context.Context, params Params) (response MarketGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getById", params, &response)
 	return
 }
 
@@ -168,7 +245,12 @@ type MarketGetCategoriesResponse struct {
 //
 // https://vk.com/dev/market.getCategories
 func (vk *VK) MarketGetCategories(params Params) (response MarketGetCategoriesResponse, err error) {
-	err = vk.RequestUnmarshal("market.getCategories", params, &response)
+	return vk.MarketGetCategoriesContext(context.Background(), params)
+}
+
+// MarketGetCategoriesContext is the same as MarketGetCategories, but takes a context.
+func (vk *VK) MarketGetCategoriesContext(ctx context.Context, params Params) (response MarketGetCategoriesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getCategories", params, &response)
 	return
 }
 
@@ -184,8 +266,13 @@ type MarketGetCommentsResponse struct {
 //
 // https://vk.com/dev/market.getComments
 func (vk *VK) MarketGetComments(params Params) (response MarketGetCommentsResponse, err error) {
+	return vk.MarketGetCommentsContext(context.Background(), params)
+}
+
+// MarketGetCommentsContext is the same as MarketGetComments, but takes a context.
+func (vk *VK) MarketGetCommentsContext(ctx context.Context, params Params) (response MarketGetCommentsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("market.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "market.getComments", params, &response)
 
 	return
 }
@@ -203,8 +290,13 @@ type MarketGetCommentsExtendedResponse struct {
 //
 // https://vk.com/dev/market.getComments
 func (vk *VK) MarketGetCommentsExtended(params Params) (response MarketGetCommentsExtendedResponse, err error) {
+	return vk.MarketGetCommentsExtendedContext(context.Background(), params)
+}
+
+// MarketGetCommentsExtendedContext is the same as MarketGetCommentsExtended, but takes a context.
+func (vk *VK) MarketGetCommentsExtendedContext(ctx context.Context, params Params) (response MarketGetCommentsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("market.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "market.getComments", params, &response)
 
 	return
 }
@@ -219,7 +311,12 @@ type MarketGetGroupOrdersResponse struct {
 //
 // https://vk.com/dev/market.getGroupOrders
 func (vk *VK) MarketGetGroupOrders(params Params) (response MarketGetGroupOrdersResponse, err error) {
-	err = vk.RequestUnmarshal("market.getGroupOrders", params, &response)
+	return vk.MarketGetGroupOrdersContext(context.Background(), params)
+}
+
+// MarketGetGroupOrdersContext is the same as MarketGetGroupOrders, but takes a context.
+func (vk *VK) MarketGetGroupOrdersContext(ctx context.Context, params Params) (response MarketGetGroupOrdersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getGroupOrders", params, &response)
 	return
 }
 
@@ -232,7 +329,12 @@ type MarketGetOrderByIDResponse struct {
 //
 // https://vk.com/dev/market.getOrderById
 func (vk *VK) MarketGetOrderByID(params Params) (response MarketGetOrderByIDResponse, err error) {
-	err = vk.RequestUnmarshal("market.getOrderById", params, &response)
+	return vk.MarketGetOrderByIDContext(context.Background(), params)
+}
+
+// MarketGetOrderByIDContext is the same as MarketGetOrderByID, but takes a context.
+func (vk *VK) MarketGetOrderByIDContext(ctx context.Context, params Params) (response MarketGetOrderByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getOrderById", params, &response)
 	return
 }
 
@@ -246,7 +348,12 @@ type MarketGetOrderItemsResponse struct {
 //
 // https://vk.com/dev/market.getOrderItems
 func (vk *VK) MarketGetOrderItems(params Params) (response MarketGetOrderItemsResponse, err error) {
-	err = vk.RequestUnmarshal("market.getOrderItems", params, &response)
+	return vk.MarketGetOrderItemsContext(context.Background(), params)
+}
+
+// MarketGetOrderItemsContext is the same as MarketGetOrderItems, but takes a context.
+func (vk *VK) MarketGetOrderItemsContext(ctx context.Context, params Params) (response MarketGetOrderItemsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.getOrderItems", params, &response)
 	return
 }
 
@@ -254,7 +361,12 @@ func (vk *VK) MarketGetOrderItems(params Params) (response MarketGetOrderItemsRe
 //
 // https://vk.com/dev/market.removeFromAlbum
 func (vk *VK) MarketRemoveFromAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.removeFromAlbum", params, &response)
+	return vk.MarketRemoveFromAlbumContext(context.Background(), params)
+}
+
+// MarketRemoveFromAlbumContext is the same as MarketRemoveFromAlbum, but takes a context.
+func (vk *VK) MarketRemoveFromAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.removeFromAlbum", params, &response)
 	return
 }
 
@@ -262,7 +374,12 @@ func (vk *VK) MarketRemoveFromAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.reorderAlbums
 func (vk *VK) MarketReorderAlbums(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.reorderAlbums", params, &response)
+	return vk.MarketReorderAlbumsContext(context.Background(), params)
+}
+
+// MarketReorderAlbumsContext is the same as MarketReorderAlbums, but takes a context.
+func (vk *VK) MarketReorderAlbumsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.reorderAlbums", params, &response)
 	return
 }
 
@@ -270,7 +387,12 @@ func (vk *VK) MarketReorderAlbums(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.reorderItems
 func (vk *VK) MarketReorderItems(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.reorderItems", params, &response)
+	return vk.MarketReorderItemsContext(context.Background(), params)
+}
+
+// MarketReorderItemsContext is the same as MarketReorderItems, but takes a context.
+func (vk *VK) MarketReorderItemsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.reorderItems", params, &response)
 	return
 }
 
@@ -278,7 +400,12 @@ func (vk *VK) MarketReorderItems(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.report
 func (vk *VK) MarketReport(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.report", params, &response)
+	return vk.MarketReportContext(context.Background(), params)
+}
+
+// MarketReportContext is the same as MarketReport, but takes a context.
+func (vk *VK) MarketReportContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.report", params, &response)
 	return
 }
 
@@ -286,7 +413,12 @@ func (vk *VK) MarketReport(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.reportComment
 func (vk *VK) MarketReportComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.reportComment", params, &response)
+	return vk.MarketReportCommentContext(context.Background(), params)
+}
+
+// MarketReportCommentContext is the same as MarketReportComment, but takes a context.
+func (vk *VK) MarketReportCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.reportComment", params, &response)
 	return
 }
 
@@ -294,7 +426,12 @@ func (vk *VK) MarketReportComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.restore
 func (vk *VK) MarketRestore(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.restore", params, &response)
+	return vk.MarketRestoreContext(context.Background(), params)
+}
+
+// MarketRestoreContext is the same as MarketRestore, but takes a context.
+func (vk *VK) MarketRestoreContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.restore", params, &response)
 	return
 }
 
@@ -302,7 +439,12 @@ func (vk *VK) MarketRestore(params Params) (response int, err error) {
 //
 // https://vk.com/dev/market.restoreComment
 func (vk *VK) MarketRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("market.restoreComment", params, &response)
+	return vk.MarketRestoreCommentContext(context.Background(), params)
+}
+
+// MarketRestoreCommentContext is the same as MarketRestoreComment, but takes a context.
+func (vk *VK) MarketRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.restoreComment", params, &response)
 	return
 }
 
@@ -316,6 +458,11 @@ type MarketSearchResponse struct {
 //
 // https://vk.com/dev/market.search
 func (vk *VK) MarketSearch(params Params) (response MarketSearchResponse, err error) {
-	err = vk.RequestUnmarshal("market.search", params, &response)
+	return vk.MarketSearchContext(context.Background(), params)
+}
+
+// MarketSearchContext is the same as MarketSearch, but takes a context.
+func (vk *VK) MarketSearchContext(ctx context.Context, params Params) (response MarketSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "market.search", params, &response)
 	return
 }
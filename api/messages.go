@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/messages.addChatUser
 func (vk *VK) MessagesAddChatUser(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.addChatUser", params, &response)
+	return vk.MessagesAddChatUserContext(context.Background(), params)
+}
+
+// MessagesAddChatUserContext is the same as MessagesAddChatUser, but takes a context.
+func (vk *VK) MessagesAddChatUserContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.addChatUser", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) MessagesAddChatUser(params Params) (response int, err error) {
 //
 // https://vk.com/dev/messages.allowMessagesFromGroup
 func (vk *VK) MessagesAllowMessagesFromGroup(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.allowMessagesFromGroup", params, &response)
+	return vk.MessagesAllowMessagesFromGroupContext(context.Background(), params)
+}
+
+// MessagesAllowMessagesFromGroupContext is the same as MessagesAllowMessagesFromGroup, but takes a context.
+func (vk *VK) MessagesAllowMessagesFromGroupContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.allowMessagesFromGroup", params, &response)
 	return
 }
 
@@ -24,7 +36,12 @@ func (vk *VK) MessagesAllowMessagesFromGroup(params Params) (response int, err e
 //
 // https://vk.com/dev/messages.createChat
 func (vk *VK) MessagesCreateChat(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.createChat", params, &response)
+	return vk.MessagesCreateChatContext(context.Background(), params)
+}
+
+// MessagesCreateChatContext is the same as MessagesCreateChat, but takes a context.
+func (vk *VK) MessagesCreateChatContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.createChat", params, &response)
 	return
 }
 
@@ -35,7 +52,12 @@ type MessagesDeleteResponse map[string]int
 //
 // https://vk.com/dev/messages.delete
 func (vk *VK) MessagesDelete(params Params) (response MessagesDeleteResponse, err error) {
-	err = vk.RequestUnmarshal("messages.delete", params, &response)
+	return vk.MessagesDeleteContext(context.Background(), params)
+}
+
+// MessagesDeleteContext is the same as MessagesDelete, but takes a context.
+func (vk *VK) MessagesDeleteContext(ctx context.Context, params Params) (response MessagesDeleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.delete", params, &response)
 	return
 }
 
@@ -49,7 +71,12 @@ type MessagesDeleteChatPhotoResponse struct {
 //
 // https://vk.com/dev/messages.deleteChatPhoto
 func (vk *VK) MessagesDeleteChatPhoto(params Params) (response MessagesDeleteChatPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("messages.deleteChatPhoto", params, &response)
+	return vk.MessagesDeleteChatPhotoContext(context.Background(), params)
+}
+
+// MessagesDeleteChatPhotoContext is the same as MessagesDeleteChatPhoto, but takes a context.
+func (vk *VK) MessagesDeleteChatPhotoContext(ctx context.Context, params Params) (response MessagesDeleteChatPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.deleteChatPhoto", params, &response)
 	return
 }
 
@@ -62,7 +89,12 @@ type MessagesDeleteConversationResponse struct {
 //
 // https://vk.com/dev/messages.deleteConversation
 func (vk *VK) MessagesDeleteConversation(params Params) (response MessagesDeleteConversationResponse, err error) {
-	err = vk.RequestUnmarshal("messages.deleteConversation", params, &response)
+	return vk.MessagesDeleteConversationContext(context.Background(), params)
+}
+
+// MessagesDeleteConversationContext is the same as MessagesDeleteConversation, but takes a context.
+func (vk *VK) MessagesDeleteConversationContext(ctx context.Context, params Params) (response MessagesDeleteConversationResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.deleteConversation", params, &response)
 	return
 }
 
@@ -70,7 +102,12 @@ func (vk *VK) MessagesDeleteConversation(params Params) (response MessagesDelete
 //
 // https://vk.com/dev/messages.denyMessagesFromGroup
 func (vk *VK) MessagesDenyMessagesFromGroup(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.denyMessagesFromGroup", params, &response)
+	return vk.MessagesDenyMessagesFromGroupContext(context.Background(), params)
+}
+
+// MessagesDenyMessagesFromGroupContext is the same as MessagesDenyMessagesFromGroup, but takes a context.
+func (vk *VK) MessagesDenyMessagesFromGroupContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.denyMessagesFromGroup", params, &response)
 	return
 }
 
@@ -78,7 +115,12 @@ func (vk *VK) MessagesDenyMessagesFromGroup(params Params) (response int, err er
 //
 // https://vk.com/dev/messages.edit
 func (vk *VK) MessagesEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.edit", params, &response)
+	return vk.MessagesEditContext(context.Background(), params)
+}
+
+// MessagesEditContext is the same as MessagesEdit, but takes a context.
+func (vk *VK) MessagesEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.edit", params, &response)
 	return
 }
 
@@ -86,7 +128,12 @@ func (vk *VK) MessagesEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/messages.editChat
 func (vk *VK) MessagesEditChat(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.editChat", params, &response)
+	return vk.MessagesEditChatContext(context.Background(), params)
+}
+
+// MessagesEditChatContext is the same as MessagesEditChat, but takes a context.
+func (vk *VK) MessagesEditChatContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.editChat", params, &response)
 	return
 }
 
@@ -101,7 +148,12 @@ type MessagesGetByConversationMessageIDResponse struct {
 //
 // https://vk.com/dev/messages.getByConversationMessageId
 func (vk *VK) MessagesGetByConversationMessageID(params Params) (response MessagesGetByConversationMessageIDResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getByConversationMessageId", params, &response)
+	return vk.MessagesGetByConversationMessageIDContext(context.Background(), params)
+}
+
+// MessagesGetByConversationMessageIDContext is the same as MessagesGetByConversationMessageID, but takes a context.
+func (vk *VK) MessagesGetByConversationMessageIDContext(ctx context.Context, params Params) (response MessagesGetByConversationMessageIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getByConversationMessageId", params, &response)
 	return
 }
 
@@ -117,8 +169,13 @@ type MessagesGetByIDResponse struct {
 //
 // https://vk.com/dev/messages.getById
 func (vk *VK) MessagesGetByID(params Params) (response MessagesGetByIDResponse, err error) {
+	return vk.MessagesGetByIDContext(context.Background(), params)
+}
+
+// MessagesGetByIDContext is the same as MessagesGetByID, but takes a context.
+func (vk *VK) MessagesGetByIDContext(ctx context.Context, params Params) (response MessagesGetByIDResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("messages.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.getById", params, &response)
 
 	return
 }
@@ -136,8 +193,13 @@ type MessagesGetByIDExtendedResponse struct {
 //
 // https://vk.com/dev/messages.getById
 func (vk *VK) MessagesGetByIDExtended(params Params) (response MessagesGetByIDExtendedResponse, err error) {
+	return vk.MessagesGetByIDExtendedContext(context.Background(), params)
+}
+
+// MessagesGetByIDExtendedContext is the same as MessagesGetByIDExtended, but takes a context.
+func (vk *VK) MessagesGetByIDExtendedContext(ctx context.Context, params Params) (response MessagesGetByIDExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("messages.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.getById", params, &response)
 
 	return
 }
@@ -149,7 +211,12 @@ type MessagesGetChatResponse object.MessagesChat
 //
 // https://vk.com/dev/messages.getChat
 func (vk *VK) MessagesGetChat(params Params) (response MessagesGetChatResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getChat", params, &response)
+	return vk.MessagesGetChatContext(context.Background(), params)
+}
+
+// MessagesGetChatContext is the same as MessagesGetChat, but takes a context.
+func (vk *VK) MessagesGetChatContext(ctx context.Context, params Params) (response MessagesGetChatResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getChat", params, &response)
 	return
 }
 
@@ -160,7 +227,12 @@ type MessagesGetChatChatIDsResponse []object.MessagesChat
 //
 // https://vk.com/dev/messages.getChat
 func (vk *VK) MessagesGetChatChatIDs(params Params) (response MessagesGetChatChatIDsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getChat", params, &response)
+	return vk.MessagesGetChatChatIDsContext(context.Background(), params)
+}
+
+// MessagesGetChatChatIDsContext is the same as MessagesGetChatChatIDs, but takes a context.
+func (vk *VK) MessagesGetChatChatIDsContext(ctx context.Context, params Params) (response MessagesGetChatChatIDsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getChat", params, &response)
 	return
 }
 
@@ -174,7 +246,12 @@ type MessagesGetChatPreviewResponse struct {
 //
 // https://vk.com/dev/messages.getChatPreview
 func (vk *VK) MessagesGetChatPreview(params Params) (response MessagesGetChatPreviewResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getChatPreview", params, &response)
+	return vk.MessagesGetChatPreviewContext(context.Background(), params)
+}
+
+// MessagesGetChatPreviewContext is the same as MessagesGetChatPreview, but takes a context.
+func (vk *VK) MessagesGetChatPreviewContext(ctx context.Context, params Params) (response MessagesGetChatPreviewResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getChatPreview", params, &response)
 	return
 }
 
@@ -202,7 +279,12 @@ type MessagesGetConversationMembersResponse struct {
 //
 // https://vk.com/dev/messages.getConversationMembers
 func (vk *VK) MessagesGetConversationMembers(params Params) (response MessagesGetConversationMembersResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getConversationMembers", params, &response)
+	return vk.MessagesGetConversationMembersContext(context.Background(), params)
+}
+
+// MessagesGetConversationMembersContext is the same as MessagesGetConversationMembers, but takes a context.
+func (vk *VK) MessagesGetConversationMembersContext(ctx context.Context, params Params) (response MessagesGetConversationMembersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getConversationMembers", params, &response)
 	return
 }
 
@@ -220,7 +302,12 @@ type MessagesGetConversationsResponse struct {
 //
 // https://vk.com/dev/messages.getConversations
 func (vk *VK) MessagesGetConversations(params Params) (response MessagesGetConversationsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getConversations", params, &response)
+	return vk.MessagesGetConversationsContext(context.Background(), params)
+}
+
+// MessagesGetConversationsContext is the same as MessagesGetConversations, but takes a context.
+func (vk *VK) MessagesGetConversationsContext(ctx context.Context, params Params) (response MessagesGetConversationsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getConversations", params, &response)
 	return
 }
 
@@ -236,8 +323,13 @@ type MessagesGetConversationsByIDResponse struct {
 //
 // https://vk.com/dev/messages.getConversationsById
 func (vk *VK) MessagesGetConversationsByID(params Params) (response MessagesGetConversationsByIDResponse, err error) {
+	return vk.MessagesGetConversationsByIDContext(context.Background(), params)
+}
+
+// MessagesGetConversationsByIDContext is the same as MessagesGetConversationsByID, but takes a context.
+func (vk *VK) MessagesGetConversationsByIDContext(ctx context.Context, params Params) (response MessagesGetConversationsByIDResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("messages.getConversationsById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.getConversationsById", params, &response)
 
 	return
 }
@@ -255,8 +347,13 @@ type MessagesGetConversationsByIDExtendedResponse struct {
 //
 // https://vk.com/dev/messages.getConversationsById
 func (vk *VK) MessagesGetConversationsByIDExtended(params Params) (response MessagesGetConversationsByIDExtendedResponse, err error) {
+	return vk.MessagesGetConversationsByIDExtendedContext(context.Background(), params)
+}
+
+// MessagesGetConversationsByIDExtendedContext is the same as MessagesGetConversationsByIDExtended, but takes a context.
+func (vk *VK) MessagesGetConversationsByIDExtendedContext(ctx context.Context, params Params) (response MessagesGetConversationsByIDExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("messages.getConversationsById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.getConversationsById", params, &response)
 
 	return
 }
@@ -273,7 +370,12 @@ type MessagesGetHistoryResponse struct {
 //
 // https://vk.com/dev/messages.getHistory
 func (vk *VK) MessagesGetHistory(params Params) (response MessagesGetHistoryResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getHistory", params, &response)
+	return vk.MessagesGetHistoryContext(context.Background(), params)
+}
+
+// MessagesGetHistoryContext is the same as MessagesGetHistory, but takes a context.
+func (vk *VK) MessagesGetHistoryContext(ctx context.Context, params Params) (response MessagesGetHistoryResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getHistory", params, &response)
 	return
 }
 
@@ -287,7 +389,12 @@ type MessagesGetHistoryAttachmentsResponse struct {
 //
 // https://vk.com/dev/messages.getHistoryAttachments
 func (vk *VK) MessagesGetHistoryAttachments(params Params) (response MessagesGetHistoryAttachmentsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getHistoryAttachments", params, &response)
+	return vk.MessagesGetHistoryAttachmentsContext(context.Background(), params)
+}
+
+// MessagesGetHistoryAttachmentsContext is the same as MessagesGetHistoryAttachments, but takes a context.
+func (vk *VK) MessagesGetHistoryAttachmentsContext(ctx context.Context, params Params) (response MessagesGetHistoryAttachmentsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getHistoryAttachments", params, &response)
 	return
 }
 
@@ -306,7 +413,12 @@ type MessagesGetImportantMessagesResponse struct {
 //
 // https://vk.com/dev/messages.getImportantMessages
 func (vk *VK) MessagesGetImportantMessages(params Params) (response MessagesGetImportantMessagesResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getImportantMessages", params, &response)
+	return vk.MessagesGetImportantMessagesContext(context.Background(), params)
+}
+
+// MessagesGetImportantMessagesContext is the same as MessagesGetImportantMessages, but takes a context.
+func (vk *VK) MessagesGetImportantMessagesContext(ctx context.Context, params Params) (response MessagesGetImportantMessagesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getImportantMessages", params, &response)
 	return
 }
 
@@ -319,7 +431,12 @@ type MessagesGetInviteLinkResponse struct {
 //
 // https://vk.com/dev/messages.getInviteLink
 func (vk *VK) MessagesGetInviteLink(params Params) (response MessagesGetInviteLinkResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getInviteLink", params, &response)
+	return vk.MessagesGetInviteLinkContext(context.Background(), params)
+}
+
+// MessagesGetInviteLinkContext is the same as MessagesGetInviteLink, but takes a context.
+func (vk *VK) MessagesGetInviteLinkContext(ctx context.Context, params Params) (response MessagesGetInviteLinkResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getInviteLink", params, &response)
 	return
 }
 
@@ -330,7 +447,12 @@ type MessagesGetLastActivityResponse object.MessagesLastActivity
 //
 // https://vk.com/dev/messages.getLastActivity
 func (vk *VK) MessagesGetLastActivity(params Params) (response MessagesGetLastActivityResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getLastActivity", params, &response)
+	return vk.MessagesGetLastActivityContext(context.Background(), params)
+}
+
+// MessagesGetLastActivityContext is the same as MessagesGetLastActivity, but takes a context.
+func (vk *VK) MessagesGetLastActivityContext(ctx context.Context, params Params) (response MessagesGetLastActivityResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getLastActivity", params, &response)
 	return
 }
 
@@ -345,7 +467,7 @@ type MessagesGetLongPollHistoryResponse struct {
 	Profiles []object.UsersUser `json:"profiles"`
 	// Chats struct {} `json:"chats"`
 	NewPTS        int                           `json:"new_pts"`
-	More          object.BaseBoolInt            `json:"chats"`
+	More          object.BaseBoolInt            `json:"more"`
 	Conversations []object.MessagesConversation `json:"conversations"`
 }
 
@@ -353,7 +475,12 @@ type MessagesGetLongPollHistoryResponse struct {
 //
 // https://vk.com/dev/messages.getLongPollHistory
 func (vk *VK) MessagesGetLongPollHistory(params Params) (response MessagesGetLongPollHistoryResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getLongPollHistory", params, &response)
+	return vk.MessagesGetLongPollHistoryContext(context.Background(), params)
+}
+
+// MessagesGetLongPollHistoryContext is the same as MessagesGetLongPollHistory, but takes a context.
+func (vk *VK) MessagesGetLongPollHistoryContext(ctx context.Context, params Params) (response MessagesGetLongPollHistoryResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getLongPollHistory", params, &response)
 	return
 }
 
@@ -364,7 +491,12 @@ type MessagesGetLongPollServerResponse object.MessagesLongpollParams
 //
 // https://vk.com/dev/messages.getLongPollServer
 func (vk *VK) MessagesGetLongPollServer(params Params) (response MessagesGetLongPollServerResponse, err error) {
-	err = vk.RequestUnmarshal("messages.getLongPollServer", params, &response)
+	return vk.MessagesGetLongPollServerContext(context.Background(), params)
+}
+
+// MessagesGetLongPollServerContext is the same as MessagesGetLongPollServer, but takes a context.
+func (vk *VK) MessagesGetLongPollServerContext(ctx context.Context, params Params) (response MessagesGetLongPollServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.getLongPollServer", params, &response)
 	return
 }
 
@@ -378,7 +510,12 @@ type MessagesIsMessagesFromGroupAllowedResponse struct {
 //
 // https://vk.com/dev/messages.isMessagesFromGroupAllowed
 func (vk *VK) MessagesIsMessagesFromGroupAllowed(params Params) (response MessagesIsMessagesFromGroupAllowedResponse, err error) {
-	err = vk.RequestUnmarshal("messages.isMessagesFromGroupAllowed", params, &response)
+	return vk.MessagesIsMessagesFromGroupAllowedContext(context.Background(), params)
+}
+
+// MessagesIsMessagesFromGroupAllowedContext is the same as MessagesIsMessagesFromGroupAllowed, but takes a context.
+func (vk *VK) MessagesIsMessagesFromGroupAllowedContext(ctx context.Context, params Params) (response MessagesIsMessagesFromGroupAllowedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.isMessagesFromGroupAllowed", params, &response)
 	return
 }
 
@@ -391,7 +528,12 @@ type MessagesJoinChatByInviteLinkResponse struct {
 //
 // https://vk.com/dev/messages.joinChatByInviteLink
 func (vk *VK) MessagesJoinChatByInviteLink(params Params) (response MessagesJoinChatByInviteLinkResponse, err error) {
-	err = vk.RequestUnmarshal("messages.joinChatByInviteLink", params, &response)
+	return vk.MessagesJoinChatByInviteLinkContext(context.Background(), params)
+}
+
+// MessagesJoinChatByInviteLinkContext is the same as MessagesJoinChatByInviteLink, but takes a context.
+func (vk *VK) MessagesJoinChatByInviteLinkContext(ctx context.Context, params Params) (response MessagesJoinChatByInviteLinkResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.joinChatByInviteLink", params, &response)
 	return
 }
 
@@ -399,7 +541,12 @@ func (vk *VK) MessagesJoinChatByInviteLink(params Params) (response MessagesJoin
 //
 // https://vk.com/dev/messages.markAsAnsweredConversation
 func (vk *VK) MessagesMarkAsAnsweredConversation(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.markAsAnsweredConversation", params, &response)
+	return vk.MessagesMarkAsAnsweredConversationContext(context.Background(), params)
+}
+
+// MessagesMarkAsAnsweredConversationContext is the same as MessagesMarkAsAnsweredConversation, but takes a context.
+func (vk *VK) MessagesMarkAsAnsweredConversationContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.markAsAnsweredConversation", params, &response)
 	return
 }
 
@@ -410,7 +557,12 @@ type MessagesMarkAsImportantResponse []int
 //
 // https://vk.com/dev/messages.markAsImportant
 func (vk *VK) MessagesMarkAsImportant(params Params) (response MessagesMarkAsImportantResponse, err error) {
-	err = vk.RequestUnmarshal("messages.markAsImportant", params, &response)
+	return vk.MessagesMarkAsImportantContext(context.Background(), params)
+}
+
+// MessagesMarkAsImportantContext is the same as MessagesMarkAsImportant, but takes a context.
+func (vk *VK) MessagesMarkAsImportantContext(ctx context.Context, params Params) (response MessagesMarkAsImportantResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.markAsImportant", params, &response)
 	return
 }
 
@@ -418,7 +570,12 @@ func (vk *VK) MessagesMarkAsImportant(params Params) (response MessagesMarkAsImp
 //
 // https://vk.com/dev/messages.markAsImportantConversation
 func (vk *VK) MessagesMarkAsImportantConversation(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.markAsImportantConversation", params, &response)
+	return vk.MessagesMarkAsImportantConversationContext(context.Background(), params)
+}
+
+// MessagesMarkAsImportantConversationContext is the same as MessagesMarkAsImportantConversation, but takes a context.
+func (vk *VK) MessagesMarkAsImportantConversationContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.markAsImportantConversation", params, &response)
 	return
 }
 
@@ -426,7 +583,12 @@ func (vk *VK) MessagesMarkAsImportantConversation(params Params) (response int,
 //
 // https://vk.com/dev/messages.markAsRead
 func (vk *VK) MessagesMarkAsRead(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.markAsRead", params, &response)
+	return vk.MessagesMarkAsReadContext(context.Background(), params)
+}
+
+// MessagesMarkAsReadContext is the same as MessagesMarkAsRead, but takes a context.
+func (vk *VK) MessagesMarkAsReadContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.markAsRead", params, &response)
 	return
 }
 
@@ -437,7 +599,12 @@ type MessagesPinResponse object.MessagesMessage
 //
 // https://vk.com/dev/messages.pin
 func (vk *VK) MessagesPin(params Params) (response MessagesPinResponse, err error) {
-	err = vk.RequestUnmarshal("messages.pin", params, &response)
+	return vk.MessagesPinContext(context.Background(), params)
+}
+
+// MessagesPinContext is the same as MessagesPin, but takes a context.
+func (vk *VK) MessagesPinContext(ctx context.Context, params Params) (response MessagesPinResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.pin", params, &response)
 	return
 }
 
@@ -445,7 +612,12 @@ func (vk *VK) MessagesPin(params Params) (response MessagesPinResponse, err erro
 //
 // https://vk.com/dev/messages.removeChatUser
 func (vk *VK) MessagesRemoveChatUser(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.removeChatUser", params, &response)
+	return vk.MessagesRemoveChatUserContext(context.Background(), params)
+}
+
+// MessagesRemoveChatUserContext is the same as MessagesRemoveChatUser, but takes a context.
+func (vk *VK) MessagesRemoveChatUserContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.removeChatUser", params, &response)
 	return
 }
 
@@ -453,7 +625,12 @@ func (vk *VK) MessagesRemoveChatUser(params Params) (response int, err error) {
 //
 // https://vk.com/dev/messages.restore
 func (vk *VK) MessagesRestore(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.restore", params, &response)
+	return vk.MessagesRestoreContext(context.Background(), params)
+}
+
+// MessagesRestoreContext is the same as MessagesRestore, but takes a context.
+func (vk *VK) MessagesRestoreContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.restore", params, &response)
 	return
 }
 
@@ -470,7 +647,12 @@ type MessagesSearchResponse struct {
 //
 // https://vk.com/dev/messages.search
 func (vk *VK) MessagesSearch(params Params) (response MessagesSearchResponse, err error) {
-	err = vk.RequestUnmarshal("messages.search", params, &response)
+	return vk.MessagesSearchContext(context.Background(), params)
+}
+
+// MessagesSearchContext is the same as MessagesSearch, but takes a context.
+func (vk *VK) MessagesSearchContext(ctx context.Context, params Params) (response MessagesSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.search", params, &response)
 	return
 }
 
@@ -485,7 +667,12 @@ type MessagesSearchConversationsResponse struct {
 //
 // https://vk.com/dev/messages.searchConversations
 func (vk *VK) MessagesSearchConversations(params Params) (response MessagesSearchConversationsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.searchConversations", params, &response)
+	return vk.MessagesSearchConversationsContext(context.Background(), params)
+}
+
+// MessagesSearchConversationsContext is the same as MessagesSearchConversations, but takes a context.
+func (vk *VK) MessagesSearchConversationsContext(ctx context.Context, params Params) (response MessagesSearchConversationsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.searchConversations", params, &response)
 	return
 }
 
@@ -493,12 +680,31 @@ func (vk *VK) MessagesSearchConversations(params Params) (response MessagesSearc
 //
 // https://vk.com/dev/messages.send
 func (vk *VK) MessagesSend(params Params) (response int, err error) {
+	return vk.MessagesSendContext(context.Background(), params)
+}
+
+// MessagesSendContext is the same as MessagesSend, but takes a context.
+func (vk *VK) MessagesSendContext(ctx context.Context, params Params) (response int, err error) {
 	params["user_ids"] = ""
-	err = vk.RequestUnmarshal("messages.send", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.send", params, &response)
 
 	return
 }
 
+// MessagesSendMessageEventAnswer sends an answer to message_event event
+// of a callback button.
+//
+// https://vk.com/dev/messages.sendMessageEventAnswer
+func (vk *VK) MessagesSendMessageEventAnswer(params Params) (response int, err error) {
+	return vk.MessagesSendMessageEventAnswerContext(context.Background(), params)
+}
+
+// MessagesSendMessageEventAnswerContext is the same as MessagesSendMessageEventAnswer, but takes a context.
+func (vk *VK) MessagesSendMessageEventAnswerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.sendMessageEventAnswer", params, &response)
+	return
+}
+
 // MessagesSendUserIDsResponse struct.
 type MessagesSendUserIDsResponse []struct {
 	PeerID    int `json:"peer_id"`
@@ -515,7 +721,12 @@ type MessagesSendUserIDsResponse []struct {
 //
 // https://vk.com/dev/messages.send
 func (vk *VK) MessagesSendUserIDs(params Params) (response MessagesSendUserIDsResponse, err error) {
-	err = vk.RequestUnmarshal("messages.send", params, &response)
+	return vk.MessagesSendUserIDsContext(context.Background(), params)
+}
+
+// MessagesSendUserIDsContext is the same as MessagesSendUserIDs, but takes a context.
+func (vk *VK) MessagesSendUserIDsContext(ctx context.Context, params Params) (response MessagesSendUserIDsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.send", params, &response)
 	return
 }
 
@@ -523,8 +734,13 @@ func (vk *VK) MessagesSendUserIDs(params Params) (response MessagesSendUserIDsRe
 //
 // https://vk.com/dev/messages.sendSticker
 func (vk *VK) MessagesSendSticker(params Params) (response int, err error) {
+	return vk.MessagesSendStickerContext(context.Background(), params)
+}
+
+// MessagesSendStickerContext is the same as MessagesSendSticker, but takes a context.
+func (vk *VK) MessagesSendStickerContext(ctx context.Context, params Params) (response int, err error) {
 	params["user_ids"] = ""
-	err = vk.RequestUnmarshal("messages.sendSticker", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "messages.sendSticker", params, &response)
 
 	return
 }
@@ -533,7 +749,12 @@ func (vk *VK) MessagesSendSticker(params Params) (response int, err error) {
 //
 // https://vk.com/dev/messages.setActivity
 func (vk *VK) MessagesSetActivity(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.setActivity", params, &response)
+	return vk.MessagesSetActivityContext(context.Background(), params)
+}
+
+// MessagesSetActivityContext is the same as MessagesSetActivity, but takes a context.
+func (vk *VK) MessagesSetActivityContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.setActivity", params, &response)
 	return
 }
 
@@ -547,7 +768,12 @@ type MessagesSetChatPhotoResponse struct {
 //
 // https://vk.com/dev/messages.setChatPhoto
 func (vk *VK) MessagesSetChatPhoto(params Params) (response MessagesSetChatPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("messages.setChatPhoto", params, &response)
+	return vk.MessagesSetChatPhotoContext(context.Background(), params)
+}
+
+// MessagesSetChatPhotoContext is the same as MessagesSetChatPhoto, but takes a context.
+func (vk *VK) MessagesSetChatPhotoContext(ctx context.Context, params Params) (response MessagesSetChatPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.setChatPhoto", params, &response)
 	return
 }
 
@@ -555,6 +781,11 @@ func (vk *VK) MessagesSetChatPhoto(params Params) (response MessagesSetChatPhoto
 //
 // https://vk.com/dev/messages.unpin
 func (vk *VK) MessagesUnpin(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("messages.unpin", params, &response)
+	return vk.MessagesUnpinContext(context.Background(), params)
+}
+
+// MessagesUnpinContext is the same as MessagesUnpin, but takes a context.
+func (vk *VK) MessagesUnpinContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "messages.unpin", params, &response)
 	return
 }
@@ -0,0 +1,254 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api/errors"
+)
+
+// Middleware wraps a HandlerFunc to add logic before and after the request.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Use adds middlewares around VK.HandlerContext.
+//
+// Middlewares are applied in the order they are passed, so the first one
+// is the outermost. Every call to Use wraps the chain built by previous
+// calls.
+//
+// 	vk.Use(api.LoggingMiddleware(nil), counter.Middleware())
+func (vk *VK) Use(middlewares ...Middleware) {
+	handler := vk.HandlerContext
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	vk.HandlerContext = handler
+}
+
+// isSecretParam reports whether the parameter is hidden by LoggingMiddleware.
+//
+// code is the OAuth code or the code of execute, which can contain tokens
+// passed as arguments.
+func isSecretParam(key string) bool {
+	switch key {
+	case "access_token", "client_secret", "captcha_key", "code":
+		return true
+	}
+
+	return false
+}
+
+// fmtParams returns params as sorted key=value pairs with tokens redacted.
+func fmtParams(params Params) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+
+	for i, key := range keys {
+		value := FmtValue(params[key], 0)
+		if isSecretParam(key) && value != "" {
+			value = "[REDACTED]"
+		}
+
+		pairs[i] = key + "=" + value
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// LoggingMiddleware logs every request with its parameters, duration
+// and error. Access tokens, secrets, captcha keys and codes are never
+// written to the log.
+//
+// If logger is nil, the standard logger is used.
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	printf := log.Printf
+	if logger != nil {
+		printf = logger.Printf
+	}
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, method string, params Params) (Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, method, params)
+
+			msg := fmt.Sprintf("vk: %s %s (%s)", method, fmtParams(params), time.Since(start))
+			if err != nil {
+				msg += fmt.Sprintf(" error: %v", err)
+			}
+
+			printf("%s", msg)
+
+			return resp, err
+		}
+	}
+}
+
+// HistogramStats is a snapshot of a method statistics.
+//
+// Buckets[i] is the number of requests with latency less than or equal
+// to Bounds[i]. The last element of Buckets counts requests slower than
+// every bound.
+type HistogramStats struct {
+	Count   int64
+	Errors  int64
+	Sum     time.Duration
+	Bounds  []time.Duration
+	Buckets []int64
+}
+
+// LatencyHistogram collects latency and error histograms keyed by
+// method name.
+type LatencyHistogram struct {
+	bounds []time.Duration
+	stats  map[string]*HistogramStats
+	mux    sync.Mutex
+}
+
+// NewLatencyHistogram returns a new LatencyHistogram.
+//
+// Bounds are upper bounds of buckets. If no bounds are passed,
+// 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s and 10s are used.
+func NewLatencyHistogram(bounds ...time.Duration) *LatencyHistogram {
+	if len(bounds) == 0 {
+		bounds = []time.Duration{
+			50 * time.Millisecond,
+			100 * time.Millisecond,
+			250 * time.Millisecond,
+			500 * time.Millisecond,
+			time.Second,
+			2500 * time.Millisecond,
+			5 * time.Second,
+			10 * time.Second,
+		}
+	}
+
+	sorted := make([]time.Duration, len(bounds))
+	copy(sorted, bounds)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return &LatencyHistogram{
+		bounds: sorted,
+		stats:  make(map[string]*HistogramStats),
+	}
+}
+
+// Observe adds a request to the histogram.
+func (h *LatencyHistogram) Observe(method string, d time.Duration, err error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	s, ok := h.stats[method]
+	if !ok {
+		s = &HistogramStats{
+			Bounds:  h.bounds,
+			Buckets: make([]int64, len(h.bounds)+1),
+		}
+		h.stats[method] = s
+	}
+
+	s.Count++
+	s.Sum += d
+
+	if err != nil {
+		s.Errors++
+	}
+
+	i := sort.Search(len(h.bounds), func(i int) bool { return d <= h.bounds[i] })
+	s.Buckets[i]++
+}
+
+// Middleware returns a Middleware that observes every request.
+func (h *LatencyHistogram) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, method string, params Params) (Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, method, params)
+			h.Observe(method, time.Since(start), err)
+
+			return resp, err
+		}
+	}
+}
+
+// Stats returns a snapshot of statistics for all observed methods.
+func (h *LatencyHistogram) Stats() map[string]HistogramStats {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	stats := make(map[string]HistogramStats, len(h.stats))
+
+	for method, s := range h.stats {
+		snapshot := *s
+		snapshot.Buckets = make([]int64, len(s.Buckets))
+		copy(snapshot.Buckets, s.Buckets)
+		stats[method] = snapshot
+	}
+
+	return stats
+}
+
+// ErrorCounter counts API errors by errors.ErrorType.
+//
+// Errors which are not returned by VK (e.g. network errors) are counted
+// as errors.NoType.
+type ErrorCounter struct {
+	counts map[errors.ErrorType]int64
+	mux    sync.Mutex
+}
+
+// NewErrorCounter returns a new ErrorCounter.
+func NewErrorCounter() *ErrorCounter {
+	return &ErrorCounter{
+		counts: make(map[errors.ErrorType]int64),
+	}
+}
+
+// Middleware returns a Middleware that counts errors.
+func (c *ErrorCounter) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, method string, params Params) (Response, error) {
+			resp, err := next(ctx, method, params)
+			if err != nil {
+				c.mux.Lock()
+				c.counts[errors.GetType(err)]++
+				c.mux.Unlock()
+			}
+
+			return resp, err
+		}
+	}
+}
+
+// Count returns the number of errors of the type.
+func (c *ErrorCounter) Count(errorType errors.ErrorType) int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.counts[errorType]
+}
+
+// Counts returns a snapshot of all counters.
+func (c *ErrorCounter) Counts() map[errors.ErrorType]int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	counts := make(map[errors.ErrorType]int64, len(c.counts))
+	for errorType, count := range c.counts {
+		counts[errorType] = count
+	}
+
+	return counts
+}
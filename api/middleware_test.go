@@ -0,0 +1,146 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestVK(handler http.HandlerFunc) (*api.VK, *httptest.Server) {
+	ts := httptest.NewServer(handler)
+
+	vk := api.NewVK("secret-token")
+	vk.MethodURL = ts.URL + "/"
+
+	return vk, ts
+}
+
+func jsonHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestVK_Use(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"response":[]}`))
+	defer ts.Close()
+
+	var calls []string
+
+	mw := func(name string) api.Middleware {
+		return func(next api.HandlerFunc) api.HandlerFunc {
+			return func(ctx context.Context, method string, params api.Params) (api.Response, error) {
+				calls = append(calls, name+" "+method)
+				return next(ctx, method, params)
+			}
+		}
+	}
+
+	vk.Use(mw("a"), mw("b"))
+
+	_, err := vk.UsersGet(api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a users.get", "b users.get"}, calls)
+
+	// Request uses the deprecated Handler which calls HandlerContext.
+	calls = nil
+	_, err = vk.Request("users.get", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a users.get", "b users.get"}, calls)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"error":{"error_code":5,"error_msg":"auth"}}`))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+
+	vk.Use(api.LoggingMiddleware(log.New(&buf, "", 0)))
+
+	_, err := vk.UsersGet(api.Params{"user_ids": 1})
+	assert.Error(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "users.get")
+	assert.Contains(t, out, "access_token=[REDACTED]")
+	assert.Contains(t, out, "user_ids=1")
+	assert.Contains(t, out, "error:")
+	assert.NotContains(t, out, "secret-token")
+
+	buf.Reset()
+
+	_, _ = vk.RequestContext(context.Background(), "execute", api.Params{
+		"code":        `return API.users.get({"access_token":"token-in-code"});`,
+		"captcha_key": "captcha",
+	})
+
+	out = buf.String()
+	assert.Contains(t, out, "code=[REDACTED]")
+	assert.Contains(t, out, "captcha_key=[REDACTED]")
+	assert.NotContains(t, out, "token-in-code")
+	assert.NotContains(t, out, "captcha ")
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	t.Parallel()
+
+	h := api.NewLatencyHistogram(time.Second, time.Millisecond)
+
+	h.Observe("users.get", 500*time.Microsecond, nil)
+	h.Observe("users.get", 500*time.Millisecond, nil)
+	h.Observe("users.get", 2*time.Second, errors.Server.New("test"))
+	h.Observe("wall.get", time.Millisecond, nil)
+
+	stats := h.Stats()
+	assert.Len(t, stats, 2)
+
+	s := stats["users.get"]
+	assert.Equal(t, int64(3), s.Count)
+	assert.Equal(t, int64(1), s.Errors)
+	assert.Equal(t, []time.Duration{time.Millisecond, time.Second}, s.Bounds)
+	assert.Equal(t, []int64{1, 1, 1}, s.Buckets)
+	assert.Equal(t, []int64{1, 0, 0}, stats["wall.get"].Buckets)
+
+	vk, ts := newTestVK(jsonHandler(`{"response":[]}`))
+	defer ts.Close()
+
+	vk.Use(h.Middleware())
+
+	_, err := vk.UsersGet(api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), h.Stats()["users.get"].Count)
+}
+
+func TestErrorCounter(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"error":{"error_code":10,"error_msg":"server"}}`))
+	defer ts.Close()
+
+	vk.RetryPolicy = nil
+
+	c := api.NewErrorCounter()
+	vk.Use(c.Middleware())
+
+	for i := 0; i < 3; i++ {
+		_, err := vk.UsersGet(api.Params{})
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, int64(3), c.Count(errors.Server))
+	assert.Equal(t, int64(0), c.Count(errors.Auth))
+	assert.Equal(t, map[errors.ErrorType]int64{errors.Server: 3}, c.Counts())
+}
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -9,7 +11,12 @@ import (
 //
 // https://vk.com/dev/newsfeed.addBan
 func (vk *VK) NewsfeedAddBan(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.addBan", params, &response)
+	return vk.NewsfeedAddBanContext(context.Background(), params)
+}
+
+// NewsfeedAddBanContext is the same as NewsfeedAddBan, but takes a context.
+func (vk *VK) NewsfeedAddBanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.addBan", params, &response)
 	return
 }
 
@@ -18,7 +25,12 @@ func (vk *VK) NewsfeedAddBan(params Params) (response int, err error) {
 //
 // https://vk.com/dev/newsfeed.deleteBan
 func (vk *VK) NewsfeedDeleteBan(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.deleteBan", params, &response)
+	return vk.NewsfeedDeleteBanContext(context.Background(), params)
+}
+
+// NewsfeedDeleteBanContext is the same as NewsfeedDeleteBan, but takes a context.
+func (vk *VK) NewsfeedDeleteBanContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.deleteBan", params, &response)
 	return
 }
 
@@ -26,7 +38,12 @@ func (vk *VK) NewsfeedDeleteBan(params Params) (response int, err error) {
 //
 // https://vk.com/dev/newsfeed.deleteList
 func (vk *VK) NewsfeedDeleteList(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.deleteList", params, &response)
+	return vk.NewsfeedDeleteListContext(context.Background(), params)
+}
+
+// NewsfeedDeleteListContext is the same as NewsfeedDeleteList, but takes a context.
+func (vk *VK) NewsfeedDeleteListContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.deleteList", params, &response)
 	return
 }
 
@@ -41,7 +58,12 @@ type NewsfeedGetResponse struct {
 //
 // https://vk.com/dev/newsfeed.get
 func (vk *VK) NewsfeedGet(params Params) (response NewsfeedGetResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.get", params, &response)
+	return vk.NewsfeedGetContext(context.Background(), params)
+}
+
+// NewsfeedGetContext is the same as NewsfeedGet, but takes a context.
+func (vk *VK) NewsfeedGetContext(ctx context.Context, params Params) (response NewsfeedGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.get", params, &response)
 	return
 }
 
@@ -57,8 +79,13 @@ type NewsfeedGetBannedResponse struct {
 //
 // https://vk.com/dev/newsfeed.getBanned
 func (vk *VK) NewsfeedGetBanned(params Params) (response NewsfeedGetBannedResponse, err error) {
+	return vk.NewsfeedGetBannedContext(context.Background(), params)
+}
+
+// NewsfeedGetBannedContext is the same as NewsfeedGetBanned, but takes a context.
+func (vk *VK) NewsfeedGetBannedContext(ctx context.Context, params Params) (response NewsfeedGetBannedResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("newsfeed.getBanned", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getBanned", params, &response)
 
 	return
 }
@@ -74,8 +101,13 @@ type NewsfeedGetBannedExtendedResponse struct {
 //
 // https://vk.com/dev/newsfeed.getBanned
 func (vk *VK) NewsfeedGetBannedExtended(params Params) (response NewsfeedGetBannedExtendedResponse, err error) {
+	return vk.NewsfeedGetBannedExtendedContext(context.Background(), params)
+}
+
+// NewsfeedGetBannedExtendedContext is the same as NewsfeedGetBannedExtended, but takes a context.
+func (vk *VK) NewsfeedGetBannedExtendedContext(ctx context.Context, params Params) (response NewsfeedGetBannedExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("newsfeed.getBanned", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getBanned", params, &response)
 
 	return
 }
@@ -91,7 +123,12 @@ type NewsfeedGetCommentsResponse struct {
 //
 // https://vk.com/dev/newsfeed.getComments
 func (vk *VK) NewsfeedGetComments(params Params) (response NewsfeedGetCommentsResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.getComments", params, &response)
+	return vk.NewsfeedGetCommentsContext(context.Background(), params)
+}
+
+// NewsfeedGetCommentsContext is the same as NewsfeedGetComments, but takes a context.
+func (vk *VK) NewsfeedGetCommentsContext(ctx context.Context, params Params) (response NewsfeedGetCommentsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getComments", params, &response)
 	return
 }
 
@@ -110,7 +147,12 @@ type NewsfeedGetListsResponse struct {
 //
 // https://vk.com/dev/newsfeed.getLists
 func (vk *VK) NewsfeedGetLists(params Params) (response NewsfeedGetListsResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.getLists", params, &response)
+	return vk.NewsfeedGetListsContext(context.Background(), params)
+}
+
+// NewsfeedGetListsContext is the same as NewsfeedGetLists, but takes a context.
+func (vk *VK) NewsfeedGetListsContext(ctx context.Context, params Params) (response NewsfeedGetListsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getLists", params, &response)
 	return
 }
 
@@ -124,7 +166,12 @@ type NewsfeedGetMentionsResponse struct {
 //
 // https://vk.com/dev/newsfeed.getMentions
 func (vk *VK) NewsfeedGetMentions(params Params) (response NewsfeedGetMentionsResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.getMentions", params, &response)
+	return vk.NewsfeedGetMentionsContext(context.Background(), params)
+}
+
+// NewsfeedGetMentionsContext is the same as NewsfeedGetMentions, but takes a context.
+func (vk *VK) NewsfeedGetMentionsContext(ctx context.Context, params Params) (response NewsfeedGetMentionsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getMentions", params, &response)
 	return
 }
 
@@ -141,7 +188,12 @@ type NewsfeedGetRecommendedResponse struct {
 //
 // https://vk.com/dev/newsfeed.getRecommended
 func (vk *VK) NewsfeedGetRecommended(params Params) (response NewsfeedGetRecommendedResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.getRecommended", params, &response)
+	return vk.NewsfeedGetRecommendedContext(context.Background(), params)
+}
+
+// NewsfeedGetRecommendedContext is the same as NewsfeedGetRecommended, but takes a context.
+func (vk *VK) NewsfeedGetRecommendedContext(ctx context.Context, params Params) (response NewsfeedGetRecommendedResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getRecommended", params, &response)
 	return
 }
 
@@ -155,7 +207,12 @@ type NewsfeedGetSuggestedSourcesResponse struct {
 //
 // https://vk.com/dev/newsfeed.getSuggestedSources
 func (vk *VK) NewsfeedGetSuggestedSources(params Params) (response NewsfeedGetSuggestedSourcesResponse, err error) {
-	err = vk.RequestUnmarshal("newsfeed.getSuggestedSources", params, &response)
+	return vk.NewsfeedGetSuggestedSourcesContext(context.Background(), params)
+}
+
+// NewsfeedGetSuggestedSourcesContext is the same as NewsfeedGetSuggestedSources, but takes a context.
+func (vk *VK) NewsfeedGetSuggestedSourcesContext(ctx context.Context, params Params) (response NewsfeedGetSuggestedSourcesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.getSuggestedSources", params, &response)
 	return
 }
 
@@ -163,7 +220,12 @@ func (vk *VK) NewsfeedGetSuggestedSources(params Params) (response NewsfeedGetSu
 //
 // https://vk.com/dev/newsfeed.ignoreItem
 func (vk *VK) NewsfeedIgnoreItem(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.ignoreItem", params, &response)
+	return vk.NewsfeedIgnoreItemContext(context.Background(), params)
+}
+
+// NewsfeedIgnoreItemContext is the same as NewsfeedIgnoreItem, but takes a context.
+func (vk *VK) NewsfeedIgnoreItemContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.ignoreItem", params, &response)
 	return
 }
 
@@ -171,7 +233,12 @@ func (vk *VK) NewsfeedIgnoreItem(params Params) (response int, err error) {
 //
 // https://vk.com/dev/newsfeed.saveList
 func (vk *VK) NewsfeedSaveList(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.saveList", params, &response)
+	return vk.NewsfeedSaveListContext(context.Background(), params)
+}
+
+// NewsfeedSaveListContext is the same as NewsfeedSaveList, but takes a context.
+func (vk *VK) NewsfeedSaveListContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.saveList", params, &response)
 	return
 }
 
@@ -189,8 +256,13 @@ type NewsfeedSearchResponse struct {
 //
 // https://vk.com/dev/newsfeed.search
 func (vk *VK) NewsfeedSearch(params Params) (response NewsfeedSearchResponse, err error) {
+	return vk.NewsfeedSearchContext(context.Background(), params)
+}
+
+// NewsfeedSearchContext is the same as NewsfeedSearch, but takes a context.
+func (vk *VK) NewsfeedSearchContext(ctx context.Context, params Params) (response NewsfeedSearchResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("newsfeed.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.search", params, &response)
 
 	return
 }
@@ -211,8 +283,13 @@ type NewsfeedSearchExtendedResponse struct {
 //
 // https://vk.com/dev/newsfeed.search
 func (vk *VK) NewsfeedSearchExtended(params Params) (response NewsfeedSearchExtendedResponse, err error) {
+	return vk.NewsfeedSearchExtendedContext(context.Background(), params)
+}
+
+// NewsfeedSearchExtendedContext is the same as NewsfeedSearchExtended, but takes a context.
+func (vk *VK) NewsfeedSearchExtendedContext(ctx context.Context, params Params) (response NewsfeedSearchExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("newsfeed.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.search", params, &response)
 
 	return
 }
@@ -221,7 +298,12 @@ func (vk *VK) NewsfeedSearchExtended(params Params) (response NewsfeedSearchExte
 //
 // https://vk.com/dev/newsfeed.unignoreItem
 func (vk *VK) NewsfeedUnignoreItem(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.unignoreItem", params, &response)
+	return vk.NewsfeedUnignoreItemContext(context.Background(), params)
+}
+
+// NewsfeedUnignoreItemContext is the same as NewsfeedUnignoreItem, but takes a context.
+func (vk *VK) NewsfeedUnignoreItemContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.unignoreItem", params, &response)
 	return
 }
 
@@ -229,6 +311,11 @@ func (vk *VK) NewsfeedUnignoreItem(params Params) (response int, err error) {
 //
 // https://vk.com/dev/newsfeed.unsubscribe
 func (vk *VK) NewsfeedUnsubscribe(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("newsfeed.unsubscribe", params, &response)
+	return vk.NewsfeedUnsubscribeContext(context.Background(), params)
+}
+
+// NewsfeedUnsubscribeContext is the same as NewsfeedUnsubscribe, but takes a context.
+func (vk *VK) NewsfeedUnsubscribeContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "newsfeed.unsubscribe", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/notes.add
 func (vk *VK) NotesAdd(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.add", params, &response)
+	return vk.NotesAddContext(context.Background(), params)
+}
+
+// NotesAddContext is the same as NotesAdd, but takes a context.
+func (vk *VK) NotesAddContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.add", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) NotesAdd(params Params) (response int, err error) {
 //
 // https://vk.com/dev/notes.createComment
 func (vk *VK) NotesCreateComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.createComment", params, &response)
+	return vk.NotesCreateCommentContext(context.Background(), params)
+}
+
+// NotesCreateCommentContext is the same as NotesCreateComment, but takes a context.
+func (vk *VK) NotesCreateCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.createComment", params, &response)
 	return
 }
 
@@ -24,7 +36,12 @@ func (vk *VK) NotesCreateComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/notes.delete
 func (vk *VK) NotesDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.delete", params, &response)
+	return vk.NotesDeleteContext(context.Background(), params)
+}
+
+// NotesDeleteContext is the same as NotesDelete, but takes a context.
+func (vk *VK) NotesDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.delete", params, &response)
 	return
 }
 
@@ -32,7 +49,12 @@ func (vk *VK) NotesDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/notes.deleteComment
 func (vk *VK) NotesDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.deleteComment", params, &response)
+	return vk.NotesDeleteCommentContext(context.Background(), params)
+}
+
+// NotesDeleteCommentContext is the same as NotesDeleteComment, but takes a context.
+func (vk *VK) NotesDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.deleteComment", params, &response)
 	return
 }
 
@@ -40,7 +62,12 @@ func (vk *VK) NotesDeleteComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/notes.edit
 func (vk *VK) NotesEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.edit", params, &response)
+	return vk.NotesEditContext(context.Background(), params)
+}
+
+// NotesEditContext is the same as NotesEdit, but takes a context.
+func (vk *VK) NotesEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.edit", params, &response)
 	return
 }
 
@@ -48,7 +75,12 @@ func (vk *VK) NotesEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/notes.editComment
 func (vk *VK) NotesEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.editComment", params, &response)
+	return vk.NotesEditCommentContext(context.Background(), params)
+}
+
+// NotesEditCommentContext is the same as NotesEditComment, but takes a context.
+func (vk *VK) NotesEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.editComment", params, &response)
 	return
 }
 
@@ -62,7 +94,12 @@ type NotesGetResponse struct {
 //
 // https://vk.com/dev/notes.get
 func (vk *VK) NotesGet(params Params) (response NotesGetResponse, err error) {
-	err = vk.RequestUnmarshal("notes.get", params, &response)
+	return vk.NotesGetContext(context.Background(), params)
+}
+
+// NotesGetContext is the same as NotesGet, but takes a context.
+func (vk *VK) NotesGetContext(ctx context.Context, params Params) (response NotesGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.get", params, &response)
 	return
 }
 
@@ -73,7 +110,12 @@ type NotesGetByIDResponse object.NotesNote
 //
 // https://vk.com/dev/notes.getById
 func (vk *VK) NotesGetByID(params Params) (response NotesGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("notes.getById", params, &response)
+	return vk.NotesGetByIDContext(context.Background(), params)
+}
+
+// NotesGetByIDContext is the same as NotesGetByID, but takes a context.
+func (vk *VK) NotesGetByIDContext(ctx context.Context, params Params) (response NotesGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.getById", params, &response)
 	return
 }
 
@@ -87,7 +129,12 @@ type NotesGetCommentsResponse struct {
 //
 // https://vk.com/dev/notes.getComments
 func (vk *VK) NotesGetComments(params Params) (response NotesGetCommentsResponse, err error) {
-	err = vk.RequestUnmarshal("notes.getComments", params, &response)
+	return vk.NotesGetCommentsContext(context.Background(), params)
+}
+
+// NotesGetCommentsContext is the same as NotesGetComments, but takes a context.
+func (vk *VK) NotesGetCommentsContext(ctx context.Context, params Params) (response NotesGetCommentsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.getComments", params, &response)
 	return
 }
 
@@ -95,6 +142,11 @@ func (vk *VK) NotesGetComments(params Params) (response NotesGetCommentsResponse
 //
 // https://vk.com/dev/notes.restoreComment
 func (vk *VK) NotesRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notes.restoreComment", params, &response)
+	return vk.NotesRestoreCommentContext(context.Background(), params)
+}
+
+// NotesRestoreCommentContext is the same as NotesRestoreComment, but takes a context.
+func (vk *VK) NotesRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notes.restoreComment", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -22,7 +24,12 @@ type NotificationsGetResponse struct {
 //
 // https://vk.com/dev/notifications.get
 func (vk *VK) NotificationsGet(params Params) (response NotificationsGetResponse, err error) {
-	err = vk.RequestUnmarshal("notifications.get", params, &response)
+	return vk.NotificationsGetContext(context.Background(), params)
+}
+
+// NotificationsGetContext is the same as NotificationsGet, but takes a context.
+func (vk *VK) NotificationsGetContext(ctx context.Context, params Params) (response NotificationsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notifications.get", params, &response)
 	return
 }
 
@@ -31,7 +38,12 @@ func (vk *VK) NotificationsGet(params Params) (response NotificationsGetResponse
 //
 // https://vk.com/dev/notifications.markAsViewed
 func (vk *VK) NotificationsMarkAsViewed(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("notifications.markAsViewed", params, &response)
+	return vk.NotificationsMarkAsViewedContext(context.Background(), params)
+}
+
+// NotificationsMarkAsViewedContext is the same as NotificationsMarkAsViewed, but takes a context.
+func (vk *VK) NotificationsMarkAsViewedContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notifications.markAsViewed", params, &response)
 	return
 }
 
@@ -49,6 +61,11 @@ type NotificationsSendMessageResponse []struct {
 //
 // https://vk.com/dev/notifications.sendMessage
 func (vk *VK) NotificationsSendMessage(params Params) (response NotificationsSendMessageResponse, err error) {
-	err = vk.RequestUnmarshal("notifications.sendMessage", params, &response)
+	return vk.NotificationsSendMessageContext(context.Background(), params)
+}
+
+// NotificationsSendMessageContext is the same as NotificationsSendMessage, but takes a context.
+func (vk *VK) NotificationsSendMessageContext(ctx context.Context, params Params) (response NotificationsSendMessageResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "notifications.sendMessage", params, &response)
 	return
 }
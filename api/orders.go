@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/orders.cancelSubscription
 func (vk *VK) OrdersCancelSubscription(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("orders.cancelSubscription", params, &response)
+	return vk.OrdersCancelSubscriptionContext(context.Background(), params)
+}
+
+// OrdersCancelSubscriptionContext is the same as OrdersCancelSubscription, but takes a context.
+func (vk *VK) OrdersCancelSubscriptionContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.cancelSubscription", params, &response)
 	return
 }
 
@@ -19,7 +26,12 @@ type OrdersChangeStateResponse string // New state
 //
 // https://vk.com/dev/orders.changeState
 func (vk *VK) OrdersChangeState(params Params) (response OrdersChangeStateResponse, err error) {
-	err = vk.RequestUnmarshal("orders.changeState", params, &response)
+	return vk.OrdersChangeStateContext(context.Background(), params)
+}
+
+// OrdersChangeStateContext is the same as OrdersChangeState, but takes a context.
+func (vk *VK) OrdersChangeStateContext(ctx context.Context, params Params) (response OrdersChangeStateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.changeState", params, &response)
 	return
 }
 
@@ -30,7 +42,12 @@ type OrdersGetResponse []object.OrdersOrder
 //
 // https://vk.com/dev/orders.get
 func (vk *VK) OrdersGet(params Params) (response OrdersGetResponse, err error) {
-	err = vk.RequestUnmarshal("orders.get", params, &response)
+	return vk.OrdersGetContext(context.Background(), params)
+}
+
+// OrdersGetContext is the same as OrdersGet, but takes a context.
+func (vk *VK) OrdersGetContext(ctx context.Context, params Params) (response OrdersGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.get", params, &response)
 	return
 }
 
@@ -41,7 +58,12 @@ type OrdersGetAmountResponse []object.OrdersAmount
 //
 // https://vk.com/dev/orders.getAmount
 func (vk *VK) OrdersGetAmount(params Params) (response OrdersGetAmountResponse, err error) {
-	err = vk.RequestUnmarshal("orders.getAmount", params, &response)
+	return vk.OrdersGetAmountContext(context.Background(), params)
+}
+
+// OrdersGetAmountContext is the same as OrdersGetAmount, but takes a context.
+func (vk *VK) OrdersGetAmountContext(ctx context.Context, params Params) (response OrdersGetAmountResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.getAmount", params, &response)
 	return
 }
 
@@ -52,7 +74,12 @@ type OrdersGetByIDResponse []object.OrdersOrder
 //
 // https://vk.com/dev/orders.getByID
 func (vk *VK) OrdersGetByID(params Params) (response OrdersGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("orders.getById", params, &response)
+	return vk.OrdersGetByIDContext(context.Background(), params)
+}
+
+// OrdersGetByIDContext is the same as OrdersGetByID, but takes a context.
+func (vk *VK) OrdersGetByIDContext(ctx context.Context, params Params) (response OrdersGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.getById", params, &response)
 	return
 }
 
@@ -63,7 +90,12 @@ type OrdersGetUserSubscriptionByIDResponse object.OrdersSubscription
 //
 // https://vk.com/dev/orders.getUserSubscriptionById
 func (vk *VK) OrdersGetUserSubscriptionByID(params Params) (response OrdersGetUserSubscriptionByIDResponse, err error) {
-	err = vk.RequestUnmarshal("orders.getUserSubscriptionById", params, &response)
+	return vk.OrdersGetUserSubscriptionByIDContext(context.Background(), params)
+}
+
+// OrdersGetUserSubscriptionByIDContext is the same as OrdersGetUserSubscriptionByID, but takes a context.
+func (vk *VK) OrdersGetUserSubscriptionByIDContext(ctx context.Context, params Params) (response OrdersGetUserSubscriptionByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.getUserSubscriptionById", params, &response)
 	return
 }
 
@@ -77,7 +109,12 @@ type OrdersGetUserSubscriptionsResponse struct {
 //
 // https://vk.com/dev/orders.getUserSubscriptions
 func (vk *VK) OrdersGetUserSubscriptions(params Params) (response OrdersGetUserSubscriptionsResponse, err error) {
-	err = vk.RequestUnmarshal("orders.getUserSubscriptions", params, &response)
+	return vk.OrdersGetUserSubscriptionsContext(context.Background(), params)
+}
+
+// OrdersGetUserSubscriptionsContext is the same as OrdersGetUserSubscriptions, but takes a context.
+func (vk *VK) OrdersGetUserSubscriptionsContext(ctx context.Context, params Params) (response OrdersGetUserSubscriptionsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.getUserSubscriptions", params, &response)
 	return
 }
 
@@ -85,6 +122,11 @@ func (vk *VK) OrdersGetUserSubscriptions(params Params) (response OrdersGetUserS
 //
 // https://vk.com/dev/orders.updateSubscription
 func (vk *VK) OrdersUpdateSubscription(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("orders.updateSubscription", params, &response)
+	return vk.OrdersUpdateSubscriptionContext(context.Background(), params)
+}
+
+// OrdersUpdateSubscriptionContext is the same as OrdersUpdateSubscription, but takes a context.
+func (vk *VK) OrdersUpdateSubscriptionContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "orders.updateSubscription", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/pages.clearCache
 func (vk *VK) PagesClearCache(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("pages.clearCache", params, &response)
+	return vk.PagesClearCacheContext(context.Background(), params)
+}
+
+// PagesClearCacheContext is the same as PagesClearCache, but takes a context.
+func (vk *VK) PagesClearCacheContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.clearCache", params, &response)
 	return
 }
 
@@ -19,7 +26,12 @@ type PagesGetResponse object.PagesWikipageFull
 //
 // https://vk.com/dev/pages.get
 func (vk *VK) PagesGet(params Params) (response PagesGetResponse, err error) {
-	err = vk.RequestUnmarshal("pages.get", params, &response)
+	return vk.PagesGetContext(context.Background(), params)
+}
+
+// PagesGetContext is the same as PagesGet, but takes a context.
+func (vk *VK) PagesGetContext(ctx context.Context, params Params) (response PagesGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.get", params, &response)
 	return
 }
 
@@ -30,7 +42,12 @@ type PagesGetHistoryResponse []object.PagesWikipageHistory
 //
 // https://vk.com/dev/pages.getHistory
 func (vk *VK) PagesGetHistory(params Params) (response PagesGetHistoryResponse, err error) {
-	err = vk.RequestUnmarshal("pages.getHistory", params, &response)
+	return vk.PagesGetHistoryContext(context.Background(), params)
+}
+
+// PagesGetHistoryContext is the same as PagesGetHistory, but takes a context.
+func (vk *VK) PagesGetHistoryContext(ctx context.Context, params Params) (response PagesGetHistoryResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.getHistory", params, &response)
 	return
 }
 
@@ -41,7 +58,12 @@ type PagesGetTitlesResponse []object.PagesWikipageFull
 //
 // https://vk.com/dev/pages.getTitles
 func (vk *VK) PagesGetTitles(params Params) (response PagesGetTitlesResponse, err error) {
-	err = vk.RequestUnmarshal("pages.getTitles", params, &response)
+	return vk.PagesGetTitlesContext(context.Background(), params)
+}
+
+// PagesGetTitlesContext is the same as PagesGetTitles, but takes a context.
+func (vk *VK) PagesGetTitlesContext(ctx context.Context, params Params) (response PagesGetTitlesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.getTitles", params, &response)
 	return
 }
 
@@ -52,7 +74,12 @@ type PagesGetVersionResponse object.PagesWikipageFull
 //
 // https://vk.com/dev/pages.getVersion
 func (vk *VK) PagesGetVersion(params Params) (response PagesGetVersionResponse, err error) {
-	err = vk.RequestUnmarshal("pages.getVersion", params, &response)
+	return vk.PagesGetVersionContext(context.Background(), params)
+}
+
+// PagesGetVersionContext is the same as PagesGetVersion, but takes a context.
+func (vk *VK) PagesGetVersionContext(ctx context.Context, params Params) (response PagesGetVersionResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.getVersion", params, &response)
 	return
 }
 
@@ -60,7 +87,12 @@ func (vk *VK) PagesGetVersion(params Params) (response PagesGetVersionResponse,
 //
 // https://vk.com/dev/pages.parseWiki
 func (vk *VK) PagesParseWiki(params Params) (response string, err error) {
-	err = vk.RequestUnmarshal("pages.parseWiki", params, &response)
+	return vk.PagesParseWikiContext(context.Background(), params)
+}
+
+// PagesParseWikiContext is the same as PagesParseWiki, but takes a context.
+func (vk *VK) PagesParseWikiContext(ctx context.Context, params Params) (response string, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.parseWiki", params, &response)
 	return
 }
 
@@ -68,7 +100,12 @@ func (vk *VK) PagesParseWiki(params Params) (response string, err error) {
 //
 // https://vk.com/dev/pages.save
 func (vk *VK) PagesSave(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("pages.save", params, &response)
+	return vk.PagesSaveContext(context.Background(), params)
+}
+
+// PagesSaveContext is the same as PagesSave, but takes a context.
+func (vk *VK) PagesSaveContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.save", params, &response)
 	return
 }
 
@@ -76,6 +113,11 @@ func (vk *VK) PagesSave(params Params) (response int, err error) {
 //
 // https://vk.com/dev/pages.saveAccess
 func (vk *VK) PagesSaveAccess(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("pages.saveAccess", params, &response)
+	return vk.PagesSaveAccessContext(context.Background(), params)
+}
+
+// PagesSaveAccessContext is the same as PagesSaveAccess, but takes a context.
+func (vk *VK) PagesSaveAccessContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "pages.saveAccess", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/photos.confirmTag
 func (vk *VK) PhotosConfirmTag(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.confirmTag", params, &response)
+	return vk.PhotosConfirmTagContext(context.Background(), params)
+}
+
+// PhotosConfirmTagContext is the same as PhotosConfirmTag, but takes a context.
+func (vk *VK) PhotosConfirmTagContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.confirmTag", params, &response)
 	return
 }
 
@@ -16,7 +23,12 @@ func (vk *VK) PhotosConfirmTag(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.copy
 func (vk *VK) PhotosCopy(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.copy", params, &response)
+	return vk.PhotosCopyContext(context.Background(), params)
+}
+
+// PhotosCopyContext is the same as PhotosCopy, but takes a context.
+func (vk *VK) PhotosCopyContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.copy", params, &response)
 	return
 }
 
@@ -27,7 +39,12 @@ type PhotosCreateAlbumResponse object.PhotosPhotoAlbumFull
 //
 // https://vk.com/dev/photos.createAlbum
 func (vk *VK) PhotosCreateAlbum(params Params) (response PhotosCreateAlbumResponse, err error) {
-	err = vk.RequestUnmarshal("photos.createAlbum", params, &response)
+	return vk.PhotosCreateAlbumContext(context.Background(), params)
+}
+
+// PhotosCreateAlbumContext is the same as PhotosCreateAlbum, but takes a context.
+func (vk *VK) PhotosCreateAlbumContext(ctx context.Context, params Params) (response PhotosCreateAlbumResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.createAlbum", params, &response)
 	return
 }
 
@@ -35,7 +52,12 @@ func (vk *VK) PhotosCreateAlbum(params Params) (response PhotosCreateAlbumRespon
 //
 // https://vk.com/dev/photos.createComment
 func (vk *VK) PhotosCreateComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.createComment", params, &response)
+	return vk.PhotosCreateCommentContext(context.Background(), params)
+}
+
+// PhotosCreateCommentContext is the same as PhotosCreateComment, but takes a context.
+func (vk *VK) PhotosCreateCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.createComment", params, &response)
 	return
 }
 
@@ -43,7 +65,12 @@ func (vk *VK) PhotosCreateComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.delete
 func (vk *VK) PhotosDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.delete", params, &response)
+	return vk.PhotosDeleteContext(context.Background(), params)
+}
+
+// PhotosDeleteContext is the same as PhotosDelete, but takes a context.
+func (vk *VK) PhotosDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.delete", params, &response)
 	return
 }
 
@@ -51,7 +78,12 @@ func (vk *VK) PhotosDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.deleteAlbum
 func (vk *VK) PhotosDeleteAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.deleteAlbum", params, &response)
+	return vk.PhotosDeleteAlbumContext(context.Background(), params)
+}
+
+// PhotosDeleteAlbumContext is the same as PhotosDeleteAlbum, but takes a context.
+func (vk *VK) PhotosDeleteAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.deleteAlbum", params, &response)
 	return
 }
 
@@ -59,7 +91,12 @@ func (vk *VK) PhotosDeleteAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.deleteComment
 func (vk *VK) PhotosDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.deleteComment", params, &response)
+	return vk.PhotosDeleteCommentContext(context.Background(), params)
+}
+
+// PhotosDeleteCommentContext is the same as PhotosDeleteComment, but takes a context.
+func (vk *VK) PhotosDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.deleteComment", params, &response)
 	return
 }
 
@@ -67,7 +104,12 @@ func (vk *VK) PhotosDeleteComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.edit
 func (vk *VK) PhotosEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.edit", params, &response)
+	return vk.PhotosEditContext(context.Background(), params)
+}
+
+// PhotosEditContext is the same as PhotosEdit, but takes a context.
+func (vk *VK) PhotosEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.edit", params, &response)
 	return
 }
 
@@ -75,7 +117,12 @@ func (vk *VK) PhotosEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.editAlbum
 func (vk *VK) PhotosEditAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.editAlbum", params, &response)
+	return vk.PhotosEditAlbumContext(context.Background(), params)
+}
+
+// PhotosEditAlbumContext is the same as PhotosEditAlbum, but takes a context.
+func (vk *VK) PhotosEditAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.editAlbum", params, &response)
 	return
 }
 
@@ -83,7 +130,12 @@ func (vk *VK) PhotosEditAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.editComment
 func (vk *VK) PhotosEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.editComment", params, &response)
+	return vk.PhotosEditCommentContext(context.Background(), params)
+}
+
+// PhotosEditCommentContext is the same as PhotosEditComment, but takes a context.
+func (vk *VK) PhotosEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.editComment", params, &response)
 	return
 }
 
@@ -99,8 +151,13 @@ type PhotosGetResponse struct {
 //
 // https://vk.com/dev/photos.get
 func (vk *VK) PhotosGet(params Params) (response PhotosGetResponse, err error) {
+	return vk.PhotosGetContext(context.Background(), params)
+}
+
+// PhotosGetContext is the same as PhotosGet, but takes a context.
+func (vk *VK) PhotosGetContext(ctx context.Context, params Params) (response PhotosGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("photos.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.get", params, &response)
 
 	return
 }
@@ -117,8 +174,13 @@ type PhotosGetExtendedResponse struct {
 //
 // https://vk.com/dev/photos.get
 func (vk *VK) PhotosGetExtended(params Params) (response PhotosGetExtendedResponse, err error) {
+	return vk.PhotosGetExtendedContext(context.Background(), params)
+}
+
+// PhotosGetExtendedContext is the same as PhotosGetExtended, but takes a context.
+func (vk *VK) PhotosGetExtendedContext(ctx context.Context, params Params) (response PhotosGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("photos.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.get", params, &response)
 
 	return
 }
@@ -133,7 +195,12 @@ type PhotosGetAlbumsResponse struct {
 //
 // https://vk.com/dev/photos.getAlbums
 func (vk *VK) PhotosGetAlbums(params Params) (response PhotosGetAlbumsResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getAlbums", params, &response)
+	return vk.PhotosGetAlbumsContext(context.Background(), params)
+}
+
+// PhotosGetAlbumsContext is the same as PhotosGetAlbums, but takes a context.
+func (vk *VK) PhotosGetAlbumsContext(ctx context.Context, params Params) (response PhotosGetAlbumsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getAlbums", params, &response)
 	return
 }
 
@@ -141,7 +208,12 @@ func (vk *VK) PhotosGetAlbums(params Params) (response PhotosGetAlbumsResponse,
 //
 // https://vk.com/dev/photos.getAlbumsCount
 func (vk *VK) PhotosGetAlbumsCount(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.getAlbumsCount", params, &response)
+	return vk.PhotosGetAlbumsCountContext(context.Background(), params)
+}
+
+// PhotosGetAlbumsCountContext is the same as PhotosGetAlbumsCount, but takes a context.
+func (vk *VK) PhotosGetAlbumsCountContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getAlbumsCount", params, &response)
 	return
 }
 
@@ -158,8 +230,13 @@ type PhotosGetAllResponse struct {
 //
 // https://vk.com/dev/photos.getAll
 func (vk *VK) PhotosGetAll(params Params) (response PhotosGetAllResponse, err error) {
+	return vk.PhotosGetAllContext(context.Background(), params)
+}
+
+// PhotosGetAllContext is the same as PhotosGetAll, but takes a context.
+func (vk *VK) PhotosGetAllContext(ctx context.Context, params Params) (response PhotosGetAllResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("photos.getAll", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getAll", params, &response)
 
 	return
 }
@@ -177,8 +254,13 @@ type PhotosGetAllExtendedResponse struct {
 //
 // https://vk.com/dev/photos.getAll
 func (vk *VK) PhotosGetAllExtended(params Params) (response PhotosGetAllExtendedResponse, err error) {
+	return vk.PhotosGetAllExtendedContext(context.Background(), params)
+}
+
+// PhotosGetAllExtendedContext is the same as PhotosGetAllExtended, but takes a context.
+func (vk *VK) PhotosGetAllExtendedContext(ctx context.Context, params Params) (response PhotosGetAllExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("photos.getAll", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getAll", params, &response)
 
 	return
 }
@@ -194,7 +276,12 @@ type PhotosGetAllCommentsResponse struct {
 //
 // https://vk.com/dev/photos.getAllComments
 func (vk *VK) PhotosGetAllComments(params Params) (response PhotosGetAllCommentsResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getAllComments", params, &response)
+	return vk.PhotosGetAllCommentsContext(context.Background(), params)
+}
+
+// PhotosGetAllCommentsContext is the same as PhotosGetAllComments, but takes a context.
+func (vk *VK) PhotosGetAllCommentsContext(ctx context.Context, params Params) (response PhotosGetAllCommentsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getAllComments", params, &response)
 	return
 }
 
@@ -207,8 +294,13 @@ type PhotosGetByIDResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.getById
 func (vk *VK) PhotosGetByID(params Params) (response PhotosGetByIDResponse, err error) {
+	return vk.PhotosGetByIDContext(context.Background(), params)
+}
+
+// PhotosGetByIDContext is the same as PhotosGetByID, but takes a context.
+func (vk *VK) PhotosGetByIDContext(ctx context.Context, params Params) (response PhotosGetByIDResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("photos.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getById", params, &response)
 
 	return
 }
@@ -222,8 +314,13 @@ type PhotosGetByIDExtendedResponse []object.PhotosPhotoFull
 //
 // https://vk.com/dev/photos.getById
 func (vk *VK) PhotosGetByIDExtended(params Params) (response PhotosGetByIDExtendedResponse, err error) {
+	return vk.PhotosGetByIDExtendedContext(context.Background(), params)
+}
+
+// PhotosGetByIDExtendedContext is the same as PhotosGetByIDExtended, but takes a context.
+func (vk *VK) PhotosGetByIDExtendedContext(ctx context.Context, params Params) (response PhotosGetByIDExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("photos.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getById", params, &response)
 
 	return
 }
@@ -237,7 +334,12 @@ type PhotosGetChatUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getChatUploadServer
 func (vk *VK) PhotosGetChatUploadServer(params Params) (response PhotosGetChatUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getChatUploadServer", params, &response)
+	return vk.PhotosGetChatUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetChatUploadServerContext is the same as PhotosGetChatUploadServer, but takes a context.
+func (vk *VK) PhotosGetChatUploadServerContext(ctx context.Context, params Params) (response PhotosGetChatUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getChatUploadServer", params, &response)
 	return
 }
 
@@ -254,8 +356,13 @@ type PhotosGetCommentsResponse struct {
 //
 // https://vk.com/dev/photos.getComments
 func (vk *VK) PhotosGetComments(params Params) (response PhotosGetCommentsResponse, err error) {
+	return vk.PhotosGetCommentsContext(context.Background(), params)
+}
+
+// PhotosGetCommentsContext is the same as PhotosGetComments, but takes a context.
+func (vk *VK) PhotosGetCommentsContext(ctx context.Context, params Params) (response PhotosGetCommentsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("photos.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getComments", params, &response)
 
 	return
 }
@@ -275,8 +382,13 @@ type PhotosGetCommentsExtendedResponse struct {
 //
 // https://vk.com/dev/photos.getComments
 func (vk *VK) PhotosGetCommentsExtended(params Params) (response PhotosGetCommentsExtendedResponse, err error) {
+	return vk.PhotosGetCommentsExtendedContext(context.Background(), params)
+}
+
+// PhotosGetCommentsExtendedContext is the same as PhotosGetCommentsExtended, but takes a context.
+func (vk *VK) PhotosGetCommentsExtendedContext(ctx context.Context, params Params) (response PhotosGetCommentsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("photos.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getComments", params, &response)
 
 	return
 }
@@ -290,7 +402,12 @@ type PhotosGetMarketAlbumUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getMarketAlbumUploadServer
 func (vk *VK) PhotosGetMarketAlbumUploadServer(params Params) (response PhotosGetMarketAlbumUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getMarketAlbumUploadServer", params, &response)
+	return vk.PhotosGetMarketAlbumUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetMarketAlbumUploadServerContext is the same as PhotosGetMarketAlbumUploadServer, but takes a context.
+func (vk *VK) PhotosGetMarketAlbumUploadServerContext(ctx context.Context, params Params) (response PhotosGetMarketAlbumUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getMarketAlbumUploadServer", params, &response)
 	return
 }
 
@@ -303,7 +420,12 @@ type PhotosGetMarketUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getMarketUploadServer
 func (vk *VK) PhotosGetMarketUploadServer(params Params) (response PhotosGetMarketUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getMarketUploadServer", params, &response)
+	return vk.PhotosGetMarketUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetMarketUploadServerContext is the same as PhotosGetMarketUploadServer, but takes a context.
+func (vk *VK) PhotosGetMarketUploadServerContext(ctx context.Context, params Params) (response PhotosGetMarketUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getMarketUploadServer", params, &response)
 	return
 }
 
@@ -318,7 +440,12 @@ type PhotosGetMessagesUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getMessagesUploadServer
 func (vk *VK) PhotosGetMessagesUploadServer(params Params) (response PhotosGetMessagesUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getMessagesUploadServer", params, &response)
+	return vk.PhotosGetMessagesUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetMessagesUploadServerContext is the same as PhotosGetMessagesUploadServer, but takes a context.
+func (vk *VK) PhotosGetMessagesUploadServerContext(ctx context.Context, params Params) (response PhotosGetMessagesUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getMessagesUploadServer", params, &response)
 	return
 }
 
@@ -332,7 +459,12 @@ type PhotosGetNewTagsResponse struct {
 //
 // https://vk.com/dev/photos.getNewTags
 func (vk *VK) PhotosGetNewTags(params Params) (response PhotosGetNewTagsResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getNewTags", params, &response)
+	return vk.PhotosGetNewTagsContext(context.Background(), params)
+}
+
+// PhotosGetNewTagsContext is the same as PhotosGetNewTags, but takes a context.
+func (vk *VK) PhotosGetNewTagsContext(ctx context.Context, params Params) (response PhotosGetNewTagsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getNewTags", params, &response)
 	return
 }
 
@@ -345,7 +477,12 @@ type PhotosGetOwnerCoverPhotoUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getOwnerCoverPhotoUploadServer
 func (vk *VK) PhotosGetOwnerCoverPhotoUploadServer(params Params) (response PhotosGetOwnerCoverPhotoUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getOwnerCoverPhotoUploadServer", params, &response)
+	return vk.PhotosGetOwnerCoverPhotoUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetOwnerCoverPhotoUploadServerContext is the same as PhotosGetOwnerCoverPhotoUploadServer, but takes a context.
+func (vk *VK) PhotosGetOwnerCoverPhotoUploadServerContext(ctx context.Context, params Params) (response PhotosGetOwnerCoverPhotoUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getOwnerCoverPhotoUploadServer", params, &response)
 	return
 }
 
@@ -358,7 +495,12 @@ type PhotosGetOwnerPhotoUploadServerResponse struct {
 //
 // https://vk.com/dev/photos.getOwnerPhotoUploadServer
 func (vk *VK) PhotosGetOwnerPhotoUploadServer(params Params) (response PhotosGetOwnerPhotoUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getOwnerPhotoUploadServer", params, &response)
+	return vk.PhotosGetOwnerPhotoUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetOwnerPhotoUploadServerContext is the same as PhotosGetOwnerPhotoUploadServer, but takes a context.
+func (vk *VK) PhotosGetOwnerPhotoUploadServerContext(ctx context.Context, params Params) (response PhotosGetOwnerPhotoUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getOwnerPhotoUploadServer", params, &response)
 	return
 }
 
@@ -369,7 +511,12 @@ type PhotosGetTagsResponse []object.PhotosPhotoTag
 //
 // https://vk.com/dev/photos.getTags
 func (vk *VK) PhotosGetTags(params Params) (response PhotosGetTagsResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getTags", params, &response)
+	return vk.PhotosGetTagsContext(context.Background(), params)
+}
+
+// PhotosGetTagsContext is the same as PhotosGetTags, but takes a context.
+func (vk *VK) PhotosGetTagsContext(ctx context.Context, params Params) (response PhotosGetTagsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getTags", params, &response)
 	return
 }
 
@@ -380,7 +527,12 @@ type PhotosGetUploadServerResponse object.PhotosPhotoUpload
 //
 // https://vk.com/dev/photos.getUploadServer
 func (vk *VK) PhotosGetUploadServer(params Params) (response PhotosGetUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getUploadServer", params, &response)
+	return vk.PhotosGetUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetUploadServerContext is the same as PhotosGetUploadServer, but takes a context.
+func (vk *VK) PhotosGetUploadServerContext(ctx context.Context, params Params) (response PhotosGetUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getUploadServer", params, &response)
 	return
 }
 
@@ -396,8 +548,13 @@ type PhotosGetUserPhotosResponse struct {
 //
 // https://vk.com/dev/photos.getUserPhotos
 func (vk *VK) PhotosGetUserPhotos(params Params) (response PhotosGetUserPhotosResponse, err error) {
+	return vk.PhotosGetUserPhotosContext(context.Background(), params)
+}
+
+// PhotosGetUserPhotosContext is the same as PhotosGetUserPhotos, but takes a context.
+func (vk *VK) PhotosGetUserPhotosContext(ctx context.Context, params Params) (response PhotosGetUserPhotosResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("photos.getUserPhotos", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getUserPhotos", params, &response)
 
 	return
 }
@@ -414,8 +571,13 @@ type PhotosGetUserPhotosExtendedResponse struct {
 //
 // https://vk.com/dev/photos.getUserPhotos
 func (vk *VK) PhotosGetUserPhotosExtended(params Params) (response PhotosGetUserPhotosExtendedResponse, err error) {
+	return vk.PhotosGetUserPhotosExtendedContext(context.Background(), params)
+}
+
+// PhotosGetUserPhotosExtendedContext is the same as PhotosGetUserPhotosExtended, but takes a context.
+func (vk *VK) PhotosGetUserPhotosExtendedContext(ctx context.Context, params Params) (response PhotosGetUserPhotosExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("photos.getUserPhotos", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "photos.getUserPhotos", params, &response)
 
 	return
 }
@@ -427,7 +589,12 @@ type PhotosGetWallUploadServerResponse object.PhotosPhotoUpload
 //
 // https://vk.com/dev/photos.getWallUploadServer
 func (vk *VK) PhotosGetWallUploadServer(params Params) (response PhotosGetWallUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("photos.getWallUploadServer", params, &response)
+	return vk.PhotosGetWallUploadServerContext(context.Background(), params)
+}
+
+// PhotosGetWallUploadServerContext is the same as PhotosGetWallUploadServer, but takes a context.
+func (vk *VK) PhotosGetWallUploadServerContext(ctx context.Context, params Params) (response PhotosGetWallUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.getWallUploadServer", params, &response)
 	return
 }
 
@@ -435,7 +602,12 @@ func (vk *VK) PhotosGetWallUploadServer(params Params) (response PhotosGetWallUp
 //
 // https://vk.com/dev/photos.makeCover
 func (vk *VK) PhotosMakeCover(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.makeCover", params, &response)
+	return vk.PhotosMakeCoverContext(context.Background(), params)
+}
+
+// PhotosMakeCoverContext is the same as PhotosMakeCover, but takes a context.
+func (vk *VK) PhotosMakeCoverContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.makeCover", params, &response)
 	return
 }
 
@@ -443,7 +615,12 @@ func (vk *VK) PhotosMakeCover(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.moveMoves
 func (vk *VK) PhotosMove(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.move", params, &response)
+	return vk.PhotosMoveContext(context.Background(), params)
+}
+
+// PhotosMoveContext is the same as PhotosMove, but takes a context.
+func (vk *VK) PhotosMoveContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.move", params, &response)
 	return
 }
 
@@ -451,7 +628,12 @@ func (vk *VK) PhotosMove(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.putTag
 func (vk *VK) PhotosPutTag(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.putTag", params, &response)
+	return vk.PhotosPutTagContext(context.Background(), params)
+}
+
+// PhotosPutTagContext is the same as PhotosPutTag, but takes a context.
+func (vk *VK) PhotosPutTagContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.putTag", params, &response)
 	return
 }
 
@@ -459,7 +641,12 @@ func (vk *VK) PhotosPutTag(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.removeTag
 func (vk *VK) PhotosRemoveTag(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.removeTag", params, &response)
+	return vk.PhotosRemoveTagContext(context.Background(), params)
+}
+
+// PhotosRemoveTagContext is the same as PhotosRemoveTag, but takes a context.
+func (vk *VK) PhotosRemoveTagContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.removeTag", params, &response)
 	return
 }
 
@@ -467,7 +654,12 @@ func (vk *VK) PhotosRemoveTag(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.reorderAlbums
 func (vk *VK) PhotosReorderAlbums(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.reorderAlbums", params, &response)
+	return vk.PhotosReorderAlbumsContext(context.Background(), params)
+}
+
+// PhotosReorderAlbumsContext is the same as PhotosReorderAlbums, but takes a context.
+func (vk *VK) PhotosReorderAlbumsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.reorderAlbums", params, &response)
 	return
 }
 
@@ -475,7 +667,12 @@ func (vk *VK) PhotosReorderAlbums(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.reorderPhotos
 func (vk *VK) PhotosReorderPhotos(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.reorderPhotos", params, &response)
+	return vk.PhotosReorderPhotosContext(context.Background(), params)
+}
+
+// PhotosReorderPhotosContext is the same as PhotosReorderPhotos, but takes a context.
+func (vk *VK) PhotosReorderPhotosContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.reorderPhotos", params, &response)
 	return
 }
 
@@ -483,7 +680,12 @@ func (vk *VK) PhotosReorderPhotos(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.report
 func (vk *VK) PhotosReport(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.report", params, &response)
+	return vk.PhotosReportContext(context.Background(), params)
+}
+
+// PhotosReportContext is the same as PhotosReport, but takes a context.
+func (vk *VK) PhotosReportContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.report", params, &response)
 	return
 }
 
@@ -491,7 +693,12 @@ func (vk *VK) PhotosReport(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.reportComment
 func (vk *VK) PhotosReportComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.reportComment", params, &response)
+	return vk.PhotosReportCommentContext(context.Background(), params)
+}
+
+// PhotosReportCommentContext is the same as PhotosReportComment, but takes a context.
+func (vk *VK) PhotosReportCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.reportComment", params, &response)
 	return
 }
 
@@ -499,7 +706,12 @@ func (vk *VK) PhotosReportComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.restore
 func (vk *VK) PhotosRestore(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.restore", params, &response)
+	return vk.PhotosRestoreContext(context.Background(), params)
+}
+
+// PhotosRestoreContext is the same as PhotosRestore, but takes a context.
+func (vk *VK) PhotosRestoreContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.restore", params, &response)
 	return
 }
 
@@ -507,7 +719,12 @@ func (vk *VK) PhotosRestore(params Params) (response int, err error) {
 //
 // https://vk.com/dev/photos.restoreComment
 func (vk *VK) PhotosRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("photos.restoreComment", params, &response)
+	return vk.PhotosRestoreCommentContext(context.Background(), params)
+}
+
+// PhotosRestoreCommentContext is the same as PhotosRestoreComment, but takes a context.
+func (vk *VK) PhotosRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.restoreComment", params, &response)
 	return
 }
 
@@ -518,7 +735,12 @@ type PhotosSaveResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.save
 func (vk *VK) PhotosSave(params Params) (response PhotosSaveResponse, err error) {
-	err = vk.RequestUnmarshal("photos.save", params, &response)
+	return vk.PhotosSaveContext(context.Background(), params)
+}
+
+// PhotosSaveContext is the same as PhotosSave, but takes a context.
+func (vk *VK) PhotosSaveContext(ctx context.Context, params Params) (response PhotosSaveResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.save", params, &response)
 	return
 }
 
@@ -529,7 +751,12 @@ type PhotosSaveMarketAlbumPhotoResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.saveMarketAlbumPhoto
 func (vk *VK) PhotosSaveMarketAlbumPhoto(params Params) (response PhotosSaveMarketAlbumPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveMarketAlbumPhoto", params, &response)
+	return vk.PhotosSaveMarketAlbumPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveMarketAlbumPhotoContext is the same as PhotosSaveMarketAlbumPhoto, but takes a context.
+func (vk *VK) PhotosSaveMarketAlbumPhotoContext(ctx context.Context, params Params) (response PhotosSaveMarketAlbumPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveMarketAlbumPhoto", params, &response)
 	return
 }
 
@@ -540,7 +767,12 @@ type PhotosSaveMarketPhotoResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.saveMarketPhoto
 func (vk *VK) PhotosSaveMarketPhoto(params Params) (response PhotosSaveMarketPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveMarketPhoto", params, &response)
+	return vk.PhotosSaveMarketPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveMarketPhotoContext is the same as PhotosSaveMarketPhoto, but takes a context.
+func (vk *VK) PhotosSaveMarketPhotoContext(ctx context.Context, params Params) (response PhotosSaveMarketPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveMarketPhoto", params, &response)
 	return
 }
 
@@ -551,7 +783,12 @@ type PhotosSaveMessagesPhotoResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.saveMessagesPhoto
 func (vk *VK) PhotosSaveMessagesPhoto(params Params) (response PhotosSaveMessagesPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveMessagesPhoto", params, &response)
+	return vk.PhotosSaveMessagesPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveMessagesPhotoContext is the same as PhotosSaveMessagesPhoto, but takes a context.
+func (vk *VK) PhotosSaveMessagesPhotoContext(ctx context.Context, params Params) (response PhotosSaveMessagesPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveMessagesPhoto", params, &response)
 	return
 }
 
@@ -564,7 +801,12 @@ type PhotosSaveOwnerCoverPhotoResponse struct {
 //
 // https://vk.com/dev/photos.saveOwnerCoverPhoto
 func (vk *VK) PhotosSaveOwnerCoverPhoto(params Params) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveOwnerCoverPhoto", params, &response)
+	return vk.PhotosSaveOwnerCoverPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveOwnerCoverPhotoContext is the same as PhotosSaveOwnerCoverPhoto, but takes a context.
+func (vk *VK) PhotosSaveOwnerCoverPhotoContext(ctx context.Context, params Params) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveOwnerCoverPhoto", params, &response)
 	return
 }
 
@@ -582,7 +824,12 @@ type PhotosSaveOwnerPhotoResponse struct {
 //
 // https://vk.com/dev/photos.saveOwnerPhoto
 func (vk *VK) PhotosSaveOwnerPhoto(params Params) (response PhotosSaveOwnerPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveOwnerPhoto", params, &response)
+	return vk.PhotosSaveOwnerPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveOwnerPhotoContext is the same as PhotosSaveOwnerPhoto, but takes a context.
+func (vk *VK) PhotosSaveOwnerPhotoContext(ctx context.Context, params Params) (response PhotosSaveOwnerPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveOwnerPhoto", params, &response)
 	return
 }
 
@@ -593,7 +840,12 @@ type PhotosSaveWallPhotoResponse []object.PhotosPhoto
 //
 // https://vk.com/dev/photos.saveWallPhoto
 func (vk *VK) PhotosSaveWallPhoto(params Params) (response PhotosSaveWallPhotoResponse, err error) {
-	err = vk.RequestUnmarshal("photos.saveWallPhoto", params, &response)
+	return vk.PhotosSaveWallPhotoContext(context.Background(), params)
+}
+
+// PhotosSaveWallPhotoContext is the same as PhotosSaveWallPhoto, but takes a context.
+func (vk *VK) PhotosSaveWallPhotoContext(ctx context.Context, params Params) (response PhotosSaveWallPhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.saveWallPhoto", params, &response)
 	return
 }
 
@@ -607,6 +859,11 @@ type PhotosSearchResponse struct {
 //
 // https://vk.com/dev/photos.search
 func (vk *VK) PhotosSearch(params Params) (response PhotosSearchResponse, err error) {
-	err = vk.RequestUnmarshal("photos.search", params, &response)
+	return vk.PhotosSearchContext(context.Background(), params)
+}
+
+// PhotosSearchContext is the same as PhotosSearch, but takes a context.
+func (vk *VK) PhotosSearchContext(ctx context.Context, params Params) (response PhotosSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "photos.search", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -15,8 +17,13 @@ type PodcastsGetCatalogResponse struct {
 //
 // https://vk.com/dev/podcasts.getCatalog
 func (vk *VK) PodcastsGetCatalog(params Params) (response PodcastsGetCatalogResponse, err error) {
+	return vk.PodcastsGetCatalogContext(context.Background(), params)
+}
+
+// PodcastsGetCatalogContext is the same as PodcastsGetCatalog, but takes a context.
+func (vk *VK) PodcastsGetCatalogContext(ctx context.Context, params Params) (response PodcastsGetCatalogResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("podcasts.getCatalog", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getCatalog", params, &response)
 
 	return
 }
@@ -33,8 +40,13 @@ type PodcastsGetCatalogExtendedResponse struct {
 //
 // https://vk.com/dev/podcasts.getCatalog
 func (vk *VK) PodcastsGetCatalogExtended(params Params) (response PodcastsGetCatalogExtendedResponse, err error) {
+	return vk.PodcastsGetCatalogExtendedContext(context.Background(), params)
+}
+
+// PodcastsGetCatalogExtendedContext is the same as PodcastsGetCatalogExtended, but takes a context.
+func (vk *VK) PodcastsGetCatalogExtendedContext(ctx context.Context, params Params) (response PodcastsGetCatalogExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("podcasts.getCatalog", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getCatalog", params, &response)
 
 	return
 }
@@ -46,7 +58,12 @@ type PodcastsGetCategoriesResponse []object.PodcastsCategory
 //
 // https://vk.com/dev/podcasts.getCategories
 func (vk *VK) PodcastsGetCategories(params Params) (response PodcastsGetCategoriesResponse, err error) {
-	err = vk.RequestUnmarshal("podcasts.getCategories", params, &response)
+	return vk.PodcastsGetCategoriesContext(context.Background(), params)
+}
+
+// PodcastsGetCategoriesContext is the same as PodcastsGetCategories, but takes a context.
+func (vk *VK) PodcastsGetCategoriesContext(ctx context.Context, params Params) (response PodcastsGetCategoriesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getCategories", params, &response)
 	return
 }
 
@@ -60,7 +77,12 @@ type PodcastsGetEpisodesResponse struct {
 //
 // https://vk.com/dev/podcasts.getEpisodes
 func (vk *VK) PodcastsGetEpisodes(params Params) (response PodcastsGetEpisodesResponse, err error) {
-	err = vk.RequestUnmarshal("podcasts.getEpisodes", params, &response)
+	return vk.PodcastsGetEpisodesContext(context.Background(), params)
+}
+
+// PodcastsGetEpisodesContext is the same as PodcastsGetEpisodes, but takes a context.
+func (vk *VK) PodcastsGetEpisodesContext(ctx context.Context, params Params) (response PodcastsGetEpisodesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getEpisodes", params, &response)
 	return
 }
 
@@ -76,8 +98,13 @@ type PodcastsGetFeedResponse struct {
 //
 // https://vk.com/dev/podcasts.getFeed
 func (vk *VK) PodcastsGetFeed(params Params) (response PodcastsGetFeedResponse, err error) {
+	return vk.PodcastsGetFeedContext(context.Background(), params)
+}
+
+// PodcastsGetFeedContext is the same as PodcastsGetFeed, but takes a context.
+func (vk *VK) PodcastsGetFeedContext(ctx context.Context, params Params) (response PodcastsGetFeedResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("podcasts.getFeed", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getFeed", params, &response)
 
 	return
 }
@@ -95,8 +122,13 @@ type PodcastsGetFeedExtendedResponse struct {
 //
 // https://vk.com/dev/podcasts.getFeed
 func (vk *VK) PodcastsGetFeedExtended(params Params) (response PodcastsGetFeedExtendedResponse, err error) {
+	return vk.PodcastsGetFeedExtendedContext(context.Background(), params)
+}
+
+// PodcastsGetFeedExtendedContext is the same as PodcastsGetFeedExtended, but takes a context.
+func (vk *VK) PodcastsGetFeedExtendedContext(ctx context.Context, params Params) (response PodcastsGetFeedExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("podcasts.getFeed", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getFeed", params, &response)
 
 	return
 }
@@ -124,8 +156,13 @@ type PodcastsGetStartPageResponse struct {
 //
 // https://vk.com/dev/podcasts.getStartPage
 func (vk *VK) PodcastsGetStartPage(params Params) (response PodcastsGetStartPageResponse, err error) {
+	return vk.PodcastsGetStartPageContext(context.Background(), params)
+}
+
+// PodcastsGetStartPageContext is the same as PodcastsGetStartPage, but takes a context.
+func (vk *VK) PodcastsGetStartPageContext(ctx context.Context, params Params) (response PodcastsGetStartPageResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("podcasts.getStartPage", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getStartPage", params, &response)
 
 	return
 }
@@ -154,8 +191,13 @@ type PodcastsGetStartPageExtendedResponse struct {
 //
 // https://vk.com/dev/podcasts.getStartPage
 func (vk *VK) PodcastsGetStartPageExtended(params Params) (response PodcastsGetStartPageExtendedResponse, err error) {
+	return vk.PodcastsGetStartPageExtendedContext(context.Background(), params)
+}
+
+// PodcastsGetStartPageExtendedContext is the same as PodcastsGetStartPageExtended, but takes a context.
+func (vk *VK) PodcastsGetStartPageExtendedContext(ctx context.Context, params Params) (response PodcastsGetStartPageExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("podcasts.getStartPage", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.getStartPage", params, &response)
 
 	return
 }
@@ -164,7 +206,12 @@ func (vk *VK) PodcastsGetStartPageExtended(params Params) (response PodcastsGetS
 //
 // https://vk.com/dev/podcasts.markAsListened
 func (vk *VK) PodcastsMarkAsListened(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("podcasts.markAsListened", params, &response)
+	return vk.PodcastsMarkAsListenedContext(context.Background(), params)
+}
+
+// PodcastsMarkAsListenedContext is the same as PodcastsMarkAsListened, but takes a context.
+func (vk *VK) PodcastsMarkAsListenedContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.markAsListened", params, &response)
 	return
 }
 
@@ -172,7 +219,12 @@ func (vk *VK) PodcastsMarkAsListened(params Params) (response int, err error) {
 //
 // https://vk.com/dev/podcasts.subscribe
 func (vk *VK) PodcastsSubscribe(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("podcasts.subscribe", params, &response)
+	return vk.PodcastsSubscribeContext(context.Background(), params)
+}
+
+// PodcastsSubscribeContext is the same as PodcastsSubscribe, but takes a context.
+func (vk *VK) PodcastsSubscribeContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.subscribe", params, &response)
 	return
 }
 
@@ -180,6 +232,11 @@ func (vk *VK) PodcastsSubscribe(params Params) (response int, err error) {
 //
 // https://vk.com/dev/podcasts.unsubscribe
 func (vk *VK) PodcastsUnsubscribe(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("podcasts.unsubscribe", params, &response)
+	return vk.PodcastsUnsubscribeContext(context.Background(), params)
+}
+
+// PodcastsUnsubscribeContext is the same as PodcastsUnsubscribe, but takes a context.
+func (vk *VK) PodcastsUnsubscribeContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "podcasts.unsubscribe", params, &response)
 	return
 }
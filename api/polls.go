@@ -1,12 +1,21 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
-import "github.com/SevereCloud/vksdk/object"
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
 
 // PollsAddVote adds the current user's vote to the selected answer in the poll.
 //
 // https://vk.com/dev/polls.addVote
 func (vk *VK) PollsAddVote(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("polls.addVote", params, &response)
+	return vk.PollsAddVoteContext(context.Background(), params)
+}
+
+// PollsAddVoteContext is the same as PollsAddVote, but takes a context.
+func (vk *VK) PollsAddVoteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.addVote", params, &response)
 	return
 }
 
@@ -17,7 +26,12 @@ type PollsCreateResponse object.PollsPoll
 //
 // https://vk.com/dev/polls.create
 func (vk *VK) PollsCreate(params Params) (response PollsCreateResponse, err error) {
-	err = vk.RequestUnmarshal("polls.create", params, &response)
+	return vk.PollsCreateContext(context.Background(), params)
+}
+
+// PollsCreateContext is the same as PollsCreate, but takes a context.
+func (vk *VK) PollsCreateContext(ctx context.Context, params Params) (response PollsCreateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.create", params, &response)
 	return
 }
 
@@ -25,7 +39,12 @@ func (vk *VK) PollsCreate(params Params) (response PollsCreateResponse, err erro
 //
 // https://vk.com/dev/polls.deleteVote
 func (vk *VK) PollsDeleteVote(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("polls.deleteVote", params, &response)
+	return vk.PollsDeleteVoteContext(context.Background(), params)
+}
+
+// PollsDeleteVoteContext is the same as PollsDeleteVote, but takes a context.
+func (vk *VK) PollsDeleteVoteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.deleteVote", params, &response)
 	return
 }
 
@@ -33,7 +52,12 @@ func (vk *VK) PollsDeleteVote(params Params) (response int, err error) {
 //
 // https://vk.com/dev/polls.edit
 func (vk *VK) PollsEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("polls.edit", params, &response)
+	return vk.PollsEditContext(context.Background(), params)
+}
+
+// PollsEditContext is the same as PollsEdit, but takes a context.
+func (vk *VK) PollsEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.edit", params, &response)
 	return
 }
 
@@ -44,7 +68,12 @@ type PollsGetBackgroundsResponse []object.PollsBackground
 //
 // https://vk.com/dev/polls.getBackgrounds
 func (vk *VK) PollsGetBackgrounds(params Params) (response PollsGetBackgroundsResponse, err error) {
-	err = vk.RequestUnmarshal("polls.getBackgrounds", params, &response)
+	return vk.PollsGetBackgroundsContext(context.Background(), params)
+}
+
+// PollsGetBackgroundsContext is the same as PollsGetBackgrounds, but takes a context.
+func (vk *VK) PollsGetBackgroundsContext(ctx context.Context, params Params) (response PollsGetBackgroundsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.getBackgrounds", params, &response)
 	return
 }
 
@@ -55,7 +84,12 @@ type PollsGetByIDResponse object.PollsPoll
 //
 // https://vk.com/dev/polls.getById
 func (vk *VK) PollsGetByID(params Params) (response PollsGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("polls.getById", params, &response)
+	return vk.PollsGetByIDContext(context.Background(), params)
+}
+
+// PollsGetByIDContext is the same as PollsGetByID, but takes a context.
+func (vk *VK) PollsGetByIDContext(ctx context.Context, params Params) (response PollsGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.getById", params, &response)
 	return
 }
 
@@ -68,7 +102,12 @@ type PollsGetPhotoUploadServerResponse struct {
 //
 // https://vk.com/dev/polls.getPhotoUploadServer
 func (vk *VK) PollsGetPhotoUploadServer(params Params) (response PollsGetPhotoUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("polls.getPhotoUploadServer", params, &response)
+	return vk.PollsGetPhotoUploadServerContext(context.Background(), params)
+}
+
+// PollsGetPhotoUploadServerContext is the same as PollsGetPhotoUploadServer, but takes a context.
+func (vk *VK) PollsGetPhotoUploadServerContext(ctx context.Context, params Params) (response PollsGetPhotoUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.getPhotoUploadServer", params, &response)
 	return
 }
 
@@ -79,7 +118,12 @@ type PollsGetVotersResponse []object.PollsVoters
 //
 // https://vk.com/dev/polls.getVoters
 func (vk *VK) PollsGetVoters(params Params) (response PollsGetVotersResponse, err error) {
-	err = vk.RequestUnmarshal("polls.getVoters", params, &response)
+	return vk.PollsGetVotersContext(context.Background(), params)
+}
+
+// PollsGetVotersContext is the same as PollsGetVoters, but takes a context.
+func (vk *VK) PollsGetVotersContext(ctx context.Context, params Params) (response PollsGetVotersResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.getVoters", params, &response)
 	return
 }
 
@@ -90,7 +134,12 @@ type PollsGetVotersFieldsResponse []object.PollsVotersFields
 //
 // https://vk.com/dev/polls.getVoters
 func (vk *VK) PollsGetVotersFields(params Params) (response PollsGetVotersFieldsResponse, err error) {
-	err = vk.RequestUnmarshal("polls.getVoters", params, &response)
+	return vk.PollsGetVotersFieldsContext(context.Background(), params)
+}
+
+// PollsGetVotersFieldsContext is the same as PollsGetVotersFields, but takes a context.
+func (vk *VK) PollsGetVotersFieldsContext(ctx context.Context, params Params) (response PollsGetVotersFieldsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.getVoters", params, &response)
 	return
 }
 
@@ -101,6 +150,11 @@ type PollsSavePhotoResponse object.PollsPhoto
 //
 // https://vk.com/dev/polls.savePhoto
 func (vk *VK) PollsSavePhoto(params Params) (response PollsSavePhotoResponse, err error) {
-	err = vk.RequestUnmarshal("polls.savePhoto", params, &response)
+	return vk.PollsSavePhotoContext(context.Background(), params)
+}
+
+// PollsSavePhotoContext is the same as PollsSavePhoto, but takes a context.
+func (vk *VK) PollsSavePhotoContext(ctx context.Context, params Params) (response PollsSavePhotoResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "polls.savePhoto", params, &response)
 	return
 }
@@ -1,6 +1,10 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
-import "github.com/SevereCloud/vksdk/object"
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
 
 // PrettyCardsCreateResponse struct.
 type PrettyCardsCreateResponse struct {
@@ -12,7 +16,12 @@ type PrettyCardsCreateResponse struct {
 //
 // https://vk.com/dev/prettyCards.create
 func (vk *VK) PrettyCardsCreate(params Params) (response PrettyCardsCreateResponse, err error) {
-	err = vk.RequestUnmarshal("prettyCards.create", params, &response)
+	return vk.PrettyCardsCreateContext(context.Background(), params)
+}
+
+// PrettyCardsCreateContext is the same as PrettyCardsCreate, but takes a context.
+func (vk *VK) PrettyCardsCreateContext(ctx context.Context, params Params) (response PrettyCardsCreateResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.create", params, &response)
 	return
 }
 
@@ -27,7 +36,12 @@ type PrettyCardsDeleteResponse struct {
 //
 // https://vk.com/dev/prettyCards.delete
 func (vk *VK) PrettyCardsDelete(params Params) (response PrettyCardsDeleteResponse, err error) {
-	err = vk.RequestUnmarshal("prettyCards.delete", params, &response)
+	return vk.PrettyCardsDeleteContext(context.Background(), params)
+}
+
+// PrettyCardsDeleteContext is the same as PrettyCardsDelete, but takes a context.
+func (vk *VK) PrettyCardsDeleteContext(ctx context.Context, params Params) (response PrettyCardsDeleteResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.delete", params, &response)
 	return
 }
 
@@ -41,7 +55,12 @@ type PrettyCardsEditResponse struct {
 //
 // https://vk.com/dev/prettyCards.edit
 func (vk *VK) PrettyCardsEdit(params Params) (response PrettyCardsEditResponse, err error) {
-	err = vk.RequestUnmarshal("prettyCards.edit", params, &response)
+	return vk.PrettyCardsEditContext(context.Background(), params)
+}
+
+// PrettyCardsEditContext is the same as PrettyCardsEdit, but takes a context.
+func (vk *VK) PrettyCardsEditContext(ctx context.Context, params Params) (response PrettyCardsEditResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.edit", params, &response)
 	return
 }
 
@@ -55,7 +74,12 @@ type PrettyCardsGetResponse struct {
 //
 // https://vk.com/dev/prettyCards.get
 func (vk *VK) PrettyCardsGet(params Params) (response PrettyCardsGetResponse, err error) {
-	err = vk.RequestUnmarshal("prettyCards.get", params, &response)
+	return vk.PrettyCardsGetContext(context.Background(), params)
+}
+
+// PrettyCardsGetContext is the same as PrettyCardsGet, but takes a context.
+func (vk *VK) PrettyCardsGetContext(ctx context.Context, params Params) (response PrettyCardsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.get", params, &response)
 	return
 }
 
@@ -66,7 +90,12 @@ type PrettyCardsGetByIDResponse []object.PrettyCardsPrettyCard
 //
 // https://vk.com/dev/prettyCards.getById
 func (vk *VK) PrettyCardsGetByID(params Params) (response PrettyCardsGetByIDResponse, err error) {
-	err = vk.RequestUnmarshal("prettyCards.getById", params, &response)
+	return vk.PrettyCardsGetByIDContext(context.Background(), params)
+}
+
+// PrettyCardsGetByIDContext is the same as PrettyCardsGetByID, but takes a context.
+func (vk *VK) PrettyCardsGetByIDContext(ctx context.Context, params Params) (response PrettyCardsGetByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.getById", params, &response)
 	return
 }
 
@@ -74,6 +103,11 @@ func (vk *VK) PrettyCardsGetByID(params Params) (response PrettyCardsGetByIDResp
 //
 // https://vk.com/dev/prettyCards.getUploadURL
 func (vk *VK) PrettyCardsGetUploadURL(params Params) (response string, err error) {
-	err = vk.RequestUnmarshal("prettyCards.getUploadURL", params, &response)
+	return vk.PrettyCardsGetUploadURLContext(context.Background(), params)
+}
+
+// PrettyCardsGetUploadURLContext is the same as PrettyCardsGetUploadURL, but takes a context.
+func (vk *VK) PrettyCardsGetUploadURLContext(ctx context.Context, params Params) (response string, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "prettyCards.getUploadURL", params, &response)
 	return
 }
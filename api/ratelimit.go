@@ -0,0 +1,187 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default limits for the secure and ads sections.
+//
+// The secure section limit depends on the app's users amount, LimitSecure
+// is the limit for apps with less than 10 000 users.
+const (
+	LimitSecure = 5
+	LimitAds    = 2
+)
+
+// RateLimiter limits the rate of requests to VK API.
+type RateLimiter interface {
+	// Wait blocks until the request of the method with the access token
+	// is allowed or the context is done.
+	Wait(ctx context.Context, token, method string) error
+}
+
+// MethodFamily is a group of methods which share a rate limit.
+type MethodFamily int
+
+// Method families.
+const (
+	FamilyRegular MethodFamily = iota
+	FamilySecure
+	FamilyAds
+)
+
+// GetMethodFamily returns the family of the method.
+func GetMethodFamily(method string) MethodFamily {
+	switch {
+	case strings.HasPrefix(method, "secure."):
+		return FamilySecure
+	case strings.HasPrefix(method, "ads."):
+		return FamilyAds
+	}
+
+	return FamilyRegular
+}
+
+// Rate of a token bucket.
+//
+// PerSecond is the number of requests per second, zero or less means
+// no limit. Burst is the bucket size, if zero or less the bucket holds
+// one second of requests.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+func (r Rate) burst() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+
+	return math.Max(1, math.Ceil(r.PerSecond))
+}
+
+// sweepInterval is the interval between removals of idle buckets.
+const sweepInterval = time.Minute
+
+type bucketKey struct {
+	token  string
+	family MethodFamily
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// TokenBucketLimiter is a RateLimiter with a token bucket for each
+// access token and method family.
+//
+// Tokens in NewVKWithPool have separate buckets, so the pool of N
+// community tokens can make N×20 requests per second. Full buckets are
+// removed, so rotated tokens do not accumulate.
+type TokenBucketLimiter struct {
+	rates     map[MethodFamily]Rate
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+	mux       sync.Mutex
+}
+
+// NewTokenBucketLimiter returns a new TokenBucketLimiter with limit
+// requests per second for regular methods and LimitSecure and LimitAds
+// for the secure and ads sections.
+func NewTokenBucketLimiter(limit int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rates: map[MethodFamily]Rate{
+			FamilyRegular: {PerSecond: float64(limit)},
+			FamilySecure:  {PerSecond: LimitSecure},
+			FamilyAds:     {PerSecond: LimitAds},
+		},
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+// SetRate sets the rate for the method family.
+func (l *TokenBucketLimiter) SetRate(family MethodFamily, rate Rate) {
+	l.mux.Lock()
+	l.rates[family] = rate
+	l.mux.Unlock()
+}
+
+// Rate returns the rate for the method family.
+func (l *TokenBucketLimiter) Rate(family MethodFamily) Rate {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return l.rates[family]
+}
+
+// Wait blocks until the request of the method with the access token
+// is allowed or the context is done.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, token, method string) error {
+	key := bucketKey{token, GetMethodFamily(method)}
+
+	l.mux.Lock()
+
+	rate := l.rates[key.family]
+	if rate.PerSecond <= 0 {
+		l.mux.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rate.burst(), last: now}
+		l.buckets[key] = b
+	}
+
+	// Refill the bucket and take a token. A negative number of tokens
+	// means that the request has reserved a token from the future.
+	b.tokens = math.Min(rate.burst(), b.tokens+now.Sub(b.last).Seconds()*rate.PerSecond)
+	b.last = now
+	b.tokens--
+
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / rate.PerSecond * float64(time.Second))
+	}
+
+	l.mux.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	err := sleepContext(ctx, delay)
+	if err != nil {
+		// Return the reserved token
+		l.mux.Lock()
+		b.tokens++
+		l.mux.Unlock()
+	}
+
+	return err
+}
+
+// sweep removes buckets which are refilled up to the burst. Such buckets
+// are the same as new ones.
+func (l *TokenBucketLimiter) sweep(now time.Time) {
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		rate := l.rates[key.family]
+
+		if rate.PerSecond <= 0 || b.tokens+now.Sub(b.last).Seconds()*rate.PerSecond >= rate.burst() {
+			delete(l.buckets, key)
+		}
+	}
+}
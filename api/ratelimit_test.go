@@ -0,0 +1,161 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMethodFamily(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, api.FamilyRegular, api.GetMethodFamily("users.get"))
+	assert.Equal(t, api.FamilySecure, api.GetMethodFamily("secure.sendNotification"))
+	assert.Equal(t, api.FamilyAds, api.GetMethodFamily("ads.getAccounts"))
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := api.NewTokenBucketLimiter(10)
+	ctx := context.Background()
+
+	// burst
+	start := time.Now()
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, l.Wait(ctx, "a", "users.get"))
+	}
+
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+
+	// separate buckets for tokens and families
+	start = time.Now()
+
+	assert.NoError(t, l.Wait(ctx, "b", "users.get"))
+	assert.NoError(t, l.Wait(ctx, "a", "secure.getAppBalance"))
+	assert.NoError(t, l.Wait(ctx, "a", "ads.getAccounts"))
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+
+	// empty bucket
+	start = time.Now()
+
+	assert.NoError(t, l.Wait(ctx, "a", "users.get"))
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestTokenBucketLimiter_context(t *testing.T) {
+	t.Parallel()
+
+	l := api.NewTokenBucketLimiter(1)
+	assert.NoError(t, l.Wait(context.Background(), "a", "users.get"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx, "a", "users.get"))
+}
+
+func TestTokenBucketLimiter_SetRate(t *testing.T) {
+	t.Parallel()
+
+	l := api.NewTokenBucketLimiter(1)
+	l.SetRate(api.FamilyRegular, api.Rate{})
+	assert.Equal(t, api.Rate{}, l.Rate(api.FamilyRegular))
+
+	// no limit
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, l.Wait(context.Background(), "a", "users.get"))
+	}
+}
+
+func TestTokenBucketLimiter_sweep(t *testing.T) {
+	t.Parallel()
+
+	l := api.NewTokenBucketLimiter(1)
+	ctx := context.Background()
+
+	assert.NoError(t, l.Wait(ctx, "a", "users.get"))
+	assert.NoError(t, l.Wait(ctx, "b", "users.get"))
+	assert.Equal(t, 2, l.Buckets())
+
+	// buckets are not full yet
+	l.Sweep(time.Now())
+	assert.Equal(t, 2, l.Buckets())
+
+	l.Sweep(time.Now().Add(2 * time.Second))
+	assert.Equal(t, 0, l.Buckets())
+}
+
+type testRateLimiter struct {
+	tokens []string
+	mux    sync.Mutex
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context, token, method string) error {
+	l.mux.Lock()
+	l.tokens = append(l.tokens, token+" "+method)
+	l.mux.Unlock()
+
+	return nil
+}
+
+func TestVK_RateLimiter(t *testing.T) {
+	t.Parallel()
+
+	var l testRateLimiter
+
+	vk, ts := newTestVK(jsonHandler(`{"response":1}`))
+	defer ts.Close()
+
+	vk.RateLimiter = &l
+
+	_, err := vk.Request("users.get", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"secret-token users.get"}, l.tokens)
+}
+
+func TestNewVKWithPool_limit(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(jsonHandler(`{"response":1}`))
+	defer ts.Close()
+
+	vk := api.NewVKWithPool("1", "2", "3")
+	vk.MethodURL = ts.URL + "/"
+	vk.Limit = 2
+
+	// 3 tokens * 2 rps
+	start := time.Now()
+
+	for i := 0; i < 6; i++ {
+		_, err := vk.Request("users.get", api.Params{})
+		assert.NoError(t, err)
+	}
+
+	assert.True(t, time.Since(start) < 200*time.Millisecond)
+}
+
+func TestVK_Limit_disabled(t *testing.T) {
+	t.Parallel()
+
+	vk, ts := newTestVK(jsonHandler(`{"response":1}`))
+	defer ts.Close()
+
+	vk.Limit = 0
+
+	// secure methods are limited even without Limit
+	start := time.Now()
+
+	for i := 0; i < api.LimitSecure+1; i++ {
+		_, err := vk.Request("secure.getAppBalance", api.Params{})
+		assert.NoError(t, err)
+	}
+
+	assert.True(t, time.Since(start) >= 150*time.Millisecond)
+}
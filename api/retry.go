@@ -0,0 +1,194 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/SevereCloud/vksdk/api/errors"
+)
+
+// StatusError is returned when VK responds with an unexpected HTTP status
+// and a non-JSON body, e.g. 502 Bad Gateway.
+type StatusError struct {
+	StatusCode int
+	Status     string
+}
+
+// Error returns the message of StatusError.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("api: unexpected status %s", e.Status)
+}
+
+// RetryRule determines whether a failed request can be repeated.
+type RetryRule int
+
+// Retry rules.
+const (
+	// NoRetry never repeats the request.
+	NoRetry RetryRule = iota
+
+	// RetryIdempotent repeats only idempotent requests. Use it for errors
+	// after which the request may have already been executed by VK.
+	RetryIdempotent
+
+	// RetryAlways repeats any request. Use it for errors after which
+	// the request is known to be rejected by VK, e.g. errors.TooMany.
+	RetryAlways
+)
+
+// RetryPolicy describes how VK repeats failed requests.
+//
+// Retries are disabled by default, set VK.RetryPolicy to enable them.
+//
+// The delay before the n-th retry is BaseDelay * 2^(n-1), but not more
+// than MaxDelay. Jitter is a fraction of the delay which is randomly
+// subtracted from it, so clients do not retry at the same moment.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      float64
+
+	// ErrorTypes are rules for errors returned by VK.
+	ErrorTypes map[errors.ErrorType]RetryRule
+
+	// Transport is the rule for network errors and StatusError.
+	Transport RetryRule
+
+	// Idempotent reports whether the request can be safely repeated.
+	// If nil, IsIdempotent is used.
+	Idempotent func(method string, params Params) bool
+}
+
+// NewRetryPolicy returns the default RetryPolicy.
+//
+// It makes up to 5 attempts with delays starting from 100 ms. Too many
+// requests errors are always retried, internal server errors, unknown
+// errors and network errors are retried only for idempotent requests.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Jitter:      0.5,
+		ErrorTypes: map[errors.ErrorType]RetryRule{
+			errors.TooMany: RetryAlways,
+			errors.Server:  RetryIdempotent,
+			errors.Unknown: RetryIdempotent,
+		},
+		Transport: RetryIdempotent,
+	}
+}
+
+// IsIdempotent reports whether the request can be safely repeated.
+//
+// Only methods which read data are idempotent: *.get*, *.search* and
+// *.is*, e.g. users.get, groups.isMember. messages.send and
+// messages.sendSticker are idempotent with a non-zero random_id, which
+// VK uses to drop duplicates. Other methods, e.g. wall.post, may create
+// duplicates if they are repeated. execute can contain any methods, so it
+// is never idempotent.
+func IsIdempotent(method string, params Params) bool {
+	switch method {
+	case "messages.send", "messages.sendSticker":
+		randomID := FmtValue(params["random_id"], 0)
+		return randomID != "" && randomID != "0"
+	case "execute":
+		return false
+	}
+
+	name := method[strings.IndexByte(method, '.')+1:]
+
+	for _, prefix := range []string{"get", "search", "is"} {
+		if name == prefix {
+			return true
+		}
+
+		if strings.HasPrefix(name, prefix) && unicode.IsUpper(rune(name[len(prefix)])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rule returns the retry rule for the error.
+func (p *RetryPolicy) rule(err error) RetryRule {
+	switch err.(type) {
+	case *url.Error, *StatusError:
+		return p.Transport
+	}
+
+	if errors.GetType(err) == errors.NoType {
+		return NoRetry
+	}
+
+	return p.ErrorTypes[errors.GetType(err)]
+}
+
+// ShouldRetry reports whether the request should be repeated after
+// the attempt failed with the error.
+func (p *RetryPolicy) ShouldRetry(attempt int, method string, params Params, err error) bool {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	switch p.rule(err) {
+	case RetryAlways:
+		return true
+	case RetryIdempotent:
+		if p.Idempotent != nil {
+			return p.Idempotent(method, params)
+		}
+
+		return IsIdempotent(method, params)
+	}
+
+	return false
+}
+
+// Backoff returns the delay before the next attempt.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+
+	for i := 1; i < attempt; i++ {
+		// Zero MaxDelay means no cap, but the delay must not overflow.
+		if p.MaxDelay > 0 && delay >= p.MaxDelay || delay > math.MaxInt64/2 {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(p.Jitter * rand.Float64() * float64(delay)) // nolint: gosec
+	}
+
+	return delay
+}
+
+// sleepContext pauses the current goroutine for at least the duration d
+// or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
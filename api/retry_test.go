@@ -0,0 +1,195 @@
+package api_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func fastRetryPolicy() *api.RetryPolicy {
+	p := api.NewRetryPolicy()
+	p.BaseDelay = time.Millisecond
+	p.MaxDelay = time.Millisecond
+
+	return p
+}
+
+// failingHandler responds with fail until n requests are made.
+func failingHandler(n int32, fail http.HandlerFunc, calls *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= n {
+			fail(w, r)
+			return
+		}
+
+		jsonHandler(`{"response":1}`)(w, r)
+	}
+}
+
+func TestRetryPolicy_status(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	badGateway := func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	}
+
+	vk, ts := newTestVK(failingHandler(2, badGateway, &calls))
+	defer ts.Close()
+
+	vk.RetryPolicy = fastRetryPolicy()
+
+	_, err := vk.Request("users.get", api.Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryPolicy_maxAttempts(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	vk, ts := newTestVK(failingHandler(10, jsonHandler(`{"error":{"error_code":10}}`), &calls))
+	defer ts.Close()
+
+	vk.RetryPolicy = fastRetryPolicy()
+	vk.RetryPolicy.MaxAttempts = 3
+
+	_, err := vk.Request("users.get", api.Params{})
+	assert.Equal(t, errors.Server, errors.GetType(err))
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryPolicy_idempotent(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	vk, ts := newTestVK(failingHandler(1, jsonHandler(`{"error":{"error_code":10}}`), &calls))
+	defer ts.Close()
+
+	vk.RetryPolicy = fastRetryPolicy()
+
+	// messages.send without random_id is never replayed
+	_, err := vk.MessagesSend(api.Params{"peer_id": 1})
+	assert.Equal(t, errors.Server, errors.GetType(err))
+	assert.Equal(t, int32(1), calls)
+
+	_, err = vk.MessagesSend(api.Params{"peer_id": 1, "random_id": 123})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestRetryPolicy_tooMany(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	vk, ts := newTestVK(failingHandler(2, jsonHandler(`{"error":{"error_code":6}}`), &calls))
+	defer ts.Close()
+
+	vk.RetryPolicy = fastRetryPolicy()
+
+	// the request is rejected by VK, so it is safe to send it again
+	_, err := vk.MessagesSend(api.Params{"peer_id": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryPolicy_disabled(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	vk, ts := newTestVK(failingHandler(1, jsonHandler(`{"error":{"error_code":10}}`), &calls))
+	defer ts.Close()
+
+	assert.Nil(t, vk.RetryPolicy)
+
+	_, err := vk.Request("users.get", api.Params{})
+	assert.Equal(t, errors.Server, errors.GetType(err))
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetryPolicy_disabledTooMany(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	vk, ts := newTestVK(failingHandler(100, jsonHandler(`{"error":{"error_code":6}}`), &calls))
+	defer ts.Close()
+
+	vk.Limit = 3
+
+	// without RetryPolicy too many requests errors are repeated up to Limit
+	_, err := vk.Request("users.get", api.Params{})
+	assert.Equal(t, errors.TooMany, errors.GetType(err))
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	p := &api.RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(4))
+	assert.Equal(t, time.Second, p.Backoff(5))
+	assert.Equal(t, time.Second, p.Backoff(100))
+
+	p.Jitter = 0.5
+
+	for i := 0; i < 100; i++ {
+		d := p.Backoff(1)
+		assert.True(t, d > 50*time.Millisecond && d <= 100*time.Millisecond, d)
+	}
+}
+
+func TestRetryPolicy_Backoff_noMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	p := &api.RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(4))
+	assert.Equal(t, 102400*time.Millisecond, p.Backoff(11))
+	assert.True(t, p.Backoff(100) > 0)
+}
+
+func TestIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	f := func(method string, params api.Params, want bool) {
+		t.Helper()
+		assert.Equal(t, want, api.IsIdempotent(method, params))
+	}
+
+	f("users.get", api.Params{}, true)
+	f("wall.getById", api.Params{}, true)
+	f("users.search", api.Params{}, true)
+	f("groups.isMember", api.Params{}, true)
+	f("wall.post", api.Params{}, false)
+	f("market.add", api.Params{}, false)
+	f("photos.saveWallPhoto", api.Params{}, false)
+	f("board.createComment", api.Params{}, false)
+	f("wall.getter", api.Params{}, false)
+	f("account.setInfo", api.Params{}, false)
+	f("messages.send", api.Params{}, false)
+	f("messages.send", api.Params{"random_id": "0"}, false)
+	f("messages.send", api.Params{"random_id": "1"}, true)
+	f("messages.sendSticker", api.Params{"random_id": 0}, false)
+	f("execute", api.Params{}, false)
+}
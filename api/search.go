@@ -1,6 +1,10 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
-import "github.com/SevereCloud/vksdk/object"
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
 
 // SearchGetHintsResponse struct.
 type SearchGetHintsResponse struct {
@@ -12,6 +16,11 @@ type SearchGetHintsResponse struct {
 //
 // https://vk.com/dev/search.getHints
 func (vk *VK) SearchGetHints(params Params) (response SearchGetHintsResponse, err error) {
-	err = vk.RequestUnmarshal("search.getHints", params, &response)
+	return vk.SearchGetHintsContext(context.Background(), params)
+}
+
+// SearchGetHintsContext is the same as SearchGetHints, but takes a context.
+func (vk *VK) SearchGetHintsContext(ctx context.Context, params Params) (response SearchGetHintsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "search.getHints", params, &response)
 	return
 }
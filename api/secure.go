@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -11,7 +13,12 @@ type SecureAddAppEventResponse int // FIXME: not found documentation. https://gi
 //
 // https://vk.com/dev/secure.addAppEvent
 func (vk *VK) SecureAddAppEvent(params Params) (response SecureAddAppEventResponse, err error) {
-	err = vk.RequestUnmarshal("secure.addAppEvent", params, &response)
+	return vk.SecureAddAppEventContext(context.Background(), params)
+}
+
+// SecureAddAppEventContext is the same as SecureAddAppEvent, but takes a context.
+func (vk *VK) SecureAddAppEventContext(ctx context.Context, params Params) (response SecureAddAppEventResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.addAppEvent", params, &response)
 	return
 }
 
@@ -22,7 +29,12 @@ type SecureCheckTokenResponse object.SecureTokenChecked
 //
 // https://vk.com/dev/secure.checkToken
 func (vk *VK) SecureCheckToken(params Params) (response SecureCheckTokenResponse, err error) {
-	err = vk.RequestUnmarshal("secure.checkToken", params, &response)
+	return vk.SecureCheckTokenContext(context.Background(), params)
+}
+
+// SecureCheckTokenContext is the same as SecureCheckToken, but takes a context.
+func (vk *VK) SecureCheckTokenContext(ctx context.Context, params Params) (response SecureCheckTokenResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.checkToken", params, &response)
 	return
 }
 
@@ -30,7 +42,12 @@ func (vk *VK) SecureCheckToken(params Params) (response SecureCheckTokenResponse
 //
 // https://vk.com/dev/secure.getAppBalance
 func (vk *VK) SecureGetAppBalance(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("secure.getAppBalance", params, &response)
+	return vk.SecureGetAppBalanceContext(context.Background(), params)
+}
+
+// SecureGetAppBalanceContext is the same as SecureGetAppBalance, but takes a context.
+func (vk *VK) SecureGetAppBalanceContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.getAppBalance", params, &response)
 	return
 }
 
@@ -41,7 +58,12 @@ type SecureGetSMSHistoryResponse []object.SecureSmsNotification
 //
 // https://vk.com/dev/secure.getSMSHistory
 func (vk *VK) SecureGetSMSHistory(params Params) (response SecureGetSMSHistoryResponse, err error) {
-	err = vk.RequestUnmarshal("secure.getSMSHistory", params, &response)
+	return vk.SecureGetSMSHistoryContext(context.Background(), params)
+}
+
+// SecureGetSMSHistoryContext is the same as SecureGetSMSHistory, but takes a context.
+func (vk *VK) SecureGetSMSHistoryContext(ctx context.Context, params Params) (response SecureGetSMSHistoryResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.getSMSHistory", params, &response)
 	return
 }
 
@@ -52,7 +74,12 @@ type SecureGetTransactionsHistoryResponse []object.SecureTransaction
 //
 // https://vk.com/dev/secure.getTransactionsHistory
 func (vk *VK) SecureGetTransactionsHistory(params Params) (response SecureGetTransactionsHistoryResponse, err error) {
-	err = vk.RequestUnmarshal("secure.getTransactionsHistory", params, &response)
+	return vk.SecureGetTransactionsHistoryContext(context.Background(), params)
+}
+
+// SecureGetTransactionsHistoryContext is the same as SecureGetTransactionsHistory, but takes a context.
+func (vk *VK) SecureGetTransactionsHistoryContext(ctx context.Context, params Params) (response SecureGetTransactionsHistoryResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.getTransactionsHistory", params, &response)
 	return
 }
 
@@ -63,7 +90,12 @@ type SecureGetUserLevelResponse []object.SecureLevel
 //
 // https://vk.com/dev/secure.getUserLevel
 func (vk *VK) SecureGetUserLevel(params Params) (response SecureGetUserLevelResponse, err error) {
-	err = vk.RequestUnmarshal("secure.getUserLevel", params, &response)
+	return vk.SecureGetUserLevelContext(context.Background(), params)
+}
+
+// SecureGetUserLevelContext is the same as SecureGetUserLevel, but takes a context.
+func (vk *VK) SecureGetUserLevelContext(ctx context.Context, params Params) (response SecureGetUserLevelResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.getUserLevel", params, &response)
 	return
 }
 
@@ -77,7 +109,12 @@ type SecureGiveEventStickerResponse []struct {
 //
 // https://vk.com/dev/secure.giveEventSticker
 func (vk *VK) SecureGiveEventSticker(params Params) (response SecureGiveEventStickerResponse, err error) {
-	err = vk.RequestUnmarshal("secure.giveEventSticker", params, &response)
+	return vk.SecureGiveEventStickerContext(context.Background(), params)
+}
+
+// SecureGiveEventStickerContext is the same as SecureGiveEventSticker, but takes a context.
+func (vk *VK) SecureGiveEventStickerContext(ctx context.Context, params Params) (response SecureGiveEventStickerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.giveEventSticker", params, &response)
 	return
 }
 
@@ -88,7 +125,12 @@ type SecureSendNotificationResponse []int //User ID
 //
 // https://vk.com/dev/secure.sendNotification
 func (vk *VK) SecureSendNotification(params Params) (response SecureSendNotificationResponse, err error) {
-	err = vk.RequestUnmarshal("secure.sendNotification", params, &response)
+	return vk.SecureSendNotificationContext(context.Background(), params)
+}
+
+// SecureSendNotificationContext is the same as SecureSendNotification, but takes a context.
+func (vk *VK) SecureSendNotificationContext(ctx context.Context, params Params) (response SecureSendNotificationResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.sendNotification", params, &response)
 	return
 }
 
@@ -96,7 +138,12 @@ func (vk *VK) SecureSendNotification(params Params) (response SecureSendNotifica
 //
 // https://vk.com/dev/secure.sendSMSNotification
 func (vk *VK) SecureSendSMSNotification(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("secure.sendSMSNotification", params, &response)
+	return vk.SecureSendSMSNotificationContext(context.Background(), params)
+}
+
+// SecureSendSMSNotificationContext is the same as SecureSendSMSNotification, but takes a context.
+func (vk *VK) SecureSendSMSNotificationContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.sendSMSNotification", params, &response)
 	return
 }
 
@@ -104,6 +151,11 @@ func (vk *VK) SecureSendSMSNotification(params Params) (response int, err error)
 //
 // https://vk.com/dev/secure.setCounter
 func (vk *VK) SecureSetCounter(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("secure.setCounter", params, &response)
+	return vk.SecureSetCounterContext(context.Background(), params)
+}
+
+// SecureSetCounterContext is the same as SecureSetCounter, but takes a context.
+func (vk *VK) SecureSetCounterContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "secure.setCounter", params, &response)
 	return
 }
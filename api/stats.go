@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -11,7 +13,12 @@ type StatsGetResponse []object.StatsPeriod
 //
 // https://vk.com/dev/stats.get
 func (vk *VK) StatsGet(params Params) (response StatsGetResponse, err error) {
-	err = vk.RequestUnmarshal("stats.get", params, &response)
+	return vk.StatsGetContext(context.Background(), params)
+}
+
+// StatsGetContext is the same as StatsGet, but takes a context.
+func (vk *VK) StatsGetContext(ctx context.Context, params Params) (response StatsGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stats.get", params, &response)
 	return
 }
 
@@ -22,7 +29,12 @@ type StatsGetPostReachResponse []object.StatsWallpostStat
 //
 // https://vk.com/dev/stats.getPostReach
 func (vk *VK) StatsGetPostReach(params Params) (response StatsGetPostReachResponse, err error) {
-	err = vk.RequestUnmarshal("stats.getPostReach", params, &response)
+	return vk.StatsGetPostReachContext(context.Background(), params)
+}
+
+// StatsGetPostReachContext is the same as StatsGetPostReach, but takes a context.
+func (vk *VK) StatsGetPostReachContext(ctx context.Context, params Params) (response StatsGetPostReachResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stats.getPostReach", params, &response)
 	return
 }
 
@@ -30,6 +42,11 @@ func (vk *VK) StatsGetPostReach(params Params) (response StatsGetPostReachRespon
 //
 // https://vk.com/dev/stats.trackVisitor
 func (vk *VK) StatsTrackVisitor(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stats.trackVisitor", params, &response)
+	return vk.StatsTrackVisitorContext(context.Background(), params)
+}
+
+// StatsTrackVisitorContext is the same as StatsTrackVisitor, but takes a context.
+func (vk *VK) StatsTrackVisitorContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stats.trackVisitor", params, &response)
 	return
 }
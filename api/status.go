@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -12,12 +14,22 @@ type StatusGetResponse struct {
 
 // StatusGet returns data required to show the status of a user or community.
 func (vk *VK) StatusGet(params Params) (response StatusGetResponse, err error) {
-	err = vk.RequestUnmarshal("status.get", params, &response)
+	return vk.StatusGetContext(context.Background(), params)
+}
+
+// StatusGetContext is the same as StatusGet, but takes a context.
+func (vk *VK) StatusGetContext(ctx context.Context, params Params) (response StatusGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "status.get", params, &response)
 	return
 }
 
 // StatusSet sets a new status for the current user.
 func (vk *VK) StatusSet(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("status.set", params, &response)
+	return vk.StatusSetContext(context.Background(), params)
+}
+
+// StatusSetContext is the same as StatusSet, but takes a context.
+func (vk *VK) StatusSetContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "status.set", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -22,12 +24,17 @@ func (s StorageGetResponse) ToMap() map[string]string {
 // StorageGet always return array!
 // https://vk.com/dev/storage.get
 func (vk *VK) StorageGet(params Params) (response StorageGetResponse, err error) {
+	return vk.StorageGetContext(context.Background(), params)
+}
+
+// StorageGetContext is the same as StorageGet, but takes a context.
+func (vk *VK) StorageGetContext(ctx context.Context, params Params) (response StorageGetResponse, err error) {
 	if _, prs := params["keys"]; !prs {
 		params["keys"] = params["key"]
 		params["key"] = ""
 	}
 
-	err = vk.RequestUnmarshal("storage.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "storage.get", params, &response)
 
 	return
 }
@@ -39,7 +46,12 @@ type StorageGetKeysResponse []string
 //
 // https://vk.com/dev/storage.getKeys
 func (vk *VK) StorageGetKeys(params Params) (response StorageGetKeysResponse, err error) {
-	err = vk.RequestUnmarshal("storage.getKeys", params, &response)
+	return vk.StorageGetKeysContext(context.Background(), params)
+}
+
+// StorageGetKeysContext is the same as StorageGetKeys, but takes a context.
+func (vk *VK) StorageGetKeysContext(ctx context.Context, params Params) (response StorageGetKeysResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "storage.getKeys", params, &response)
 	return
 }
 
@@ -47,6 +59,11 @@ func (vk *VK) StorageGetKeys(params Params) (response StorageGetKeysResponse, er
 //
 // https://vk.com/dev/storage.set
 func (vk *VK) StorageSet(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("storage.set", params, &response)
+	return vk.StorageSetContext(context.Background(), params)
+}
+
+// StorageSetContext is the same as StorageSet, but takes a context.
+func (vk *VK) StorageSetContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "storage.set", params, &response)
 	return
 }
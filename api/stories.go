@@ -1,12 +1,21 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
-import "github.com/SevereCloud/vksdk/object"
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
 
 // StoriesBanOwner allows to hide stories from chosen sources from current user's feed.
 //
 // https://vk.com/dev/stories.banOwner
 func (vk *VK) StoriesBanOwner(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stories.banOwner", params, &response)
+	return vk.StoriesBanOwnerContext(context.Background(), params)
+}
+
+// StoriesBanOwnerContext is the same as StoriesBanOwner, but takes a context.
+func (vk *VK) StoriesBanOwnerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.banOwner", params, &response)
 	return
 }
 
@@ -14,7 +23,12 @@ func (vk *VK) StoriesBanOwner(params Params) (response int, err error) {
 //
 // https://vk.com/dev/stories.delete
 func (vk *VK) StoriesDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stories.delete", params, &response)
+	return vk.StoriesDeleteContext(context.Background(), params)
+}
+
+// StoriesDeleteContext is the same as StoriesDelete, but takes a context.
+func (vk *VK) StoriesDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.delete", params, &response)
 	return
 }
 
@@ -31,8 +45,13 @@ type StoriesGetResponse struct {
 //
 // https://vk.com/dev/stories.get
 func (vk *VK) StoriesGet(params Params) (response StoriesGetResponse, err error) {
+	return vk.StoriesGetContext(context.Background(), params)
+}
+
+// StoriesGetContext is the same as StoriesGet, but takes a context.
+func (vk *VK) StoriesGetContext(ctx context.Context, params Params) (response StoriesGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.get", params, &response)
 
 	return
 }
@@ -50,8 +69,13 @@ type StoriesGetExtendedResponse struct {
 //
 // https://vk.com/dev/stories.get
 func (vk *VK) StoriesGetExtended(params Params) (response StoriesGetExtendedResponse, err error) {
+	return vk.StoriesGetExtendedContext(context.Background(), params)
+}
+
+// StoriesGetExtendedContext is the same as StoriesGetExtended, but takes a context.
+func (vk *VK) StoriesGetExtendedContext(ctx context.Context, params Params) (response StoriesGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.get", params, &response)
 
 	return
 }
@@ -68,8 +92,13 @@ type StoriesGetBannedResponse struct {
 //
 // https://vk.com/dev/stories.getBanned
 func (vk *VK) StoriesGetBanned(params Params) (response StoriesGetBannedResponse, err error) {
+	return vk.StoriesGetBannedContext(context.Background(), params)
+}
+
+// StoriesGetBannedContext is the same as StoriesGetBanned, but takes a context.
+func (vk *VK) StoriesGetBannedContext(ctx context.Context, params Params) (response StoriesGetBannedResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.getBanned", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getBanned", params, &response)
 
 	return
 }
@@ -87,8 +116,13 @@ type StoriesGetBannedExtendedResponse struct {
 //
 // https://vk.com/dev/stories.getBanned
 func (vk *VK) StoriesGetBannedExtended(params Params) (response StoriesGetBannedExtendedResponse, err error) {
+	return vk.StoriesGetBannedExtendedContext(context.Background(), params)
+}
+
+// StoriesGetBannedExtendedContext is the same as StoriesGetBannedExtended, but takes a context.
+func (vk *VK) StoriesGetBannedExtendedContext(ctx context.Context, params Params) (response StoriesGetBannedExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.getBanned", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getBanned", params, &response)
 
 	return
 }
@@ -105,8 +139,13 @@ type StoriesGetByIDResponse struct {
 //
 // https://vk.com/dev/stories.getById
 func (vk *VK) StoriesGetByID(params Params) (response StoriesGetByIDResponse, err error) {
+	return vk.StoriesGetByIDContext(context.Background(), params)
+}
+
+// StoriesGetByIDContext is the same as StoriesGetByID, but takes a context.
+func (vk *VK) StoriesGetByIDContext(ctx context.Context, params Params) (response StoriesGetByIDResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getById", params, &response)
 
 	return
 }
@@ -124,8 +163,13 @@ type StoriesGetByIDExtendedResponse struct {
 //
 // https://vk.com/dev/stories.getById
 func (vk *VK) StoriesGetByIDExtended(params Params) (response StoriesGetByIDExtendedResponse, err error) {
+	return vk.StoriesGetByIDExtendedContext(context.Background(), params)
+}
+
+// StoriesGetByIDExtendedContext is the same as StoriesGetByIDExtended, but takes a context.
+func (vk *VK) StoriesGetByIDExtendedContext(ctx context.Context, params Params) (response StoriesGetByIDExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getById", params, &response)
 
 	return
 }
@@ -141,7 +185,12 @@ type StoriesGetPhotoUploadServerResponse struct {
 //
 // https://vk.com/dev/stories.getPhotoUploadServer
 func (vk *VK) StoriesGetPhotoUploadServer(params Params) (response StoriesGetPhotoUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("stories.getPhotoUploadServer", params, &response)
+	return vk.StoriesGetPhotoUploadServerContext(context.Background(), params)
+}
+
+// StoriesGetPhotoUploadServerContext is the same as StoriesGetPhotoUploadServer, but takes a context.
+func (vk *VK) StoriesGetPhotoUploadServerContext(ctx context.Context, params Params) (response StoriesGetPhotoUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.getPhotoUploadServer", params, &response)
 	return
 }
 
@@ -157,8 +206,13 @@ type StoriesGetRepliesResponse struct {
 //
 // https://vk.com/dev/stories.getReplies
 func (vk *VK) StoriesGetReplies(params Params) (response StoriesGetRepliesResponse, err error) {
+	return vk.StoriesGetRepliesContext(context.Background(), params)
+}
+
+// StoriesGetRepliesContext is the same as StoriesGetReplies, but takes a context.
+func (vk *VK) StoriesGetRepliesContext(ctx context.Context, params Params) (response StoriesGetRepliesResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.getReplies", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getReplies", params, &response)
 
 	return
 }
@@ -176,8 +230,13 @@ type StoriesGetRepliesExtendedResponse struct {
 //
 // https://vk.com/dev/stories.getReplies
 func (vk *VK) StoriesGetRepliesExtended(params Params) (response StoriesGetRepliesExtendedResponse, err error) {
+	return vk.StoriesGetRepliesExtendedContext(context.Background(), params)
+}
+
+// StoriesGetRepliesExtendedContext is the same as StoriesGetRepliesExtended, but takes a context.
+func (vk *VK) StoriesGetRepliesExtendedContext(ctx context.Context, params Params) (response StoriesGetRepliesExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.getReplies", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getReplies", params, &response)
 
 	return
 }
@@ -189,7 +248,12 @@ type StoriesGetStatsResponse object.StoriesStoryStats
 //
 // https://vk.com/dev/stories.getStats
 func (vk *VK) StoriesGetStats(params Params) (response StoriesGetStatsResponse, err error) {
-	err = vk.RequestUnmarshal("stories.getStats", params, &response)
+	return vk.StoriesGetStatsContext(context.Background(), params)
+}
+
+// StoriesGetStatsContext is the same as StoriesGetStats, but takes a context.
+func (vk *VK) StoriesGetStatsContext(ctx context.Context, params Params) (response StoriesGetStatsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.getStats", params, &response)
 	return
 }
 
@@ -204,7 +268,12 @@ type StoriesGetVideoUploadServerResponse struct {
 //
 // https://vk.com/dev/stories.getVideoUploadServer
 func (vk *VK) StoriesGetVideoUploadServer(params Params) (response StoriesGetVideoUploadServerResponse, err error) {
-	err = vk.RequestUnmarshal("stories.getVideoUploadServer", params, &response)
+	return vk.StoriesGetVideoUploadServerContext(context.Background(), params)
+}
+
+// StoriesGetVideoUploadServerContext is the same as StoriesGetVideoUploadServer, but takes a context.
+func (vk *VK) StoriesGetVideoUploadServerContext(ctx context.Context, params Params) (response StoriesGetVideoUploadServerResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.getVideoUploadServer", params, &response)
 	return
 }
 
@@ -220,8 +289,13 @@ type StoriesGetViewersResponse struct {
 //
 // https://vk.com/dev/stories.getViewers
 func (vk *VK) StoriesGetViewers(params Params) (response StoriesGetViewersResponse, err error) {
+	return vk.StoriesGetViewersContext(context.Background(), params)
+}
+
+// StoriesGetViewersContext is the same as StoriesGetViewers, but takes a context.
+func (vk *VK) StoriesGetViewersContext(ctx context.Context, params Params) (response StoriesGetViewersResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.getViewers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getViewers", params, &response)
 
 	return
 }
@@ -238,8 +312,13 @@ type StoriesGetViewersExtendedResponse struct {
 //
 // https://vk.com/dev/stories.getViewers
 func (vk *VK) StoriesGetViewersExtended(params Params) (response StoriesGetViewersExtendedResponse, err error) {
+	return vk.StoriesGetViewersExtendedContext(context.Background(), params)
+}
+
+// StoriesGetViewersExtendedContext is the same as StoriesGetViewersExtended, but takes a context.
+func (vk *VK) StoriesGetViewersExtendedContext(ctx context.Context, params Params) (response StoriesGetViewersExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.getViewers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.getViewers", params, &response)
 
 	return
 }
@@ -248,7 +327,12 @@ func (vk *VK) StoriesGetViewersExtended(params Params) (response StoriesGetViewe
 //
 // https://vk.com/dev/stories.hideAllReplies
 func (vk *VK) StoriesHideAllReplies(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stories.hideAllReplies", params, &response)
+	return vk.StoriesHideAllRepliesContext(context.Background(), params)
+}
+
+// StoriesHideAllRepliesContext is the same as StoriesHideAllReplies, but takes a context.
+func (vk *VK) StoriesHideAllRepliesContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.hideAllReplies", params, &response)
 	return
 }
 
@@ -256,7 +340,12 @@ func (vk *VK) StoriesHideAllReplies(params Params) (response int, err error) {
 //
 // https://vk.com/dev/stories.hideReply
 func (vk *VK) StoriesHideReply(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stories.hideReply", params, &response)
+	return vk.StoriesHideReplyContext(context.Background(), params)
+}
+
+// StoriesHideReplyContext is the same as StoriesHideReply, but takes a context.
+func (vk *VK) StoriesHideReplyContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.hideReply", params, &response)
 	return
 }
 
@@ -272,8 +361,13 @@ type StoriesSearchResponse struct {
 //
 // https://vk.com/dev/stories.search
 func (vk *VK) StoriesSearch(params Params) (response StoriesSearchResponse, err error) {
+	return vk.StoriesSearchContext(context.Background(), params)
+}
+
+// StoriesSearchContext is the same as StoriesSearch, but takes a context.
+func (vk *VK) StoriesSearchContext(ctx context.Context, params Params) (response StoriesSearchResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("stories.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.search", params, &response)
 
 	return
 }
@@ -291,8 +385,13 @@ type StoriesSearchExtendedResponse struct {
 //
 // https://vk.com/dev/stories.search
 func (vk *VK) StoriesSearchExtended(params Params) (response StoriesSearchExtendedResponse, err error) {
+	return vk.StoriesSearchExtendedContext(context.Background(), params)
+}
+
+// StoriesSearchExtendedContext is the same as StoriesSearchExtended, but takes a context.
+func (vk *VK) StoriesSearchExtendedContext(ctx context.Context, params Params) (response StoriesSearchExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("stories.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "stories.search", params, &response)
 
 	return
 }
@@ -301,6 +400,11 @@ func (vk *VK) StoriesSearchExtended(params Params) (response StoriesSearchExtend
 //
 // https://vk.com/dev/stories.unbanOwner
 func (vk *VK) StoriesUnbanOwner(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("stories.unbanOwner", params, &response)
+	return vk.StoriesUnbanOwnerContext(context.Background(), params)
+}
+
+// StoriesUnbanOwnerContext is the same as StoriesUnbanOwner, but takes a context.
+func (vk *VK) StoriesUnbanOwnerContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "stories.unbanOwner", params, &response)
 	return
 }
@@ -1,5 +1,9 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
+import (
+	"context"
+)
+
 // StreamingGetServerURLResponse struct.
 type StreamingGetServerURLResponse struct {
 	Endpoint string `json:"endpoint"`
@@ -10,7 +14,12 @@ type StreamingGetServerURLResponse struct {
 //
 // https://vk.com/dev/streaming.getServerUrl
 func (vk *VK) StreamingGetServerURL(params Params) (response StreamingGetServerURLResponse, err error) {
-	err = vk.RequestUnmarshal("streaming.getServerUrl", params, &response)
+	return vk.StreamingGetServerURLContext(context.Background(), params)
+}
+
+// StreamingGetServerURLContext is the same as StreamingGetServerURL, but takes a context.
+func (vk *VK) StreamingGetServerURLContext(ctx context.Context, params Params) (response StreamingGetServerURLResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "streaming.getServerUrl", params, &response)
 	return
 }
 
@@ -23,7 +32,12 @@ type StreamingGetSettingsResponse struct {
 //
 // https://vk.com/dev/streaming.getSettings
 func (vk *VK) StreamingGetSettings(params Params) (response StreamingGetSettingsResponse, err error) {
-	err = vk.RequestUnmarshal("streaming.getSettings", params, &response)
+	return vk.StreamingGetSettingsContext(context.Background(), params)
+}
+
+// StreamingGetSettingsContext is the same as StreamingGetSettings, but takes a context.
+func (vk *VK) StreamingGetSettingsContext(ctx context.Context, params Params) (response StreamingGetSettingsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "streaming.getSettings", params, &response)
 	return
 }
 
@@ -40,7 +54,12 @@ type StreamingGetStatsResponse []struct {
 //
 // https://vk.com/dev/streaming.getStats
 func (vk *VK) StreamingGetStats(params Params) (response StreamingGetStatsResponse, err error) {
-	err = vk.RequestUnmarshal("streaming.getStats", params, &response)
+	return vk.StreamingGetStatsContext(context.Background(), params)
+}
+
+// StreamingGetStatsContext is the same as StreamingGetStats, but takes a context.
+func (vk *VK) StreamingGetStatsContext(ctx context.Context, params Params) (response StreamingGetStatsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "streaming.getStats", params, &response)
 	return
 }
 
@@ -53,7 +72,12 @@ type StreamingGetStemResponse struct {
 //
 // https://vk.com/dev/streaming.getStem
 func (vk *VK) StreamingGetStem(params Params) (response StreamingGetStemResponse, err error) {
-	err = vk.RequestUnmarshal("streaming.getStem", params, &response)
+	return vk.StreamingGetStemContext(context.Background(), params)
+}
+
+// StreamingGetStemContext is the same as StreamingGetStem, but takes a context.
+func (vk *VK) StreamingGetStemContext(ctx context.Context, params Params) (response StreamingGetStemResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "streaming.getStem", params, &response)
 	return
 }
 
@@ -61,7 +85,12 @@ func (vk *VK) StreamingGetStem(params Params) (response StreamingGetStemResponse
 //
 // https://vk.com/dev/streaming.setSettings
 func (vk *VK) StreamingSetSettings(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("streaming.setSettings", params, &response)
+	return vk.StreamingSetSettingsContext(context.Background(), params)
+}
+
+// StreamingSetSettingsContext is the same as StreamingSetSettings, but takes a context.
+func (vk *VK) StreamingSetSettingsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "streaming.setSettings", params, &response)
 
 	return
 }
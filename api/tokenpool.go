@@ -0,0 +1,236 @@
+package api // import "github.com/SevereCloud/vksdk/api"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api/errors"
+)
+
+// ErrNoTokens is returned by TokenPool.Get when the pool has no healthy tokens.
+var ErrNoTokens = fmt.Errorf("api: no healthy tokens in pool")
+
+// Unhealthy is a penalty which excludes a token from the pool until
+// TokenPool.Revive is called.
+const Unhealthy time.Duration = -1
+
+// TokenStats is a snapshot of a token statistics.
+type TokenStats struct {
+	Token         string
+	Weight        int
+	Healthy       bool
+	CooldownUntil time.Time
+	Requests      int64
+	Errors        int64
+	LastError     error
+	LastUsed      time.Time
+}
+
+type poolToken struct {
+	TokenStats
+
+	currentWeight int
+}
+
+// TokenPool is a pool of access tokens with health tracking.
+//
+// Tokens are selected by smooth weighted round-robin. A token which got
+// an error from Penalties is excluded from the pool for the penalty time,
+// or until Revive is called if the penalty is Unhealthy.
+type TokenPool struct {
+	// Penalties maps error types to the time a token is excluded
+	// from the pool.
+	Penalties map[errors.ErrorType]time.Duration
+
+	tokens []*poolToken
+	mux    sync.Mutex
+}
+
+// NewTokenPool returns a new TokenPool with tokens of weight 1.
+//
+// Tokens are marked unhealthy on errors.Auth, because a revoked token
+// never works again. Other errors are temporary: a token is cooled down
+// for 10 minutes on errors.Flood, a per-action limit, for a minute on
+// errors.Permission and for a second on errors.TooMany. If permission
+// errors are caused by requested objects rather than tokens, delete
+// errors.Permission from Penalties.
+func NewTokenPool(tokens ...string) *TokenPool {
+	p := &TokenPool{
+		Penalties: map[errors.ErrorType]time.Duration{
+			errors.Auth:       Unhealthy,
+			errors.Flood:      10 * time.Minute,
+			errors.Permission: time.Minute,
+			errors.TooMany:    time.Second,
+		},
+	}
+
+	for _, token := range tokens {
+		p.Add(token, 1)
+	}
+
+	return p
+}
+
+func (p *TokenPool) find(token string) *poolToken {
+	for _, t := range p.tokens {
+		if t.Token == token {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// Add adds the token to the pool or updates its weight.
+// Weight less than 1 is treated as 1.
+func (p *TokenPool) Add(token string, weight int) {
+	if weight < 1 {
+		weight = 1
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if t := p.find(token); t != nil {
+		t.Weight = weight
+		return
+	}
+
+	p.tokens = append(p.tokens, &poolToken{
+		TokenStats: TokenStats{
+			Token:   token,
+			Weight:  weight,
+			Healthy: true,
+		},
+	})
+}
+
+// Remove removes the token from the pool.
+func (p *TokenPool) Remove(token string) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	for i, t := range p.tokens {
+		if t.Token == token {
+			p.tokens = append(p.tokens[:i], p.tokens[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Has reports whether the token is in the pool.
+func (p *TokenPool) Has(token string) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	return p.find(token) != nil
+}
+
+// Revive marks the token healthy and resets its cooldown.
+func (p *TokenPool) Revive(token string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if t := p.find(token); t != nil {
+		t.Healthy = true
+		t.CooldownUntil = time.Time{}
+	}
+}
+
+// Get returns an access token from the pool.
+//
+// If all healthy tokens are cooling down, the token with the earliest
+// end of cooldown is returned. If there are no healthy tokens,
+// ErrNoTokens is returned.
+func (p *TokenPool) Get() (string, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	now := time.Now()
+
+	var (
+		best, earliest *poolToken
+		total          int
+	)
+
+	for _, t := range p.tokens {
+		if !t.Healthy {
+			continue
+		}
+
+		if now.Before(t.CooldownUntil) {
+			if earliest == nil || t.CooldownUntil.Before(earliest.CooldownUntil) {
+				earliest = t
+			}
+
+			continue
+		}
+
+		t.currentWeight += t.Weight
+		total += t.Weight
+
+		if best == nil || t.currentWeight > best.currentWeight {
+			best = t
+		}
+	}
+
+	if best == nil {
+		best = earliest
+	} else {
+		best.currentWeight -= total
+	}
+
+	if best == nil {
+		return "", ErrNoTokens
+	}
+
+	best.LastUsed = now
+
+	return best.Token, nil
+}
+
+// Report updates the token statistics with the result of a request.
+func (p *TokenPool) Report(token string, err error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	t := p.find(token)
+	if t == nil {
+		return
+	}
+
+	t.Requests++
+
+	if err == nil {
+		return
+	}
+
+	t.Errors++
+	t.LastError = err
+
+	penalty, ok := p.Penalties[errors.GetType(err)]
+
+	switch {
+	case !ok:
+	case penalty == Unhealthy:
+		t.Healthy = false
+	case penalty > 0:
+		t.CooldownUntil = time.Now().Add(penalty)
+	}
+}
+
+// Stats returns a snapshot of statistics for all tokens in the pool.
+func (p *TokenPool) Stats() []TokenStats {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	stats := make([]TokenStats, len(p.tokens))
+	for i, t := range p.tokens {
+		stats[i] = t.TokenStats
+	}
+
+	return stats
+}
@@ -0,0 +1,213 @@
+package api_test
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenPool_Get(t *testing.T) {
+	t.Parallel()
+
+	tokens := []string{"1", "2", "3"}
+	p := api.NewTokenPool(tokens...)
+
+	for i := 0; i < len(tokens)*2; i++ {
+		token, err := p.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, tokens[i%len(tokens)], token)
+	}
+}
+
+func TestTokenPool_weight(t *testing.T) {
+	t.Parallel()
+
+	p := api.NewTokenPool()
+	p.Add("a", 3)
+	p.Add("b", 1)
+
+	got := make(map[string]int)
+
+	for i := 0; i < 8; i++ {
+		token, err := p.Get()
+		assert.NoError(t, err)
+
+		got[token]++
+	}
+
+	assert.Equal(t, map[string]int{"a": 6, "b": 2}, got)
+}
+
+func TestTokenPool_health(t *testing.T) {
+	t.Parallel()
+
+	p := api.NewTokenPool("a", "b")
+
+	p.Report("a", errors.Auth.New("revoked"))
+	p.Report("b", nil)
+
+	for i := 0; i < 4; i++ {
+		token, err := p.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "b", token)
+	}
+
+	p.Report("b", errors.Auth.New("revoked"))
+
+	_, err := p.Get()
+	assert.Equal(t, api.ErrNoTokens, err)
+
+	p.Revive("a")
+
+	token, err := p.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", token)
+
+	stats := p.Stats()
+	assert.Len(t, stats, 2)
+	assert.True(t, stats[0].Healthy)
+	assert.Equal(t, int64(1), stats[0].Errors)
+	assert.False(t, stats[1].Healthy)
+	assert.Equal(t, int64(2), stats[1].Requests)
+	assert.Equal(t, errors.Auth, errors.GetType(stats[1].LastError))
+}
+
+func TestTokenPool_cooldown(t *testing.T) {
+	t.Parallel()
+
+	p := api.NewTokenPool("a", "b")
+	p.Penalties[errors.TooMany] = 50 * time.Millisecond
+
+	p.Report("a", errors.TooMany.New("too many"))
+
+	for i := 0; i < 4; i++ {
+		token, _ := p.Get()
+		assert.Equal(t, "b", token)
+	}
+
+	// all tokens are cooling down
+	p.Report("b", errors.TooMany.New("too many"))
+
+	token, err := p.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", token)
+
+	assert.False(t, p.Stats()[0].CooldownUntil.IsZero())
+
+	time.Sleep(50 * time.Millisecond)
+
+	got := make(map[string]bool)
+
+	for i := 0; i < 2; i++ {
+		token, _ := p.Get()
+		got[token] = true
+	}
+
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, got)
+}
+
+func TestTokenPool_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	p := api.NewTokenPool("a")
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _ = p.Get()
+		}()
+	}
+
+	p.Add("b", 1)
+	assert.True(t, p.Has("b"))
+	assert.True(t, p.Remove("a"))
+	assert.False(t, p.Remove("a"))
+	assert.False(t, p.Has("a"))
+
+	wg.Wait()
+
+	token, err := p.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "b", token)
+}
+
+func TestVK_TokenPool(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tokens []string
+		mux    sync.Mutex
+	)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		token := r.FormValue("access_token")
+
+		mux.Lock()
+		tokens = append(tokens, token)
+		mux.Unlock()
+
+		if strings.HasPrefix(token, "revoked") {
+			jsonHandler(`{"error":{"error_code":5}}`)(w, r)
+			return
+		}
+
+		jsonHandler(`{"response":1}`)(w, r)
+	}
+
+	vk, ts := newTestVK(handler)
+	defer ts.Close()
+
+	vk.IsPoolClient = true
+	vk.TokenPool = api.NewTokenPool("revoked", "ok")
+
+	// the request is repeated with another token
+	for i := 0; i < 3; i++ {
+		_, err := vk.Request("users.get", api.Params{})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"revoked", "ok", "ok", "ok"}, tokens)
+	assert.False(t, vk.TokenPool.Stats()[0].Healthy)
+
+	// all tokens are revoked
+	tokens = nil
+	vk.TokenPool = api.NewTokenPool("revoked", "revoked2")
+
+	_, err := vk.Request("users.get", api.Params{})
+	assert.Equal(t, errors.Auth, errors.GetType(err))
+	assert.Equal(t, []string{"revoked", "revoked2"}, tokens)
+}
+
+func TestTokenPool_temporaryPenalties(t *testing.T) {
+	t.Parallel()
+
+	p := api.NewTokenPool("a", "b", "c")
+	p.Report("a", errors.Permission.New("no rights"))
+	p.Report("b", errors.Flood.New("flood"))
+
+	// penalized tokens are not used while another token is available
+	for i := 0; i < 3; i++ {
+		token, err := p.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "c", token)
+	}
+
+	// flood and permission errors do not exclude tokens for good
+	for _, s := range p.Stats()[:2] {
+		assert.True(t, s.Healthy)
+		assert.False(t, s.CooldownUntil.IsZero())
+	}
+
+	assert.True(t, p.Stats()[1].CooldownUntil.After(p.Stats()[0].CooldownUntil))
+}
@@ -2,12 +2,15 @@ package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net/http"
 
+	"github.com/SevereCloud/vksdk/internal"
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -20,6 +23,11 @@ type uploadError struct {
 
 // UploadFile uploading file.
 func (vk *VK) UploadFile(url string, file io.Reader, fieldname, filename string) (bodyContent []byte, err error) {
+	return vk.UploadFileContext(context.Background(), url, file, fieldname, filename)
+}
+
+// UploadFileContext is the same as UploadFile, but takes a context.
+func (vk *VK) UploadFileContext(ctx context.Context, url string, file io.Reader, fieldname, filename string) (bodyContent []byte, err error) {
 	body := new(bytes.Buffer)
 	writer := multipart.NewWriter(body)
 
@@ -36,7 +44,17 @@ func (vk *VK) UploadFile(url string, file io.Reader, fieldname, filename string)
 	contentType := writer.FormDataContentType()
 	_ = writer.Close()
 
-	resp, err := vk.Client.Post(url, contentType, body) // nolint: gosec
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	ctx = context.WithValue(ctx, internal.HTTPClientKey, vk.Client)
+	ctx = context.WithValue(ctx, internal.UserAgentKey, vk.UserAgent)
+
+	resp, err := internal.DoRequest(ctx, req)
 	if err != nil {
 		return
 	}
@@ -53,13 +71,13 @@ func (vk *VK) UploadFile(url string, file io.Reader, fieldname, filename string)
 //
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
-func (vk *VK) uploadPhoto(params Params, file io.Reader) (response PhotosSaveResponse, err error) {
-	uploadServer, err := vk.PhotosGetUploadServer(params)
+func (vk *VK) uploadPhoto(ctx context.Context, params Params, file io.Reader) (response PhotosSaveResponse, err error) {
+	uploadServer, err := vk.PhotosGetUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "file1", "file1.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "file1", "file1.jpeg")
 	if err != nil {
 		return
 	}
@@ -71,7 +89,7 @@ func (vk *VK) uploadPhoto(params Params, file io.Reader) (response PhotosSaveRes
 		return
 	}
 
-	response, err = vk.PhotosSave(Params{
+	response, err = vk.PhotosSaveContext(ctx, Params{
 		"server":      handler.Server,
 		"photos_list": handler.PhotosList,
 		"aid":         handler.AID,
@@ -90,7 +108,12 @@ func (vk *VK) uploadPhoto(params Params, file io.Reader) (response PhotosSaveRes
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadPhoto(albumID int, file io.Reader) (response PhotosSaveResponse, err error) {
-	response, err = vk.uploadPhoto(Params{
+	return vk.UploadPhotoContext(context.Background(), albumID, file)
+}
+
+// UploadPhotoContext is the same as UploadPhoto, but takes a context.
+func (vk *VK) UploadPhotoContext(ctx context.Context, albumID int, file io.Reader) (response PhotosSaveResponse, err error) {
+	response, err = vk.uploadPhoto(ctx, Params{
 		"album_id": albumID,
 	}, file)
 
@@ -104,7 +127,12 @@ func (vk *VK) UploadPhoto(albumID int, file io.Reader) (response PhotosSaveRespo
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadPhotoGroup(groupID, albumID int, file io.Reader) (response PhotosSaveResponse, err error) {
-	response, err = vk.uploadPhoto(Params{
+	return vk.UploadPhotoGroupContext(context.Background(), groupID, albumID, file)
+}
+
+// UploadPhotoGroupContext is the same as UploadPhotoGroup, but takes a context.
+func (vk *VK) UploadPhotoGroupContext(ctx context.Context, groupID, albumID int, file io.Reader) (response PhotosSaveResponse, err error) {
+	response, err = vk.uploadPhoto(ctx, Params{
 		"album_id": albumID,
 		"group_id": groupID,
 	}, file)
@@ -118,13 +146,13 @@ func (vk *VK) UploadPhotoGroup(groupID, albumID int, file io.Reader) (response P
 //
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
-func (vk *VK) uploadWallPhoto(params Params, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetWallUploadServer(params)
+func (vk *VK) uploadWallPhoto(ctx context.Context, params Params, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetWallUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "photo", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "photo", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -136,7 +164,7 @@ func (vk *VK) uploadWallPhoto(params Params, file io.Reader) (response PhotosSav
 		return
 	}
 
-	response, err = vk.PhotosSaveWallPhoto(Params{
+	response, err = vk.PhotosSaveWallPhotoContext(ctx, Params{
 		"server":   handler.Server,
 		"photo":    handler.Photo,
 		"hash":     handler.Hash,
@@ -153,7 +181,12 @@ func (vk *VK) uploadWallPhoto(params Params, file io.Reader) (response PhotosSav
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadWallPhoto(file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
-	response, err = vk.uploadWallPhoto(Params{}, file)
+	return vk.UploadWallPhotoContext(context.Background(), file)
+}
+
+// UploadWallPhotoContext is the same as UploadWallPhoto, but takes a context.
+func (vk *VK) UploadWallPhotoContext(ctx context.Context, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
+	response, err = vk.uploadWallPhoto(ctx, Params{}, file)
 	return
 }
 
@@ -164,7 +197,12 @@ func (vk *VK) UploadWallPhoto(file io.Reader) (response PhotosSaveWallPhotoRespo
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadGroupWallPhoto(groupID int, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
-	response, err = vk.uploadWallPhoto(Params{
+	return vk.UploadGroupWallPhotoContext(context.Background(), groupID, file)
+}
+
+// UploadGroupWallPhotoContext is the same as UploadGroupWallPhoto, but takes a context.
+func (vk *VK) UploadGroupWallPhotoContext(ctx context.Context, groupID int, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
+	response, err = vk.uploadWallPhoto(ctx, Params{
 		"group_id": groupID,
 	}, file)
 
@@ -183,8 +221,8 @@ func (vk *VK) UploadGroupWallPhoto(groupID int, file io.Reader) (response Photos
 //
 // Limits: size not less than 200x200px, aspect ratio from 0.25 to 3,
 // width+height not more than 14000 px, file size up to 50 Mb.
-func (vk *VK) uploadOwnerPhoto(params Params, squareCrop string, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetOwnerPhotoUploadServer(params)
+func (vk *VK) uploadOwnerPhoto(ctx context.Context, params Params, squareCrop string, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetOwnerPhotoUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
@@ -231,7 +269,7 @@ func (vk *VK) uploadOwnerPhoto(params Params, squareCrop string, file io.Reader)
 		return
 	}
 
-	response, err = vk.PhotosSaveOwnerPhoto(Params{
+	response, err = vk.PhotosSaveOwnerPhotoContext(ctx, Params{
 		"server": handler.Server,
 		"photo":  handler.Photo,
 		"hash":   handler.Hash,
@@ -247,7 +285,12 @@ func (vk *VK) uploadOwnerPhoto(params Params, squareCrop string, file io.Reader)
 // Limits: size not less than 200x200px, aspect ratio from 0.25 to 3,
 // width+height not more than 14000 px, file size up to 50 Mb.
 func (vk *VK) UploadUserPhoto(file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
-	response, err = vk.uploadOwnerPhoto(Params{}, "", file)
+	return vk.UploadUserPhotoContext(context.Background(), file)
+}
+
+// UploadUserPhotoContext is the same as UploadUserPhoto, but takes a context.
+func (vk *VK) UploadUserPhotoContext(ctx context.Context, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
+	response, err = vk.uploadOwnerPhoto(ctx, Params{}, "", file)
 	return
 }
 
@@ -264,7 +307,12 @@ func (vk *VK) UploadUserPhoto(file io.Reader) (response PhotosSaveOwnerPhotoResp
 // Limits: size not less than 200x200px, aspect ratio from 0.25 to 3,
 // width+height not more than 14000 px, file size up to 50 Mb.
 func (vk *VK) UploadOwnerPhoto(ownerID int, squareCrop string, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
-	response, err = vk.uploadOwnerPhoto(Params{
+	return vk.UploadOwnerPhotoContext(context.Background(), ownerID, squareCrop, file)
+}
+
+// UploadOwnerPhotoContext is the same as UploadOwnerPhoto, but takes a context.
+func (vk *VK) UploadOwnerPhotoContext(ctx context.Context, ownerID int, squareCrop string, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
+	response, err = vk.uploadOwnerPhoto(ctx, Params{
 		"owner_id": ownerID,
 	}, squareCrop, file)
 
@@ -278,14 +326,19 @@ func (vk *VK) UploadOwnerPhoto(ownerID int, squareCrop string, file io.Reader) (
 // Limits: width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadMessagesPhoto(peerID int, file io.Reader) (response PhotosSaveMessagesPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetMessagesUploadServer(Params{
+	return vk.UploadMessagesPhotoContext(context.Background(), peerID, file)
+}
+
+// UploadMessagesPhotoContext is the same as UploadMessagesPhoto, but takes a context.
+func (vk *VK) UploadMessagesPhotoContext(ctx context.Context, peerID int, file io.Reader) (response PhotosSaveMessagesPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetMessagesUploadServerContext(ctx, Params{
 		"peer_id": peerID,
 	})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "photo", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "photo", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -297,7 +350,7 @@ func (vk *VK) UploadMessagesPhoto(peerID int, file io.Reader) (response PhotosSa
 		return
 	}
 
-	response, err = vk.PhotosSaveMessagesPhoto(Params{
+	response, err = vk.PhotosSaveMessagesPhotoContext(ctx, Params{
 		"server": handler.Server,
 		"photo":  handler.Photo,
 		"hash":   handler.Hash,
@@ -313,13 +366,13 @@ func (vk *VK) UploadMessagesPhoto(peerID int, file io.Reader) (response PhotosSa
 // Limits: size not less than 200x200px,
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
-func (vk *VK) uploadChatPhoto(params Params, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetChatUploadServer(params)
+func (vk *VK) uploadChatPhoto(ctx context.Context, params Params, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetChatUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "file", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "file", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -331,7 +384,7 @@ func (vk *VK) uploadChatPhoto(params Params, file io.Reader) (response MessagesS
 		return
 	}
 
-	response, err = vk.MessagesSetChatPhoto(Params{
+	response, err = vk.MessagesSetChatPhotoContext(ctx, Params{
 		"file": handler.Response,
 	})
 
@@ -346,7 +399,12 @@ func (vk *VK) uploadChatPhoto(params Params, file io.Reader) (response MessagesS
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadChatPhoto(chatID int, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
-	response, err = vk.uploadChatPhoto(Params{
+	return vk.UploadChatPhotoContext(context.Background(), chatID, file)
+}
+
+// UploadChatPhotoContext is the same as UploadChatPhoto, but takes a context.
+func (vk *VK) UploadChatPhotoContext(ctx context.Context, chatID int, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
+	response, err = vk.uploadChatPhoto(ctx, Params{
 		"chat_id": chatID,
 	}, file)
 
@@ -361,7 +419,12 @@ func (vk *VK) UploadChatPhoto(chatID int, file io.Reader) (response MessagesSetC
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadChatPhotoCrop(chatID, cropX, cropY, cropWidth int, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
-	response, err = vk.uploadChatPhoto(Params{
+	return vk.UploadChatPhotoCropContext(context.Background(), chatID, cropX, cropY, cropWidth, file)
+}
+
+// UploadChatPhotoCropContext is the same as UploadChatPhotoCrop, but takes a context.
+func (vk *VK) UploadChatPhotoCropContext(ctx context.Context, chatID, cropX, cropY, cropWidth int, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
+	response, err = vk.uploadChatPhoto(ctx, Params{
 		"chat_id":    chatID,
 		"crop_x":     cropX,
 		"crop_y":     cropY,
@@ -378,13 +441,13 @@ func (vk *VK) UploadChatPhotoCrop(chatID, cropX, cropY, cropWidth int, file io.R
 // Limits: size not less than 400x400px,
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
-func (vk *VK) uploadMarketPhoto(params Params, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetMarketUploadServer(params)
+func (vk *VK) uploadMarketPhoto(ctx context.Context, params Params, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetMarketUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "file", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "file", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -396,7 +459,7 @@ func (vk *VK) uploadMarketPhoto(params Params, file io.Reader) (response PhotosS
 		return
 	}
 
-	response, err = vk.PhotosSaveMarketPhoto(Params{
+	response, err = vk.PhotosSaveMarketPhotoContext(ctx, Params{
 		"group_id":  params["group_id"],
 		"server":    handler.Server,
 		"photo":     handler.Photo,
@@ -416,7 +479,12 @@ func (vk *VK) uploadMarketPhoto(params Params, file io.Reader) (response PhotosS
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadMarketPhoto(groupID int, mainPhoto bool, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
-	response, err = vk.uploadMarketPhoto(Params{
+	return vk.UploadMarketPhotoContext(context.Background(), groupID, mainPhoto, file)
+}
+
+// UploadMarketPhotoContext is the same as UploadMarketPhoto, but takes a context.
+func (vk *VK) UploadMarketPhotoContext(ctx context.Context, groupID int, mainPhoto bool, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
+	response, err = vk.uploadMarketPhoto(ctx, Params{
 		"group_id":   groupID,
 		"main_photo": mainPhoto,
 	}, file)
@@ -432,7 +500,12 @@ func (vk *VK) UploadMarketPhoto(groupID int, mainPhoto bool, file io.Reader) (re
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadMarketPhotoCrop(groupID, cropX, cropY, cropWidth int, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
-	response, err = vk.uploadMarketPhoto(Params{
+	return vk.UploadMarketPhotoCropContext(context.Background(), groupID, cropX, cropY, cropWidth, file)
+}
+
+// UploadMarketPhotoCropContext is the same as UploadMarketPhotoCrop, but takes a context.
+func (vk *VK) UploadMarketPhotoCropContext(ctx context.Context, groupID, cropX, cropY, cropWidth int, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
+	response, err = vk.uploadMarketPhoto(ctx, Params{
 		"group_id":   groupID,
 		"main_photo": true,
 		"crop_x":     cropX,
@@ -451,14 +524,19 @@ func (vk *VK) UploadMarketPhotoCrop(groupID, cropX, cropY, cropWidth int, file i
 // width+height not more than 14000 px, file size up to 50 Mb,
 // aspect ratio of at least 1:20.
 func (vk *VK) UploadMarketAlbumPhoto(groupID int, file io.Reader) (response PhotosSaveMarketAlbumPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetMarketAlbumUploadServer(Params{
+	return vk.UploadMarketAlbumPhotoContext(context.Background(), groupID, file)
+}
+
+// UploadMarketAlbumPhotoContext is the same as UploadMarketAlbumPhoto, but takes a context.
+func (vk *VK) UploadMarketAlbumPhotoContext(ctx context.Context, groupID int, file io.Reader) (response PhotosSaveMarketAlbumPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetMarketAlbumUploadServerContext(ctx, Params{
 		"group_id": groupID,
 	})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "file", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "file", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -470,7 +548,7 @@ func (vk *VK) UploadMarketAlbumPhoto(groupID int, file io.Reader) (response Phot
 		return
 	}
 
-	response, err = vk.PhotosSaveMarketAlbumPhoto(Params{
+	response, err = vk.PhotosSaveMarketAlbumPhotoContext(ctx, Params{
 		"group_id": groupID,
 		"server":   handler.Server,
 		"photo":    handler.Photo,
@@ -484,12 +562,17 @@ func (vk *VK) UploadMarketAlbumPhoto(groupID int, file io.Reader) (response Phot
 //
 // Supported formats: AVI, MP4, 3GP, MPEG, MOV, FLV, WMV.
 func (vk *VK) UploadVideo(params Params, file io.Reader) (response VideoSaveResponse, err error) {
-	response, err = vk.VideoSave(params)
+	return vk.UploadVideoContext(context.Background(), params, file)
+}
+
+// UploadVideoContext is the same as UploadVideo, but takes a context.
+func (vk *VK) UploadVideoContext(ctx context.Context, params Params, file io.Reader) (response VideoSaveResponse, err error) {
+	response, err = vk.VideoSaveContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(response.UploadURL, file, "video_file", "video.mp4")
+	bodyContent, err := vk.UploadFileContext(ctx, response.UploadURL, file, "video_file", "video.mp4")
 	if err != nil {
 		return
 	}
@@ -513,8 +596,8 @@ func (vk *VK) UploadVideo(params Params, file io.Reader) (response VideoSaveResp
 // Supported formats: any formats excepting mp3 and executable files.
 //
 // Limits: file size up to 200 MB.
-func (vk *VK) uploadDoc(url, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	bodyContent, err := vk.UploadFile(url, file, "file", title)
+func (vk *VK) uploadDoc(ctx context.Context, url, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	bodyContent, err := vk.UploadFileContext(ctx, url, file, "file", title)
 	if err != nil {
 		return
 	}
@@ -538,7 +621,7 @@ func (vk *VK) uploadDoc(url, title, tags string, file io.Reader) (response DocsS
 		return
 	}
 
-	response, err = vk.DocsSave(Params{
+	response, err = vk.DocsSaveContext(ctx, Params{
 		"file":  handler.File,
 		"title": title,
 		"tags":  tags,
@@ -553,12 +636,17 @@ func (vk *VK) uploadDoc(url, title, tags string, file io.Reader) (response DocsS
 //
 // Limits: file size up to 200 MB.
 func (vk *VK) UploadDoc(title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	uploadServer, err := vk.DocsGetUploadServer(Params{})
+	return vk.UploadDocContext(context.Background(), title, tags, file)
+}
+
+// UploadDocContext is the same as UploadDoc, but takes a context.
+func (vk *VK) UploadDocContext(ctx context.Context, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	uploadServer, err := vk.DocsGetUploadServerContext(ctx, Params{})
 	if err != nil {
 		return
 	}
 
-	response, err = vk.uploadDoc(uploadServer.UploadURL, title, tags, file)
+	response, err = vk.uploadDoc(ctx, uploadServer.UploadURL, title, tags, file)
 
 	return
 }
@@ -569,14 +657,19 @@ func (vk *VK) UploadDoc(title, tags string, file io.Reader) (response DocsSaveRe
 //
 // Limits: file size up to 200 MB.
 func (vk *VK) UploadGroupDoc(groupID int, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	uploadServer, err := vk.DocsGetUploadServer(Params{
+	return vk.UploadGroupDocContext(context.Background(), groupID, title, tags, file)
+}
+
+// UploadGroupDocContext is the same as UploadGroupDoc, but takes a context.
+func (vk *VK) UploadGroupDocContext(ctx context.Context, groupID int, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	uploadServer, err := vk.DocsGetUploadServerContext(ctx, Params{
 		"group_id": groupID,
 	})
 	if err != nil {
 		return
 	}
 
-	response, err = vk.uploadDoc(uploadServer.UploadURL, title, tags, file)
+	response, err = vk.uploadDoc(ctx, uploadServer.UploadURL, title, tags, file)
 
 	return
 }
@@ -587,12 +680,17 @@ func (vk *VK) UploadGroupDoc(groupID int, title, tags string, file io.Reader) (r
 //
 // Limits: file size up to 200 MB.
 func (vk *VK) UploadWallDoc(title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	uploadServer, err := vk.DocsGetWallUploadServer(Params{})
+	return vk.UploadWallDocContext(context.Background(), title, tags, file)
+}
+
+// UploadWallDocContext is the same as UploadWallDoc, but takes a context.
+func (vk *VK) UploadWallDocContext(ctx context.Context, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	uploadServer, err := vk.DocsGetWallUploadServerContext(ctx, Params{})
 	if err != nil {
 		return
 	}
 
-	response, err = vk.uploadDoc(uploadServer.UploadURL, title, tags, file)
+	response, err = vk.uploadDoc(ctx, uploadServer.UploadURL, title, tags, file)
 
 	return
 }
@@ -603,14 +701,19 @@ func (vk *VK) UploadWallDoc(title, tags string, file io.Reader) (response DocsSa
 //
 // Limits: file size up to 200 MB.
 func (vk *VK) UploadGroupWallDoc(groupID int, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	uploadServer, err := vk.DocsGetWallUploadServer(Params{
+	return vk.UploadGroupWallDocContext(context.Background(), groupID, title, tags, file)
+}
+
+// UploadGroupWallDocContext is the same as UploadGroupWallDoc, but takes a context.
+func (vk *VK) UploadGroupWallDocContext(ctx context.Context, groupID int, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	uploadServer, err := vk.DocsGetWallUploadServerContext(ctx, Params{
 		"group_id": groupID,
 	})
 	if err != nil {
 		return
 	}
 
-	response, err = vk.uploadDoc(uploadServer.UploadURL, title, tags, file)
+	response, err = vk.uploadDoc(ctx, uploadServer.UploadURL, title, tags, file)
 
 	return
 }
@@ -621,7 +724,12 @@ func (vk *VK) UploadGroupWallDoc(groupID int, title, tags string, file io.Reader
 //
 // Limits: file size up to 200 MB.
 func (vk *VK) UploadMessagesDoc(peerID int, typeDoc, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
-	uploadServer, err := vk.DocsGetMessagesUploadServer(Params{
+	return vk.UploadMessagesDocContext(context.Background(), peerID, typeDoc, title, tags, file)
+}
+
+// UploadMessagesDocContext is the same as UploadMessagesDoc, but takes a context.
+func (vk *VK) UploadMessagesDocContext(ctx context.Context, peerID int, typeDoc, title, tags string, file io.Reader) (response DocsSaveResponse, err error) {
+	uploadServer, err := vk.DocsGetMessagesUploadServerContext(ctx, Params{
 		"peer_id": peerID,
 		"type":    typeDoc,
 	})
@@ -629,7 +737,7 @@ func (vk *VK) UploadMessagesDoc(peerID int, typeDoc, title, tags string, file io
 		return
 	}
 
-	response, err = vk.uploadDoc(uploadServer.UploadURL, title, tags, file)
+	response, err = vk.uploadDoc(ctx, uploadServer.UploadURL, title, tags, file)
 
 	return
 }
@@ -641,7 +749,12 @@ func (vk *VK) UploadMessagesDoc(peerID int, typeDoc, title, tags string, file io
 // Limits: minimum photo size 795x200px, width+height not more than 14000px,
 // file size up to 50 MB. Recommended size: 1590x400px.
 func (vk *VK) UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2 int, file io.Reader) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
-	uploadServer, err := vk.PhotosGetOwnerCoverPhotoUploadServer(Params{
+	return vk.UploadOwnerCoverPhotoContext(context.Background(), groupID, cropX, cropY, cropX2, cropY2, file)
+}
+
+// UploadOwnerCoverPhotoContext is the same as UploadOwnerCoverPhoto, but takes a context.
+func (vk *VK) UploadOwnerCoverPhotoContext(ctx context.Context, groupID, cropX, cropY, cropX2, cropY2 int, file io.Reader) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
+	uploadServer, err := vk.PhotosGetOwnerCoverPhotoUploadServerContext(ctx, Params{
 		"group_id": groupID,
 		"crop_x":   cropX,
 		"crop_y":   cropY,
@@ -652,7 +765,7 @@ func (vk *VK) UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2 int, f
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "photo", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "photo", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -664,7 +777,7 @@ func (vk *VK) UploadOwnerCoverPhoto(groupID, cropX, cropY, cropX2, cropY2 int, f
 		return
 	}
 
-	response, err = vk.PhotosSaveOwnerCoverPhoto(Params{
+	response, err = vk.PhotosSaveOwnerCoverPhotoContext(ctx, Params{
 		"photo": handler.Photo,
 		"hash":  handler.Hash,
 	})
@@ -700,12 +813,17 @@ type rawUploadStoriesVideo struct {
 //
 // https://vk.com/dev/stories.getPhotoUploadServer
 func (vk *VK) UploadStoriesPhoto(params Params, file io.Reader) (response UploadStories, err error) {
-	uploadServer, err := vk.StoriesGetPhotoUploadServer(params)
+	return vk.UploadStoriesPhotoContext(context.Background(), params, file)
+}
+
+// UploadStoriesPhotoContext is the same as UploadStoriesPhoto, but takes a context.
+func (vk *VK) UploadStoriesPhotoContext(ctx context.Context, params Params, file io.Reader) (response UploadStories, err error) {
+	uploadServer, err := vk.StoriesGetPhotoUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "file", "file.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "file", "file.jpeg")
 	if err != nil {
 		return
 	}
@@ -731,12 +849,17 @@ func (vk *VK) UploadStoriesPhoto(params Params, file io.Reader) (response Upload
 //
 // Video format: h264 video, aac audio, maximum 720х1280, 30fps.
 func (vk *VK) UploadStoriesVideo(params Params, file io.Reader) (response UploadStories, err error) {
-	uploadServer, err := vk.StoriesGetVideoUploadServer(params)
+	return vk.UploadStoriesVideoContext(context.Background(), params, file)
+}
+
+// UploadStoriesVideoContext is the same as UploadStoriesVideo, but takes a context.
+func (vk *VK) UploadStoriesVideoContext(ctx context.Context, params Params, file io.Reader) (response UploadStories, err error) {
+	uploadServer, err := vk.StoriesGetVideoUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "video_file", "video.mp4")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "video_file", "video.mp4")
 	if err != nil {
 		return
 	}
@@ -765,13 +888,13 @@ func (vk *VK) UploadStoriesVideo(params Params, file io.Reader) (response Upload
 //
 // Limits: minimum photo size 795x200px, width+height not more than 14000px,
 // file size up to 50 MB. Recommended size: 1590x400px.
-func (vk *VK) uploadPollsPhoto(params Params, file io.Reader) (response PollsSavePhotoResponse, err error) {
-	uploadServer, err := vk.PollsGetPhotoUploadServer(params)
+func (vk *VK) uploadPollsPhoto(ctx context.Context, params Params, file io.Reader) (response PollsSavePhotoResponse, err error) {
+	uploadServer, err := vk.PollsGetPhotoUploadServerContext(ctx, params)
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "photo", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "photo", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -783,7 +906,7 @@ func (vk *VK) uploadPollsPhoto(params Params, file io.Reader) (response PollsSav
 		return
 	}
 
-	response, err = vk.PollsSavePhoto(Params{
+	response, err = vk.PollsSavePhotoContext(ctx, Params{
 		"photo": handler.Photo,
 		"hash":  handler.Hash,
 	})
@@ -798,7 +921,12 @@ func (vk *VK) uploadPollsPhoto(params Params, file io.Reader) (response PollsSav
 // Limits: minimum photo size 795x200px, width+height not more than 14000px,
 // file size up to 50 MB. Recommended size: 1590x400px.
 func (vk *VK) UploadPollsPhoto(file io.Reader) (response PollsSavePhotoResponse, err error) {
-	return vk.uploadPollsPhoto(Params{}, file)
+	return vk.UploadPollsPhotoContext(context.Background(), file)
+}
+
+// UploadPollsPhotoContext is the same as UploadPollsPhoto, but takes a context.
+func (vk *VK) UploadPollsPhotoContext(ctx context.Context, file io.Reader) (response PollsSavePhotoResponse, err error) {
+	return vk.uploadPollsPhoto(ctx, Params{}, file)
 }
 
 // UploadOwnerPollsPhoto uploading a Poll Photo.
@@ -808,7 +936,12 @@ func (vk *VK) UploadPollsPhoto(file io.Reader) (response PollsSavePhotoResponse,
 // Limits: minimum photo size 795x200px, width+height not more than 14000px,
 // file size up to 50 MB. Recommended size: 1590x400px.
 func (vk *VK) UploadOwnerPollsPhoto(ownerID int, file io.Reader) (response PollsSavePhotoResponse, err error) {
-	return vk.uploadPollsPhoto(Params{"owner_id": ownerID}, file)
+	return vk.UploadOwnerPollsPhotoContext(context.Background(), ownerID, file)
+}
+
+// UploadOwnerPollsPhotoContext is the same as UploadOwnerPollsPhoto, but takes a context.
+func (vk *VK) UploadOwnerPollsPhotoContext(ctx context.Context, ownerID int, file io.Reader) (response PollsSavePhotoResponse, err error) {
+	return vk.uploadPollsPhoto(ctx, Params{"owner_id": ownerID}, file)
 }
 
 type uploadPrettyCardsPhotoHandler struct {
@@ -820,12 +953,17 @@ type uploadPrettyCardsPhotoHandler struct {
 //
 // Supported formats: JPG, PNG, GIF.
 func (vk *VK) UploadPrettyCardsPhoto(file io.Reader) (response string, err error) {
-	uploadURL, err := vk.PrettyCardsGetUploadURL(Params{})
+	return vk.UploadPrettyCardsPhotoContext(context.Background(), file)
+}
+
+// UploadPrettyCardsPhotoContext is the same as UploadPrettyCardsPhoto, but takes a context.
+func (vk *VK) UploadPrettyCardsPhotoContext(ctx context.Context, file io.Reader) (response string, err error) {
+	uploadURL, err := vk.PrettyCardsGetUploadURLContext(ctx, Params{})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadURL, file, "file", "photo.jpg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadURL, file, "file", "photo.jpg")
 	if err != nil {
 		return
 	}
@@ -856,12 +994,17 @@ type uploadLeadFormsPhotoHandler struct {
 //
 // Supported formats: JPG, PNG, GIF.
 func (vk *VK) UploadLeadFormsPhoto(file io.Reader) (response string, err error) {
-	uploadURL, err := vk.LeadFormsGetUploadURL(Params{})
+	return vk.UploadLeadFormsPhotoContext(context.Background(), file)
+}
+
+// UploadLeadFormsPhotoContext is the same as UploadLeadFormsPhoto, but takes a context.
+func (vk *VK) UploadLeadFormsPhotoContext(ctx context.Context, file io.Reader) (response string, err error) {
+	uploadURL, err := vk.LeadFormsGetUploadURLContext(ctx, Params{})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadURL, file, "file", "photo.jpg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadURL, file, "file", "photo.jpg")
 	if err != nil {
 		return
 	}
@@ -885,14 +1028,19 @@ func (vk *VK) UploadLeadFormsPhoto(file io.Reader) (response string, err error)
 
 // UploadAppImage uploading a Image into App collection for community app widgets.
 func (vk *VK) UploadAppImage(imageType string, file io.Reader) (response object.AppWidgetsImage, err error) {
-	uploadServer, err := vk.AppWidgetsGetAppImageUploadServer(Params{
+	return vk.UploadAppImageContext(context.Background(), imageType, file)
+}
+
+// UploadAppImageContext is the same as UploadAppImage, but takes a context.
+func (vk *VK) UploadAppImageContext(ctx context.Context, imageType string, file io.Reader) (response object.AppWidgetsImage, err error) {
+	uploadServer, err := vk.AppWidgetsGetAppImageUploadServerContext(ctx, Params{
 		"image_type": imageType,
 	})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "image", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "image", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -904,7 +1052,7 @@ func (vk *VK) UploadAppImage(imageType string, file io.Reader) (response object.
 		return
 	}
 
-	response, err = vk.AppWidgetsSaveAppImage(Params{
+	response, err = vk.AppWidgetsSaveAppImageContext(ctx, Params{
 		"image": handler.Image,
 		"hash":  handler.Hash,
 	})
@@ -914,14 +1062,19 @@ func (vk *VK) UploadAppImage(imageType string, file io.Reader) (response object.
 
 // UploadGroupImage uploading a Image into Community collection for community app widgets.
 func (vk *VK) UploadGroupImage(imageType string, file io.Reader) (response object.AppWidgetsImage, err error) {
-	uploadServer, err := vk.AppWidgetsGetGroupImageUploadServer(Params{
+	return vk.UploadGroupImageContext(context.Background(), imageType, file)
+}
+
+// UploadGroupImageContext is the same as UploadGroupImage, but takes a context.
+func (vk *VK) UploadGroupImageContext(ctx context.Context, imageType string, file io.Reader) (response object.AppWidgetsImage, err error) {
+	uploadServer, err := vk.AppWidgetsGetGroupImageUploadServerContext(ctx, Params{
 		"image_type": imageType,
 	})
 	if err != nil {
 		return
 	}
 
-	bodyContent, err := vk.UploadFile(uploadServer.UploadURL, file, "image", "photo.jpeg")
+	bodyContent, err := vk.UploadFileContext(ctx, uploadServer.UploadURL, file, "image", "photo.jpeg")
 	if err != nil {
 		return
 	}
@@ -933,7 +1086,7 @@ func (vk *VK) UploadGroupImage(imageType string, file io.Reader) (response objec
 		return
 	}
 
-	response, err = vk.AppWidgetsSaveGroupImage(Params{
+	response, err = vk.AppWidgetsSaveGroupImageContext(ctx, Params{
 		"image": handler.Image,
 		"hash":  handler.Hash,
 	})
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -11,7 +13,12 @@ type UsersGetResponse []object.UsersUser
 //
 // https://vk.com/dev/users.get
 func (vk *VK) UsersGet(params Params) (response UsersGetResponse, err error) {
-	err = vk.RequestUnmarshal("users.get", params, &response)
+	return vk.UsersGetContext(context.Background(), params)
+}
+
+// UsersGetContext is the same as UsersGet, but takes a context.
+func (vk *VK) UsersGetContext(ctx context.Context, params Params) (response UsersGetResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "users.get", params, &response)
 	return
 }
 
@@ -27,8 +34,13 @@ type UsersGetFollowersResponse struct {
 //
 // https://vk.com/dev/users.getFollowers
 func (vk *VK) UsersGetFollowers(params Params) (response UsersGetFollowersResponse, err error) {
+	return vk.UsersGetFollowersContext(context.Background(), params)
+}
+
+// UsersGetFollowersContext is the same as UsersGetFollowers, but takes a context.
+func (vk *VK) UsersGetFollowersContext(ctx context.Context, params Params) (response UsersGetFollowersResponse, err error) {
 	params["fields"] = ""
-	err = vk.RequestUnmarshal("users.getFollowers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "users.getFollowers", params, &response)
 
 	return
 }
@@ -45,11 +57,16 @@ type UsersGetFollowersFieldsResponse struct {
 //
 // https://vk.com/dev/users.getFollowers
 func (vk *VK) UsersGetFollowersFields(params Params) (response UsersGetFollowersFieldsResponse, err error) {
+	return vk.UsersGetFollowersFieldsContext(context.Background(), params)
+}
+
+// UsersGetFollowersFieldsContext is the same as UsersGetFollowersFields, but takes a context.
+func (vk *VK) UsersGetFollowersFieldsContext(ctx context.Context, params Params) (response UsersGetFollowersFieldsResponse, err error) {
 	if v, prs := params["fields"]; v == "" || !prs {
 		params["fields"] = "id"
 	}
 
-	err = vk.RequestUnmarshal("users.getFollowers", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "users.getFollowers", params, &response)
 
 	return
 }
@@ -74,8 +91,13 @@ type UsersGetSubscriptionsResponse struct {
 //
 // BUG(SevereCloud): UsersGetSubscriptions bad response with extended=1.
 func (vk *VK) UsersGetSubscriptions(params Params) (response UsersGetSubscriptionsResponse, err error) {
+	return vk.UsersGetSubscriptionsContext(context.Background(), params)
+}
+
+// UsersGetSubscriptionsContext is the same as UsersGetSubscriptions, but takes a context.
+func (vk *VK) UsersGetSubscriptionsContext(ctx context.Context, params Params) (response UsersGetSubscriptionsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("users.getSubscriptions", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "users.getSubscriptions", params, &response)
 
 	return
 }
@@ -84,7 +106,12 @@ func (vk *VK) UsersGetSubscriptions(params Params) (response UsersGetSubscriptio
 //
 // https://vk.com/dev/users.report
 func (vk *VK) UsersReport(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("users.report", params, &response)
+	return vk.UsersReportContext(context.Background(), params)
+}
+
+// UsersReportContext is the same as UsersReport, but takes a context.
+func (vk *VK) UsersReportContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "users.report", params, &response)
 	return
 }
 
@@ -98,6 +125,11 @@ type UsersSearchResponse struct {
 //
 // https://vk.com/dev/users.search
 func (vk *VK) UsersSearch(params Params) (response UsersSearchResponse, err error) {
-	err = vk.RequestUnmarshal("users.search", params, &response)
+	return vk.UsersSearchContext(context.Background(), params)
+}
+
+// UsersSearchContext is the same as UsersSearch, but takes a context.
+func (vk *VK) UsersSearchContext(ctx context.Context, params Params) (response UsersSearchResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "users.search", params, &response)
 	return
 }
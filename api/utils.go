@@ -1,6 +1,7 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/SevereCloud/vksdk/object"
@@ -13,7 +14,12 @@ type UtilsCheckLinkResponse object.UtilsLinkChecked
 //
 // https://vk.com/dev/utils.checkLink
 func (vk *VK) UtilsCheckLink(params Params) (response UtilsCheckLinkResponse, err error) {
-	err = vk.RequestUnmarshal("utils.checkLink", params, &response)
+	return vk.UtilsCheckLinkContext(context.Background(), params)
+}
+
+// UtilsCheckLinkContext is the same as UtilsCheckLink, but takes a context.
+func (vk *VK) UtilsCheckLinkContext(ctx context.Context, params Params) (response UtilsCheckLinkResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "utils.checkLink", params, &response)
 	return
 }
 
@@ -21,7 +27,12 @@ func (vk *VK) UtilsCheckLink(params Params) (response UtilsCheckLinkResponse, er
 //
 // https://vk.com/dev/utils.deleteFromLastShortened
 func (vk *VK) UtilsDeleteFromLastShortened(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("utils.deleteFromLastShortened", params, &response)
+	return vk.UtilsDeleteFromLastShortenedContext(context.Background(), params)
+}
+
+// UtilsDeleteFromLastShortenedContext is the same as UtilsDeleteFromLastShortened, but takes a context.
+func (vk *VK) UtilsDeleteFromLastShortenedContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "utils.deleteFromLastShortened", params, &response)
 	return
 }
 
@@ -35,7 +46,12 @@ type UtilsGetLastShortenedLinksResponse struct {
 //
 // https://vk.com/dev/utils.getLastShortenedLinks
 func (vk *VK) UtilsGetLastShortenedLinks(params Params) (response UtilsGetLastShortenedLinksResponse, err error) {
-	err = vk.RequestUnmarshal("utils.getLastShortenedLinks", params, &response)
+	return vk.UtilsGetLastShortenedLinksContext(context.Background(), params)
+}
+
+// UtilsGetLastShortenedLinksContext is the same as UtilsGetLastShortenedLinks, but takes a context.
+func (vk *VK) UtilsGetLastShortenedLinksContext(ctx context.Context, params Params) (response UtilsGetLastShortenedLinksResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "utils.getLastShortenedLinks", params, &response)
 	return
 }
 
@@ -48,8 +64,13 @@ type UtilsGetLinkStatsResponse object.UtilsLinkStats
 //
 // https://vk.com/dev/utils.getLinkStats
 func (vk *VK) UtilsGetLinkStats(params Params) (response UtilsGetLinkStatsResponse, err error) {
+	return vk.UtilsGetLinkStatsContext(context.Background(), params)
+}
+
+// UtilsGetLinkStatsContext is the same as UtilsGetLinkStats, but takes a context.
+func (vk *VK) UtilsGetLinkStatsContext(ctx context.Context, params Params) (response UtilsGetLinkStatsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("utils.getLinkStats", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "utils.getLinkStats", params, &response)
 
 	return
 }
@@ -63,8 +84,13 @@ type UtilsGetLinkStatsExtendedResponse object.UtilsLinkStatsExtended
 //
 // https://vk.com/dev/utils.getLinkStats
 func (vk *VK) UtilsGetLinkStatsExtended(params Params) (response UtilsGetLinkStatsExtendedResponse, err error) {
+	return vk.UtilsGetLinkStatsExtendedContext(context.Background(), params)
+}
+
+// UtilsGetLinkStatsExtendedContext is the same as UtilsGetLinkStatsExtended, but takes a context.
+func (vk *VK) UtilsGetLinkStatsExtendedContext(ctx context.Context, params Params) (response UtilsGetLinkStatsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("utils.getLinkStats", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "utils.getLinkStats", params, &response)
 
 	return
 }
@@ -73,7 +99,12 @@ func (vk *VK) UtilsGetLinkStatsExtended(params Params) (response UtilsGetLinkSta
 //
 // https://vk.com/dev/utils.getServerTime
 func (vk *VK) UtilsGetServerTime(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("utils.getServerTime", params, &response)
+	return vk.UtilsGetServerTimeContext(context.Background(), params)
+}
+
+// UtilsGetServerTimeContext is the same as UtilsGetServerTime, but takes a context.
+func (vk *VK) UtilsGetServerTimeContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "utils.getServerTime", params, &response)
 	return
 }
 
@@ -84,7 +115,12 @@ type UtilsGetShortLinkResponse object.UtilsShortLink
 //
 // https://vk.com/dev/utils.getShortLink
 func (vk *VK) UtilsGetShortLink(params Params) (response UtilsGetShortLinkResponse, err error) {
-	err = vk.RequestUnmarshal("utils.getShortLink", params, &response)
+	return vk.UtilsGetShortLinkContext(context.Background(), params)
+}
+
+// UtilsGetShortLinkContext is the same as UtilsGetShortLink, but takes a context.
+func (vk *VK) UtilsGetShortLinkContext(ctx context.Context, params Params) (response UtilsGetShortLinkResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "utils.getShortLink", params, &response)
 	return
 }
 
@@ -95,7 +131,12 @@ type UtilsResolveScreenNameResponse object.UtilsDomainResolved
 //
 // https://vk.com/dev/utils.resolveScreenName
 func (vk *VK) UtilsResolveScreenName(params Params) (response UtilsResolveScreenNameResponse, err error) {
-	rawResponse, err := vk.Request("utils.resolveScreenName", params)
+	return vk.UtilsResolveScreenNameContext(context.Background(), params)
+}
+
+// UtilsResolveScreenNameContext is the same as UtilsResolveScreenName, but takes a context.
+func (vk *VK) UtilsResolveScreenNameContext(ctx context.Context, params Params) (response UtilsResolveScreenNameResponse, err error) {
+	rawResponse, err := vk.RequestContext(ctx, "utils.resolveScreenName", params)
 	// Если короткое имя screen_name не занято, то будет возвращён пустой объект.
 	if err != nil || string(rawResponse) == "[]" {
 		return
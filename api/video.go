@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/video.add
 func (vk *VK) VideoAdd(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.add", params, &response)
+	return vk.VideoAddContext(context.Background(), params)
+}
+
+// VideoAddContext is the same as VideoAdd, but takes a context.
+func (vk *VK) VideoAddContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.add", params, &response)
 	return
 }
 
@@ -21,7 +28,12 @@ type VideoAddAlbumResponse struct {
 //
 // https://vk.com/dev/video.addAlbum
 func (vk *VK) VideoAddAlbum(params Params) (response VideoAddAlbumResponse, err error) {
-	err = vk.RequestUnmarshal("video.addAlbum", params, &response)
+	return vk.VideoAddAlbumContext(context.Background(), params)
+}
+
+// VideoAddAlbumContext is the same as VideoAddAlbum, but takes a context.
+func (vk *VK) VideoAddAlbumContext(ctx context.Context, params Params) (response VideoAddAlbumResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.addAlbum", params, &response)
 	return
 }
 
@@ -29,7 +41,12 @@ func (vk *VK) VideoAddAlbum(params Params) (response VideoAddAlbumResponse, err
 //
 // https://vk.com/dev/video.addToAlbum
 func (vk *VK) VideoAddToAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.addToAlbum", params, &response)
+	return vk.VideoAddToAlbumContext(context.Background(), params)
+}
+
+// VideoAddToAlbumContext is the same as VideoAddToAlbum, but takes a context.
+func (vk *VK) VideoAddToAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.addToAlbum", params, &response)
 	return
 }
 
@@ -37,7 +54,12 @@ func (vk *VK) VideoAddToAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.createComment
 func (vk *VK) VideoCreateComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.createComment", params, &response)
+	return vk.VideoCreateCommentContext(context.Background(), params)
+}
+
+// VideoCreateCommentContext is the same as VideoCreateComment, but takes a context.
+func (vk *VK) VideoCreateCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.createComment", params, &response)
 	return
 }
 
@@ -45,7 +67,12 @@ func (vk *VK) VideoCreateComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.delete
 func (vk *VK) VideoDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.delete", params, &response)
+	return vk.VideoDeleteContext(context.Background(), params)
+}
+
+// VideoDeleteContext is the same as VideoDelete, but takes a context.
+func (vk *VK) VideoDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.delete", params, &response)
 	return
 }
 
@@ -53,7 +80,12 @@ func (vk *VK) VideoDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.deleteAlbum
 func (vk *VK) VideoDeleteAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.deleteAlbum", params, &response)
+	return vk.VideoDeleteAlbumContext(context.Background(), params)
+}
+
+// VideoDeleteAlbumContext is the same as VideoDeleteAlbum, but takes a context.
+func (vk *VK) VideoDeleteAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.deleteAlbum", params, &response)
 	return
 }
 
@@ -61,7 +93,12 @@ func (vk *VK) VideoDeleteAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.deleteComment
 func (vk *VK) VideoDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.deleteComment", params, &response)
+	return vk.VideoDeleteCommentContext(context.Background(), params)
+}
+
+// VideoDeleteCommentContext is the same as VideoDeleteComment, but takes a context.
+func (vk *VK) VideoDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.deleteComment", params, &response)
 	return
 }
 
@@ -69,7 +106,12 @@ func (vk *VK) VideoDeleteComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.edit
 func (vk *VK) VideoEdit(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.edit", params, &response)
+	return vk.VideoEditContext(context.Background(), params)
+}
+
+// VideoEditContext is the same as VideoEdit, but takes a context.
+func (vk *VK) VideoEditContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.edit", params, &response)
 	return
 }
 
@@ -77,7 +119,12 @@ func (vk *VK) VideoEdit(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.editAlbum
 func (vk *VK) VideoEditAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.editAlbum", params, &response)
+	return vk.VideoEditAlbumContext(context.Background(), params)
+}
+
+// VideoEditAlbumContext is the same as VideoEditAlbum, but takes a context.
+func (vk *VK) VideoEditAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.editAlbum", params, &response)
 	return
 }
 
@@ -85,7 +132,12 @@ func (vk *VK) VideoEditAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.editComment
 func (vk *VK) VideoEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.editComment", params, &response)
+	return vk.VideoEditCommentContext(context.Background(), params)
+}
+
+// VideoEditCommentContext is the same as VideoEditComment, but takes a context.
+func (vk *VK) VideoEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.editComment", params, &response)
 	return
 }
 
@@ -101,8 +153,13 @@ type VideoGetResponse struct {
 //
 // https://vk.com/dev/video.get
 func (vk *VK) VideoGet(params Params) (response VideoGetResponse, err error) {
+	return vk.VideoGetContext(context.Background(), params)
+}
+
+// VideoGetContext is the same as VideoGet, but takes a context.
+func (vk *VK) VideoGetContext(ctx context.Context, params Params) (response VideoGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("video.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.get", params, &response)
 
 	return
 }
@@ -120,8 +177,13 @@ type VideoGetExtendedResponse struct {
 //
 // https://vk.com/dev/video.get
 func (vk *VK) VideoGetExtended(params Params) (response VideoGetExtendedResponse, err error) {
+	return vk.VideoGetExtendedContext(context.Background(), params)
+}
+
+// VideoGetExtendedContext is the same as VideoGetExtended, but takes a context.
+func (vk *VK) VideoGetExtendedContext(ctx context.Context, params Params) (response VideoGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("video.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.get", params, &response)
 
 	return
 }
@@ -133,7 +195,12 @@ type VideoGetAlbumByIDResponse object.VideoVideoAlbumFull
 //
 // https://vk.com/dev/video.getAlbumById
 func (vk *VK) VideoGetAlbumByID(params Params) (response VideoGetAlbumByIDResponse, err error) {
-	err = vk.RequestUnmarshal("video.getAlbumById", params, &response)
+	return vk.VideoGetAlbumByIDContext(context.Background(), params)
+}
+
+// VideoGetAlbumByIDContext is the same as VideoGetAlbumByID, but takes a context.
+func (vk *VK) VideoGetAlbumByIDContext(ctx context.Context, params Params) (response VideoGetAlbumByIDResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.getAlbumById", params, &response)
 	return
 }
 
@@ -149,8 +216,13 @@ type VideoGetAlbumsResponse struct {
 //
 // https://vk.com/dev/video.getAlbums
 func (vk *VK) VideoGetAlbums(params Params) (response VideoGetAlbumsResponse, err error) {
+	return vk.VideoGetAlbumsContext(context.Background(), params)
+}
+
+// VideoGetAlbumsContext is the same as VideoGetAlbums, but takes a context.
+func (vk *VK) VideoGetAlbumsContext(ctx context.Context, params Params) (response VideoGetAlbumsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("video.getAlbums", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getAlbums", params, &response)
 
 	return
 }
@@ -167,8 +239,13 @@ type VideoGetAlbumsExtendedResponse struct {
 //
 // https://vk.com/dev/video.getAlbums
 func (vk *VK) VideoGetAlbumsExtended(params Params) (response VideoGetAlbumsExtendedResponse, err error) {
+	return vk.VideoGetAlbumsExtendedContext(context.Background(), params)
+}
+
+// VideoGetAlbumsExtendedContext is the same as VideoGetAlbumsExtended, but takes a context.
+func (vk *VK) VideoGetAlbumsExtendedContext(ctx context.Context, params Params) (response VideoGetAlbumsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("video.getAlbums", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getAlbums", params, &response)
 
 	return
 }
@@ -182,8 +259,13 @@ type VideoGetAlbumsByVideoResponse []int
 //
 // https://vk.com/dev/video.getAlbumsByVideo
 func (vk *VK) VideoGetAlbumsByVideo(params Params) (response VideoGetAlbumsByVideoResponse, err error) {
+	return vk.VideoGetAlbumsByVideoContext(context.Background(), params)
+}
+
+// VideoGetAlbumsByVideoContext is the same as VideoGetAlbumsByVideo, but takes a context.
+func (vk *VK) VideoGetAlbumsByVideoContext(ctx context.Context, params Params) (response VideoGetAlbumsByVideoResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("video.getAlbumsByVideo", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getAlbumsByVideo", params, &response)
 
 	return
 }
@@ -200,8 +282,13 @@ type VideoGetAlbumsByVideoExtendedResponse struct {
 //
 // https://vk.com/dev/video.getAlbumsByVideo
 func (vk *VK) VideoGetAlbumsByVideoExtended(params Params) (response VideoGetAlbumsByVideoExtendedResponse, err error) {
+	return vk.VideoGetAlbumsByVideoExtendedContext(context.Background(), params)
+}
+
+// VideoGetAlbumsByVideoExtendedContext is the same as VideoGetAlbumsByVideoExtended, but takes a context.
+func (vk *VK) VideoGetAlbumsByVideoExtendedContext(ctx context.Context, params Params) (response VideoGetAlbumsByVideoExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("video.getAlbumsByVideo", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getAlbumsByVideo", params, &response)
 
 	return
 }
@@ -218,8 +305,13 @@ type VideoGetCommentsResponse struct {
 //
 // https://vk.com/dev/video.getComments
 func (vk *VK) VideoGetComments(params Params) (response VideoGetCommentsResponse, err error) {
+	return vk.VideoGetCommentsContext(context.Background(), params)
+}
+
+// VideoGetCommentsContext is the same as VideoGetComments, but takes a context.
+func (vk *VK) VideoGetCommentsContext(ctx context.Context, params Params) (response VideoGetCommentsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("video.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getComments", params, &response)
 
 	return
 }
@@ -237,8 +329,13 @@ type VideoGetCommentsExtendedResponse struct {
 //
 // https://vk.com/dev/video.getComments
 func (vk *VK) VideoGetCommentsExtended(params Params) (response VideoGetCommentsExtendedResponse, err error) {
+	return vk.VideoGetCommentsExtendedContext(context.Background(), params)
+}
+
+// VideoGetCommentsExtendedContext is the same as VideoGetCommentsExtended, but takes a context.
+func (vk *VK) VideoGetCommentsExtendedContext(ctx context.Context, params Params) (response VideoGetCommentsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("video.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.getComments", params, &response)
 
 	return
 }
@@ -247,7 +344,12 @@ func (vk *VK) VideoGetCommentsExtended(params Params) (response VideoGetComments
 //
 // https://vk.com/dev/video.removeFromAlbum
 func (vk *VK) VideoRemoveFromAlbum(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.removeFromAlbum", params, &response)
+	return vk.VideoRemoveFromAlbumContext(context.Background(), params)
+}
+
+// VideoRemoveFromAlbumContext is the same as VideoRemoveFromAlbum, but takes a context.
+func (vk *VK) VideoRemoveFromAlbumContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.removeFromAlbum", params, &response)
 	return
 }
 
@@ -255,7 +357,12 @@ func (vk *VK) VideoRemoveFromAlbum(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.reorderAlbums
 func (vk *VK) VideoReorderAlbums(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.reorderAlbums", params, &response)
+	return vk.VideoReorderAlbumsContext(context.Background(), params)
+}
+
+// VideoReorderAlbumsContext is the same as VideoReorderAlbums, but takes a context.
+func (vk *VK) VideoReorderAlbumsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.reorderAlbums", params, &response)
 	return
 }
 
@@ -263,7 +370,12 @@ func (vk *VK) VideoReorderAlbums(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.reorderVideos
 func (vk *VK) VideoReorderVideos(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.reorderVideos", params, &response)
+	return vk.VideoReorderVideosContext(context.Background(), params)
+}
+
+// VideoReorderVideosContext is the same as VideoReorderVideos, but takes a context.
+func (vk *VK) VideoReorderVideosContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.reorderVideos", params, &response)
 	return
 }
 
@@ -271,7 +383,12 @@ func (vk *VK) VideoReorderVideos(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.report
 func (vk *VK) VideoReport(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.report", params, &response)
+	return vk.VideoReportContext(context.Background(), params)
+}
+
+// VideoReportContext is the same as VideoReport, but takes a context.
+func (vk *VK) VideoReportContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.report", params, &response)
 	return
 }
 
@@ -279,7 +396,12 @@ func (vk *VK) VideoReport(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.reportComment
 func (vk *VK) VideoReportComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.reportComment", params, &response)
+	return vk.VideoReportCommentContext(context.Background(), params)
+}
+
+// VideoReportCommentContext is the same as VideoReportComment, but takes a context.
+func (vk *VK) VideoReportCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.reportComment", params, &response)
 	return
 }
 
@@ -287,7 +409,12 @@ func (vk *VK) VideoReportComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.restore
 func (vk *VK) VideoRestore(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.restore", params, &response)
+	return vk.VideoRestoreContext(context.Background(), params)
+}
+
+// VideoRestoreContext is the same as VideoRestore, but takes a context.
+func (vk *VK) VideoRestoreContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.restore", params, &response)
 	return
 }
 
@@ -295,7 +422,12 @@ func (vk *VK) VideoRestore(params Params) (response int, err error) {
 //
 // https://vk.com/dev/video.restoreComment
 func (vk *VK) VideoRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("video.restoreComment", params, &response)
+	return vk.VideoRestoreCommentContext(context.Background(), params)
+}
+
+// VideoRestoreCommentContext is the same as VideoRestoreComment, but takes a context.
+func (vk *VK) VideoRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.restoreComment", params, &response)
 	return
 }
 
@@ -306,7 +438,12 @@ type VideoSaveResponse object.VideoSaveResult
 //
 // https://vk.com/dev/video.save
 func (vk *VK) VideoSave(params Params) (response VideoSaveResponse, err error) {
-	err = vk.RequestUnmarshal("video.save", params, &response)
+	return vk.VideoSaveContext(context.Background(), params)
+}
+
+// VideoSaveContext is the same as VideoSave, but takes a context.
+func (vk *VK) VideoSaveContext(ctx context.Context, params Params) (response VideoSaveResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "video.save", params, &response)
 	return
 }
 
@@ -322,8 +459,13 @@ type VideoSearchResponse struct {
 //
 // https://vk.com/dev/video.search
 func (vk *VK) VideoSearch(params Params) (response VideoSearchResponse, err error) {
+	return vk.VideoSearchContext(context.Background(), params)
+}
+
+// VideoSearchContext is the same as VideoSearch, but takes a context.
+func (vk *VK) VideoSearchContext(ctx context.Context, params Params) (response VideoSearchResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("video.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.search", params, &response)
 
 	return
 }
@@ -341,8 +483,13 @@ type VideoSearchExtendedResponse struct {
 //
 // https://vk.com/dev/video.search
 func (vk *VK) VideoSearchExtended(params Params) (response VideoSearchExtendedResponse, err error) {
+	return vk.VideoSearchExtendedContext(context.Background(), params)
+}
+
+// VideoSearchExtendedContext is the same as VideoSearchExtended, but takes a context.
+func (vk *VK) VideoSearchExtendedContext(ctx context.Context, params Params) (response VideoSearchExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("video.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "video.search", params, &response)
 
 	return
 }
@@ -0,0 +1,97 @@
+//go:build ignore
+// +build ignore
+
+// gen_methods generates methods.go from API methods requested by the api
+// package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, "..", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	methods := make(map[string]bool)
+
+	for _, file := range pkgs["api"].Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if method, ok := requestMethod(n); ok {
+				methods[method] = true
+			}
+
+			return true
+		})
+	}
+
+	names := make([]string, 0, len(methods))
+	for method := range methods {
+		names = append(names, method)
+	}
+
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by gen_methods.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package vkscript // import \"github.com/SevereCloud/vksdk/api/vkscript\"\n\n")
+	buf.WriteString("// knownMethods returns API methods implemented by the api package.\n")
+	buf.WriteString("func knownMethods() map[string]bool {\n\treturn map[string]bool{\n")
+
+	for _, method := range names {
+		fmt.Fprintf(&buf, "\t\t%q: true,\n", method)
+	}
+
+	buf.WriteString("\t}\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile("methods.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// requestMethod returns the name of the API method if n is a call of
+// vk.RequestUnmarshalContext or vk.RequestContext with a string literal.
+func requestMethod(n ast.Node) (string, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) < 3 {
+		return "", false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "RequestUnmarshalContext" && sel.Sel.Name != "RequestContext") {
+		return "", false
+	}
+
+	lit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	method, err := strconv.Unquote(lit.Value)
+
+	return method, err == nil && strings.Contains(method, ".")
+}
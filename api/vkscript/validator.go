@@ -0,0 +1,559 @@
+package vkscript // import "github.com/SevereCloud/vksdk/api/vkscript"
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/SevereCloud/vksdk/internal"
+)
+
+//go:generate go run gen_methods.go
+
+// Error is a VKScript validation error.
+type Error struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+// Error returns the message of Error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("vkscript: %d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// ErrorList is a list of validation errors.
+type ErrorList []*Error
+
+// Error returns the message of the first error.
+func (list ErrorList) Error() string {
+	switch len(list) {
+	case 0:
+		return "vkscript: no errors"
+	case 1:
+		return list[0].Error()
+	}
+
+	return fmt.Sprintf("%s (and %d more errors)", list[0], len(list)-1)
+}
+
+// Validator checks VKScript code without sending it to VK.
+type Validator struct {
+	// Methods are allowed API methods. If nil, methods implemented by
+	// the api package are allowed.
+	Methods map[string]bool
+
+	// MaxCalls is the maximum number of API calls. If zero, MaxCalls
+	// is used.
+	MaxCalls int
+}
+
+// Validate checks syntax, the number of API calls, API method names
+// and declaration of variables.
+//
+// Calls inside loops are counted once, so the number of calls is a lower
+// bound and a loop can still exceed MaxCalls at run time.
+func Validate(code string) error {
+	var v Validator
+	return v.Validate(code)
+}
+
+// Validate checks syntax, the number of API calls, API method names
+// and declaration of variables.
+func (v *Validator) Validate(code string) error {
+	methods := v.Methods
+	if methods == nil {
+		methods = knownMethods()
+	}
+
+	p := &parser{
+		methods: methods,
+		vars: map[string]bool{
+			"API":         true,
+			"Args":        true,
+			"parseInt":    true,
+			"parseDouble": true,
+		},
+	}
+
+	p.tokens, p.errors = scan(code)
+	if len(p.errors) == 0 {
+		p.parseProgram()
+	}
+
+	maxCalls := v.MaxCalls
+	if maxCalls == 0 {
+		maxCalls = MaxCalls
+	}
+
+	if p.calls > maxCalls {
+		p.errors = append(p.errors, &Error{
+			Line:   1,
+			Column: 1,
+			Msg:    fmt.Sprintf("too many API calls: %d, maximum is %d", p.calls, maxCalls),
+		})
+	}
+
+	if len(p.errors) > 0 {
+		return p.errors
+	}
+
+	return nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenPunct
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+// punctuation sorted by length, so longer operators are matched first.
+func punctuation() []string {
+	return []string{
+		"@.", "==", "!=", "<=", ">=", "&&", "||", "+=", "-=", "*=", "/=",
+		"{", "}", "(", ")", "[", "]", ",", ";", ".", ":", "=", "<", ">",
+		"+", "-", "*", "/", "%", "!",
+	}
+}
+
+func scan(code string) ([]token, ErrorList) {
+	var (
+		tokens []token
+		errs   ErrorList
+	)
+
+	src := []rune(code)
+	line, column := 1, 1
+
+	advance := func(n int) {
+		for i := 0; i < n; i++ {
+			if src[0] == '\n' {
+				line++
+				column = 1
+			} else {
+				column++
+			}
+
+			src = src[1:]
+		}
+	}
+
+	puncts := punctuation()
+
+	for len(src) > 0 {
+		r := src[0]
+		tok := token{line: line, column: column}
+
+		switch {
+		case unicode.IsSpace(r):
+			advance(1)
+			continue
+		case r == '/' && len(src) > 1 && src[1] == '/':
+			for len(src) > 0 && src[0] != '\n' {
+				advance(1)
+			}
+
+			continue
+		case r == '/' && len(src) > 1 && src[1] == '*':
+			end := strings.Index(string(src[2:]), "*/")
+			if end < 0 {
+				errs = append(errs, &Error{line, column, "comment not terminated"})
+				return tokens, errs
+			}
+
+			advance(len([]rune(string(src[2:])[:end])) + 4)
+
+			continue
+		case r == '_' || r == '$' || unicode.IsLetter(r):
+			n := 1
+			for n < len(src) && (src[n] == '_' || src[n] == '$' || unicode.IsLetter(src[n]) || unicode.IsDigit(src[n])) {
+				n++
+			}
+
+			tok.kind = tokenIdent
+			tok.text = string(src[:n])
+			advance(n)
+		case unicode.IsDigit(r):
+			n := 1
+			for n < len(src) && (unicode.IsDigit(src[n]) || src[n] == '.') {
+				n++
+			}
+
+			tok.kind = tokenNumber
+			tok.text = string(src[:n])
+			advance(n)
+		case r == '"' || r == '\'':
+			n := 1
+			for n < len(src) && src[n] != r && src[n] != '\n' {
+				if src[n] == '\\' {
+					n++
+				}
+
+				n++
+			}
+
+			if n >= len(src) || src[n] != r {
+				errs = append(errs, &Error{line, column, "string literal not terminated"})
+				return tokens, errs
+			}
+
+			tok.kind = tokenString
+			tok.text = string(src[:n+1])
+			advance(n + 1)
+		default:
+			for _, p := range puncts {
+				if strings.HasPrefix(string(src), p) {
+					tok.kind = tokenPunct
+					tok.text = p
+
+					break
+				}
+			}
+
+			if tok.kind != tokenPunct {
+				errs = append(errs, &Error{line, column, fmt.Sprintf("unexpected character %q", r)})
+				return tokens, errs
+			}
+
+			advance(len(tok.text))
+		}
+
+		tokens = append(tokens, tok)
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, text: "EOF", line: line, column: column})
+
+	return tokens, errs
+}
+
+// bailout is used to stop parsing on a syntax error.
+type bailout struct{}
+
+type parser struct {
+	methods map[string]bool
+	tokens  []token
+	pos     int
+	calls   int
+	vars    map[string]bool
+	errors  ErrorList
+}
+
+func (p *parser) tok() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) is(text string) bool {
+	tok := p.tok()
+	return (tok.kind == tokenPunct || tok.kind == tokenIdent) && tok.text == text
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) {
+	p.errors = append(p.errors, &Error{tok.line, tok.column, fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) fail(format string, args ...interface{}) {
+	p.errorf(p.tok(), format, args...)
+	panic(bailout{})
+}
+
+func (p *parser) expect(text string) {
+	if !p.is(text) {
+		p.fail("expected %q, found %q", text, p.tok().text)
+	}
+
+	p.next()
+}
+
+func (p *parser) ident() token {
+	tok := p.tok()
+	if tok.kind != tokenIdent {
+		p.fail("expected identifier, found %q", tok.text)
+	}
+
+	return p.next()
+}
+
+func (p *parser) parseProgram() {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(bailout); !ok {
+				panic(r)
+			}
+		}
+	}()
+
+	for p.tok().kind != tokenEOF {
+		p.parseStatement()
+	}
+}
+
+// semicolon expects a semicolon which is optional before } and EOF.
+func (p *parser) semicolon() {
+	if p.is("}") || p.tok().kind == tokenEOF {
+		return
+	}
+
+	p.expect(";")
+}
+
+func (p *parser) parseStatement() {
+	switch {
+	case p.is(";"):
+		p.next()
+	case p.is("{"):
+		p.next()
+
+		for !p.is("}") {
+			if p.tok().kind == tokenEOF {
+				p.fail("expected %q, found EOF", "}")
+			}
+
+			p.parseStatement()
+		}
+
+		p.next()
+	case p.is("var"):
+		p.next()
+
+		for {
+			name := p.ident()
+			if p.is("=") {
+				p.next()
+				p.parseExpr()
+			}
+
+			p.vars[name.text] = true
+
+			if !p.is(",") {
+				break
+			}
+
+			p.next()
+		}
+
+		p.semicolon()
+	case p.is("if"):
+		p.next()
+		p.expect("(")
+		p.parseExpr()
+		p.expect(")")
+		p.parseStatement()
+
+		if p.is("else") {
+			p.next()
+			p.parseStatement()
+		}
+	case p.is("while"):
+		p.next()
+		p.expect("(")
+		p.parseExpr()
+		p.expect(")")
+		p.parseStatement()
+	case p.is("return"):
+		p.next()
+
+		if !p.is(";") && !p.is("}") && p.tok().kind != tokenEOF {
+			p.parseExpr()
+		}
+
+		p.semicolon()
+	default:
+		p.parseExpr()
+
+		switch {
+		case p.is("="), p.is("+="), p.is("-="), p.is("*="), p.is("/="):
+			p.next()
+			p.parseExpr()
+		}
+
+		p.semicolon()
+	}
+}
+
+// binaryLevels are binary operators by precedence from low to high.
+func binaryLevels() [][]string {
+	return [][]string{
+		{"||"},
+		{"&&"},
+		{"==", "!="},
+		{"<", ">", "<=", ">="},
+		{"+", "-"},
+		{"*", "/", "%"},
+	}
+}
+
+func (p *parser) parseExpr() {
+	p.parseBinary(binaryLevels())
+}
+
+func (p *parser) parseBinary(levels [][]string) {
+	if len(levels) == 0 {
+		p.parseUnary()
+		return
+	}
+
+	p.parseBinary(levels[1:])
+
+	for p.tok().kind == tokenPunct && internal.Contains(levels[0], p.tok().text) {
+		p.next()
+		p.parseBinary(levels[1:])
+	}
+}
+
+func (p *parser) parseUnary() {
+	if p.is("!") || p.is("-") || p.is("+") {
+		p.next()
+		p.parseUnary()
+
+		return
+	}
+
+	p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() {
+	start := p.tok()
+	isAPI := p.parsePrimary()
+
+	var path []string
+
+	for {
+		switch {
+		case p.is("."), p.is("@."):
+			p.next()
+			name := p.ident()
+
+			if isAPI {
+				path = append(path, name.text)
+			}
+		case p.is("["):
+			p.next()
+			p.parseExpr()
+			p.expect("]")
+
+			isAPI = false
+		case p.is("("):
+			if isAPI {
+				p.checkMethod(start, path)
+				isAPI = false
+			}
+
+			p.next()
+
+			for !p.is(")") {
+				p.parseExpr()
+
+				if !p.is(",") {
+					break
+				}
+
+				p.next()
+			}
+
+			p.expect(")")
+		default:
+			if isAPI {
+				p.errorf(start, "API must be called as API.section.method(params)")
+			}
+
+			return
+		}
+	}
+}
+
+func (p *parser) checkMethod(tok token, path []string) {
+	p.calls++
+
+	if len(path) != 2 {
+		p.errorf(tok, "API must be called as API.section.method(params)")
+		return
+	}
+
+	method := strings.Join(path, ".")
+	if !p.methods[method] {
+		p.errorf(tok, "unknown API method %q", method)
+	}
+}
+
+// parsePrimary parses a primary expression and reports whether it is API.
+func (p *parser) parsePrimary() bool {
+	tok := p.tok()
+
+	switch {
+	case tok.kind == tokenNumber, tok.kind == tokenString:
+		p.next()
+	case p.is("true"), p.is("false"), p.is("null"):
+		p.next()
+	case tok.kind == tokenIdent:
+		p.next()
+
+		if !p.vars[tok.text] {
+			p.errorf(tok, "undefined: %s", tok.text)
+		}
+
+		return tok.text == "API"
+	case p.is("("):
+		p.next()
+		p.parseExpr()
+		p.expect(")")
+	case p.is("["):
+		p.next()
+
+		for !p.is("]") {
+			p.parseExpr()
+
+			if !p.is(",") {
+				break
+			}
+
+			p.next()
+		}
+
+		p.expect("]")
+	case p.is("{"):
+		p.next()
+
+		for !p.is("}") {
+			key := p.next()
+			if key.kind != tokenIdent && key.kind != tokenString && key.kind != tokenNumber {
+				p.errorf(key, "expected object key, found %q", key.text)
+				panic(bailout{})
+			}
+
+			p.expect(":")
+			p.parseExpr()
+
+			if !p.is(",") {
+				break
+			}
+
+			p.next()
+		}
+
+		p.expect("}")
+	default:
+		p.fail("unexpected %q", tok.text)
+	}
+
+	return false
+}
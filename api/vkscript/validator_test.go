@@ -0,0 +1,115 @@
+package vkscript_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/api/vkscript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	f := func(code string, wantErr string) {
+		t.Helper()
+
+		err := vkscript.Validate(code)
+		if wantErr == "" {
+			assert.NoError(t, err)
+			return
+		}
+
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), wantErr)
+		}
+	}
+
+	f(`return 1;`, "")
+	f(`return`, "")
+	f(`var a = API.users.get({user_ids: Args.id}); return a[0].id;`, "")
+	f(`var a = 1, b; b = a + 1; return {"a": a, 'b': b};`, "")
+	f(`var a = []; a.push(1); return a@.id;`, "")
+	f(`// comment
+	var i = 0; /* multiline
+	comment */
+	while (i < 10) { i = i + 1; }
+	if (i == 10) return true; else { return false }`, "")
+	f(`return !(-1 >= +2) && 3 % 2 != 0 || parseInt("1") <= parseDouble("2.5");`, "")
+
+	f(`return 1`+"\n"+`return 2;`, `2:1: expected ";"`)
+	f(`var a = ;`, `unexpected ";"`)
+	f(`return "abc`, "string literal not terminated")
+	f(`/* abc`, "comment not terminated")
+	f(`return #;`, "unexpected character")
+	f(`return [1, 2;`, `expected "]"`)
+	f(`if (true) {`, `expected "}", found EOF`)
+	f(`return {1 + 2: 3};`, `expected ":"`)
+	f(`return b;`, "undefined: b")
+	f(`return API.users.gett({});`, `unknown API method "users.gett"`)
+	f(`return API.users({});`, "API must be called as API.section.method(params)")
+	f(`return API.users.get;`, "API must be called as API.section.method(params)")
+	f(strings.Repeat("API.users.get({});", 26), "too many API calls: 26, maximum is 25")
+}
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	v := vkscript.Validator{
+		Methods:  map[string]bool{"ads.getAccounts": true},
+		MaxCalls: 1,
+	}
+
+	assert.NoError(t, v.Validate(`return API.ads.getAccounts({});`))
+
+	err := v.Validate(`API.users.get({}); API.ads.getAccounts({});`)
+	assert.Len(t, err, 2)
+	assert.Equal(t, `vkscript: 1:1: unknown API method "users.get" (and 1 more errors)`, err.Error())
+}
+
+// TestValidate_methods checks that all methods of the api package are known,
+// i.e. methods.go is regenerated with go generate.
+func TestValidate_methods(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("../*.go")
+	assert.NoError(t, err)
+
+	fset := token.NewFileSet()
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		assert.NoError(t, err)
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (sel.Sel.Name != "RequestUnmarshalContext" && sel.Sel.Name != "RequestContext") {
+				return true
+			}
+
+			lit, ok := call.Args[1].(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+
+			method, _ := strconv.Unquote(lit.Value)
+			assert.NoError(t, vkscript.Validate("API."+method+"({});"), method)
+
+			return true
+		})
+	}
+}
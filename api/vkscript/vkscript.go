@@ -0,0 +1,359 @@
+/*
+Package vkscript implements a builder and a static validator of VKScript,
+the language of the execute method.
+
+	p := vkscript.New()
+	users := p.Var("users", vkscript.API("users.get", vkscript.Params{
+		"user_ids": vkscript.Args("user_ids"),
+	}))
+	p.Return(users.Pluck("first_name"))
+
+	err := p.Validate()
+	err = vk.ExecuteWithArgs(p.String(), api.Params{"user_ids": "1,2"}, &names)
+
+See more https://vk.com/dev/execute
+*/
+package vkscript // import "github.com/SevereCloud/vksdk/api/vkscript"
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxCalls is the maximum number of API calls in one execute.
+const MaxCalls = 25
+
+// Expr is a VKScript expression.
+type Expr struct {
+	code  string
+	calls int
+}
+
+// Params is a set of API method parameters. Values can be Expr or
+// Go values accepted by Value.
+type Params map[string]interface{}
+
+// String returns the code of the expression.
+func (e Expr) String() string {
+	return e.code
+}
+
+// Raw returns an expression with the code as is.
+//
+// API calls in the code are not counted by Program.Calls.
+func Raw(code string) Expr {
+	return Expr{code: code}
+}
+
+// Ident returns a reference to the variable.
+func Ident(name string) Expr {
+	return Expr{code: name}
+}
+
+// Args returns a parameter passed to execute.
+func Args(name string) Expr {
+	return Expr{code: "Args." + name}
+}
+
+// Value returns a literal of the Go value.
+//
+// Expr is returned as is, slices and maps are converted to arrays and
+// objects, other values are encoded as JSON.
+func Value(v interface{}) Expr {
+	switch v := v.(type) {
+	case Expr:
+		return v
+	case []Expr:
+		return Array(v...)
+	case []interface{}:
+		items := make([]Expr, len(v))
+		for i, item := range v {
+			items[i] = Value(item)
+		}
+
+		return Array(items...)
+	case Params:
+		return Object(v)
+	case map[string]interface{}:
+		return Object(v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return Expr{code: strconv.Quote(fmt.Sprint(v))}
+	}
+
+	return Expr{code: string(b)}
+}
+
+// Array returns an array literal.
+func Array(items ...Expr) Expr {
+	var e Expr
+
+	codes := make([]string, len(items))
+	for i, item := range items {
+		codes[i] = item.code
+		e.calls += item.calls
+	}
+
+	e.code = "[" + strings.Join(codes, ", ") + "]"
+
+	return e
+}
+
+// Object returns an object literal. Fields are sorted by name.
+func Object(fields map[string]interface{}) Expr {
+	var e Expr
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	codes := make([]string, len(keys))
+
+	for i, key := range keys {
+		value := Value(fields[key])
+		codes[i] = strconv.Quote(key) + ": " + value.code
+		e.calls += value.calls
+	}
+
+	e.code = "{" + strings.Join(codes, ", ") + "}"
+
+	return e
+}
+
+// API returns a call of the API method.
+func API(method string, params Params) Expr {
+	e := Object(params)
+	e.code = "API." + method + "(" + e.code + ")"
+	e.calls++
+
+	return e
+}
+
+// Func returns a call of the global function, e.g. parseInt.
+func Func(name string, args ...interface{}) Expr {
+	return call(Expr{code: name}, args)
+}
+
+// ParseInt returns parseInt(v).
+func ParseInt(v interface{}) Expr {
+	return Func("parseInt", v)
+}
+
+// ParseDouble returns parseDouble(v).
+func ParseDouble(v interface{}) Expr {
+	return Func("parseDouble", v)
+}
+
+// Not returns !e.
+func Not(e Expr) Expr {
+	return Expr{code: "!" + e.code, calls: e.calls}
+}
+
+func call(fn Expr, args []interface{}) Expr {
+	e := fn
+
+	codes := make([]string, len(args))
+
+	for i, arg := range args {
+		value := Value(arg)
+		codes[i] = value.code
+		e.calls += value.calls
+	}
+
+	e.code += "(" + strings.Join(codes, ", ") + ")"
+
+	return e
+}
+
+func (e Expr) binary(op string, v interface{}) Expr {
+	value := Value(v)
+
+	return Expr{
+		code:  "(" + e.code + " " + op + " " + value.code + ")",
+		calls: e.calls + value.calls,
+	}
+}
+
+// Field returns e.name.
+func (e Expr) Field(name string) Expr {
+	return Expr{code: e.code + "." + name, calls: e.calls}
+}
+
+// Index returns e[i].
+func (e Expr) Index(i interface{}) Expr {
+	index := Value(i)
+
+	return Expr{
+		code:  e.code + "[" + index.code + "]",
+		calls: e.calls + index.calls,
+	}
+}
+
+// Pluck returns e@.name, an array of the field values of e items.
+func (e Expr) Pluck(name string) Expr {
+	return Expr{code: e.code + "@." + name, calls: e.calls}
+}
+
+// Len returns e.length.
+func (e Expr) Len() Expr {
+	return e.Field("length")
+}
+
+// Method returns a call of the method of e.
+func (e Expr) Method(name string, args ...interface{}) Expr {
+	return call(e.Field(name), args)
+}
+
+// Push returns e.push(v).
+func (e Expr) Push(v interface{}) Expr {
+	return e.Method("push", v)
+}
+
+// Add returns (e + v).
+func (e Expr) Add(v interface{}) Expr { return e.binary("+", v) }
+
+// Sub returns (e - v).
+func (e Expr) Sub(v interface{}) Expr { return e.binary("-", v) }
+
+// Mul returns (e * v).
+func (e Expr) Mul(v interface{}) Expr { return e.binary("*", v) }
+
+// Div returns (e / v).
+func (e Expr) Div(v interface{}) Expr { return e.binary("/", v) }
+
+// Mod returns (e % v).
+func (e Expr) Mod(v interface{}) Expr { return e.binary("%", v) }
+
+// Eq returns (e == v).
+func (e Expr) Eq(v interface{}) Expr { return e.binary("==", v) }
+
+// Ne returns (e != v).
+func (e Expr) Ne(v interface{}) Expr { return e.binary("!=", v) }
+
+// Lt returns (e < v).
+func (e Expr) Lt(v interface{}) Expr { return e.binary("<", v) }
+
+// Le returns (e <= v).
+func (e Expr) Le(v interface{}) Expr { return e.binary("<=", v) }
+
+// Gt returns (e > v).
+func (e Expr) Gt(v interface{}) Expr { return e.binary(">", v) }
+
+// Ge returns (e >= v).
+func (e Expr) Ge(v interface{}) Expr { return e.binary(">=", v) }
+
+// And returns (e && v).
+func (e Expr) And(v interface{}) Expr { return e.binary("&&", v) }
+
+// Or returns (e || v).
+func (e Expr) Or(v interface{}) Expr { return e.binary("||", v) }
+
+// Block is a list of statements.
+type Block struct {
+	lines []string
+	calls int
+}
+
+func (b *Block) add(stmt string, calls int) {
+	b.lines = append(b.lines, stmt)
+	b.calls += calls
+}
+
+func (b *Block) addBlock(header string, body func(*Block)) {
+	var inner Block
+
+	if body != nil {
+		body(&inner)
+	}
+
+	b.add(header+" {", inner.calls)
+
+	for _, line := range inner.lines {
+		b.lines = append(b.lines, "\t"+line)
+	}
+
+	b.lines = append(b.lines, "}")
+}
+
+// Var declares the variable and returns a reference to it.
+func (b *Block) Var(name string, value interface{}) Expr {
+	e := Value(value)
+	b.add("var "+name+" = "+e.code+";", e.calls)
+
+	return Ident(name)
+}
+
+// Set assigns the value to the target.
+func (b *Block) Set(target Expr, value interface{}) {
+	e := Value(value)
+	b.add(target.code+" = "+e.code+";", target.calls+e.calls)
+}
+
+// Do adds the expression statement, e.g. a call.
+func (b *Block) Do(e Expr) {
+	b.add(e.code+";", e.calls)
+}
+
+// If adds the if statement. els can be nil.
+func (b *Block) If(cond Expr, then, els func(*Block)) {
+	b.addBlock("if ("+cond.code+")", then)
+	b.calls += cond.calls
+
+	if els != nil {
+		last := len(b.lines) - 1
+		b.lines = b.lines[:last]
+		b.addBlock("} else", els)
+	}
+}
+
+// While adds the while loop.
+//
+// API calls in the body are counted once by Program.Calls, because the
+// number of iterations is unknown. The program can exceed MaxCalls at
+// run time even if it passes Validate.
+func (b *Block) While(cond Expr, body func(*Block)) {
+	b.addBlock("while ("+cond.code+")", body)
+	b.calls += cond.calls
+}
+
+// Return adds the return statement.
+func (b *Block) Return(value interface{}) {
+	e := Value(value)
+	b.add("return "+e.code+";", e.calls)
+}
+
+// Program is a VKScript program.
+type Program struct {
+	Block
+}
+
+// New returns a new Program.
+func New() *Program {
+	return &Program{}
+}
+
+// String returns the code of the program.
+func (p *Program) String() string {
+	return strings.Join(p.lines, "\n")
+}
+
+// Calls returns the number of API calls in the program.
+//
+// Calls inside loops are counted once, so it is a lower bound of
+// the number of calls at run time.
+func (p *Program) Calls() int {
+	return p.calls
+}
+
+// Validate checks the program with Validate.
+func (p *Program) Validate() error {
+	return Validate(p.String())
+}
@@ -0,0 +1,87 @@
+package vkscript_test
+
+import (
+	"testing"
+
+	"github.com/SevereCloud/vksdk/api/vkscript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgram(t *testing.T) {
+	t.Parallel()
+
+	p := vkscript.New()
+	users := p.Var("users", vkscript.API("users.get", vkscript.Params{
+		"user_ids": vkscript.Args("user_ids"),
+		"fields":   "photo_100",
+	}))
+	result := p.Var("result", []interface{}{})
+	i := p.Var("i", 0)
+
+	p.While(i.Lt(users.Len()), func(b *vkscript.Block) {
+		b.If(users.Index(i).Field("photo_100").Ne(nil), func(b *vkscript.Block) {
+			b.Do(result.Push(users.Index(i).Field("id")))
+		}, func(b *vkscript.Block) {
+			b.Do(result.Push(vkscript.ParseInt("0")))
+		})
+		b.Set(i, i.Add(1))
+	})
+	p.Return(vkscript.Params{
+		"names":  users.Pluck("first_name"),
+		"result": result,
+		"count":  vkscript.Not(users.Len().Eq(0)),
+	})
+
+	want := `var users = API.users.get({"fields": "photo_100", "user_ids": Args.user_ids});
+var result = [];
+var i = 0;
+while ((i < users.length)) {
+	if ((users[i].photo_100 != null)) {
+		result.push(users[i].id);
+	} else {
+		result.push(parseInt("0"));
+	}
+	i = (i + 1);
+}
+return {"count": !(users.length == 0), "names": users@.first_name, "result": result};`
+
+	assert.Equal(t, want, p.String())
+	assert.Equal(t, 1, p.Calls())
+	assert.NoError(t, p.Validate())
+}
+
+func TestProgram_Calls(t *testing.T) {
+	t.Parallel()
+
+	p := vkscript.New()
+
+	for i := 0; i <= vkscript.MaxCalls; i++ {
+		p.Do(vkscript.API("users.get", nil))
+	}
+
+	assert.Equal(t, vkscript.MaxCalls+1, p.Calls())
+	assert.Error(t, p.Validate())
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	f := func(v interface{}, want string) {
+		t.Helper()
+		assert.Equal(t, want, vkscript.Value(v).String())
+	}
+
+	f(nil, "null")
+	f(1, "1")
+	f(1.5, "1.5")
+	f(true, "true")
+	f(`a"b`, `"a\"b"`)
+	f([]int{1, 2}, "[1,2]")
+	f([]interface{}{1, vkscript.Args("a")}, "[1, Args.a]")
+	f(map[string]interface{}{"b": 1, "a": vkscript.Ident("x")}, `{"a": x, "b": 1}`)
+	f(vkscript.Raw("x + 1"), "x + 1")
+	f(vkscript.ParseDouble(vkscript.Args("d")).Mul(2).Sub(1).Div(3).Mod(4), "((((parseDouble(Args.d) * 2) - 1) / 3) % 4)")
+	f(vkscript.Ident("a").Le(1).And(vkscript.Ident("b").Ge(2)).Or(vkscript.Ident("c").Gt(3)), "(((a <= 1) && (b >= 2)) || (c > 3))")
+	f(vkscript.Ident("a").Method("slice", 0, 2), "a.slice(0, 2)")
+	f(vkscript.Func("f"), "f()")
+}
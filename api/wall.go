@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -8,7 +10,12 @@ import (
 //
 // https://vk.com/dev/wall.closeComments
 func (vk *VK) WallCloseComments(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.closeComments", params, &response)
+	return vk.WallCloseCommentsContext(context.Background(), params)
+}
+
+// WallCloseCommentsContext is the same as WallCloseComments, but takes a context.
+func (vk *VK) WallCloseCommentsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.closeComments", params, &response)
 	return
 }
 
@@ -22,7 +29,12 @@ type WallCreateCommentResponse struct {
 //
 // https://vk.com/dev/wall.createComment
 func (vk *VK) WallCreateComment(params Params) (response WallCreateCommentResponse, err error) {
-	err = vk.RequestUnmarshal("wall.createComment", params, &response)
+	return vk.WallCreateCommentContext(context.Background(), params)
+}
+
+// WallCreateCommentContext is the same as WallCreateComment, but takes a context.
+func (vk *VK) WallCreateCommentContext(ctx context.Context, params Params) (response WallCreateCommentResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.createComment", params, &response)
 	return
 }
 
@@ -30,7 +42,12 @@ func (vk *VK) WallCreateComment(params Params) (response WallCreateCommentRespon
 //
 // https://vk.com/dev/wall.delete
 func (vk *VK) WallDelete(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.delete", params, &response)
+	return vk.WallDeleteContext(context.Background(), params)
+}
+
+// WallDeleteContext is the same as WallDelete, but takes a context.
+func (vk *VK) WallDeleteContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.delete", params, &response)
 	return
 }
 
@@ -38,7 +55,12 @@ func (vk *VK) WallDelete(params Params) (response int, err error) {
 //
 // https://vk.com/dev/wall.deleteComment
 func (vk *VK) WallDeleteComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.deleteComment", params, &response)
+	return vk.WallDeleteCommentContext(context.Background(), params)
+}
+
+// WallDeleteCommentContext is the same as WallDeleteComment, but takes a context.
+func (vk *VK) WallDeleteCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.deleteComment", params, &response)
 	return
 }
 
@@ -51,7 +73,12 @@ type WallEditResponse struct {
 //
 // https://vk.com/dev/wall.edit
 func (vk *VK) WallEdit(params Params) (response WallEditResponse, err error) {
-	err = vk.RequestUnmarshal("wall.edit", params, &response)
+	return vk.WallEditContext(context.Background(), params)
+}
+
+// WallEditContext is the same as WallEdit, but takes a context.
+func (vk *VK) WallEditContext(ctx context.Context, params Params) (response WallEditResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.edit", params, &response)
 	return
 }
 
@@ -59,7 +86,12 @@ func (vk *VK) WallEdit(params Params) (response WallEditResponse, err error) {
 //
 // https://vk.com/dev/wall.editAdsStealth
 func (vk *VK) WallEditAdsStealth(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.editAdsStealth", params, &response)
+	return vk.WallEditAdsStealthContext(context.Background(), params)
+}
+
+// WallEditAdsStealthContext is the same as WallEditAdsStealth, but takes a context.
+func (vk *VK) WallEditAdsStealthContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.editAdsStealth", params, &response)
 	return
 }
 
@@ -67,7 +99,12 @@ func (vk *VK) WallEditAdsStealth(params Params) (response int, err error) {
 //
 // https://vk.com/dev/wall.editComment
 func (vk *VK) WallEditComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.editComment", params, &response)
+	return vk.WallEditCommentContext(context.Background(), params)
+}
+
+// WallEditCommentContext is the same as WallEditComment, but takes a context.
+func (vk *VK) WallEditCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.editComment", params, &response)
 	return
 }
 
@@ -83,8 +120,13 @@ type WallGetResponse struct {
 //
 // https://vk.com/dev/wall.get
 func (vk *VK) WallGet(params Params) (response WallGetResponse, err error) {
+	return vk.WallGetContext(context.Background(), params)
+}
+
+// WallGetContext is the same as WallGet, but takes a context.
+func (vk *VK) WallGetContext(ctx context.Context, params Params) (response WallGetResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("wall.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.get", params, &response)
 
 	return
 }
@@ -102,8 +144,13 @@ type WallGetExtendedResponse struct {
 //
 // https://vk.com/dev/wall.get
 func (vk *VK) WallGetExtended(params Params) (response WallGetExtendedResponse, err error) {
+	return vk.WallGetExtendedContext(context.Background(), params)
+}
+
+// WallGetExtendedContext is the same as WallGetExtended, but takes a context.
+func (vk *VK) WallGetExtendedContext(ctx context.Context, params Params) (response WallGetExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("wall.get", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.get", params, &response)
 
 	return
 }
@@ -117,8 +164,13 @@ type WallGetByIDResponse []object.WallWallpost
 //
 // https://vk.com/dev/wall.getById
 func (vk *VK) WallGetByID(params Params) (response WallGetByIDResponse, err error) {
+	return vk.WallGetByIDContext(context.Background(), params)
+}
+
+// WallGetByIDContext is the same as WallGetByID, but takes a context.
+func (vk *VK) WallGetByIDContext(ctx context.Context, params Params) (response WallGetByIDResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("wall.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getById", params, &response)
 
 	return
 }
@@ -135,8 +187,13 @@ type WallGetByIDExtendedResponse struct {
 //
 // https://vk.com/dev/wall.getById
 func (vk *VK) WallGetByIDExtended(params Params) (response WallGetByIDExtendedResponse, err error) {
+	return vk.WallGetByIDExtendedContext(context.Background(), params)
+}
+
+// WallGetByIDExtendedContext is the same as WallGetByIDExtended, but takes a context.
+func (vk *VK) WallGetByIDExtendedContext(ctx context.Context, params Params) (response WallGetByIDExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("wall.getById", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getById", params, &response)
 
 	return
 }
@@ -156,8 +213,13 @@ type WallGetCommentResponse struct {
 //
 // https://vk.com/dev/wall.getComment
 func (vk *VK) WallGetComment(params Params) (response WallGetCommentResponse, err error) {
+	return vk.WallGetCommentContext(context.Background(), params)
+}
+
+// WallGetCommentContext is the same as WallGetComment, but takes a context.
+func (vk *VK) WallGetCommentContext(ctx context.Context, params Params) (response WallGetCommentResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("wall.getComment", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getComment", params, &response)
 
 	return
 }
@@ -180,8 +242,13 @@ type WallGetCommentExtendedResponse struct {
 //
 // https://vk.com/dev/wall.getComment
 func (vk *VK) WallGetCommentExtended(params Params) (response WallGetCommentExtendedResponse, err error) {
+	return vk.WallGetCommentExtendedContext(context.Background(), params)
+}
+
+// WallGetCommentExtendedContext is the same as WallGetCommentExtended, but takes a context.
+func (vk *VK) WallGetCommentExtendedContext(ctx context.Context, params Params) (response WallGetCommentExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("wall.getComment", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getComment", params, &response)
 
 	return
 }
@@ -202,8 +269,13 @@ type WallGetCommentsResponse struct {
 //
 // https://vk.com/dev/wall.getComments
 func (vk *VK) WallGetComments(params Params) (response WallGetCommentsResponse, err error) {
+	return vk.WallGetCommentsContext(context.Background(), params)
+}
+
+// WallGetCommentsContext is the same as WallGetComments, but takes a context.
+func (vk *VK) WallGetCommentsContext(ctx context.Context, params Params) (response WallGetCommentsResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("wall.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getComments", params, &response)
 
 	return
 }
@@ -225,8 +297,13 @@ type WallGetCommentsExtendedResponse struct {
 //
 // https://vk.com/dev/wall.getComments
 func (vk *VK) WallGetCommentsExtended(params Params) (response WallGetCommentsExtendedResponse, err error) {
+	return vk.WallGetCommentsExtendedContext(context.Background(), params)
+}
+
+// WallGetCommentsExtendedContext is the same as WallGetCommentsExtended, but takes a context.
+func (vk *VK) WallGetCommentsExtendedContext(ctx context.Context, params Params) (response WallGetCommentsExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("wall.getComments", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.getComments", params, &response)
 
 	return
 }
@@ -241,7 +318,12 @@ type WallGetRepostsResponse struct {
 //
 // https://vk.com/dev/wall.getReposts
 func (vk *VK) WallGetReposts(params Params) (response WallGetRepostsResponse, err error) {
-	err = vk.RequestUnmarshal("wall.getReposts", params, &response)
+	return vk.WallGetRepostsContext(context.Background(), params)
+}
+
+// WallGetRepostsContext is the same as WallGetReposts, but takes a context.
+func (vk *VK) WallGetRepostsContext(ctx context.Context, params Params) (response WallGetRepostsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.getReposts", params, &response)
 	return
 }
 
@@ -249,7 +331,12 @@ func (vk *VK) WallGetReposts(params Params) (response WallGetRepostsResponse, er
 //
 // https://vk.com/dev/wall.openComments
 func (vk *VK) WallOpenComments(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.openComments", params, &response)
+	return vk.WallOpenCommentsContext(context.Background(), params)
+}
+
+// WallOpenCommentsContext is the same as WallOpenComments, but takes a context.
+func (vk *VK) WallOpenCommentsContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.openComments", params, &response)
 	return
 }
 
@@ -257,7 +344,12 @@ func (vk *VK) WallOpenComments(params Params) (response int, err error) {
 //
 // https://vk.com/dev/wall.pin
 func (vk *VK) WallPin(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.pin", params, &response)
+	return vk.WallPinContext(context.Background(), params)
+}
+
+// WallPinContext is the same as WallPin, but takes a context.
+func (vk *VK) WallPinContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.pin", params, &response)
 	return
 }
 
@@ -270,7 +362,12 @@ type WallPostResponse struct {
 //
 // https://vk.com/dev/wall.post
 func (vk *VK) WallPost(params Params) (response WallPostResponse, err error) {
-	err = vk.RequestUnmarshal("wall.post", params, &response)
+	return vk.WallPostContext(context.Background(), params)
+}
+
+// WallPostContext is the same as WallPost, but takes a context.
+func (vk *VK) WallPostContext(ctx context.Context, params Params) (response WallPostResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.post", params, &response)
 	return
 }
 
@@ -285,7 +382,12 @@ type WallPostAdsStealthResponse struct {
 //
 // https://vk.com/dev/wall.postAdsStealth
 func (vk *VK) WallPostAdsStealth(params Params) (response WallPostAdsStealthResponse, err error) {
-	err = vk.RequestUnmarshal("wall.postAdsStealth", params, &response)
+	return vk.WallPostAdsStealthContext(context.Background(), params)
+}
+
+// WallPostAdsStealthContext is the same as WallPostAdsStealth, but takes a context.
+func (vk *VK) WallPostAdsStealthContext(ctx context.Context, params Params) (response WallPostAdsStealthResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.postAdsStealth", params, &response)
 	return
 }
 
@@ -293,7 +395,12 @@ func (vk *VK) WallPostAdsStealth(params Params) (response WallPostAdsStealthResp
 //
 // https://vk.com/dev/wall.reportComment
 func (vk *VK) WallReportComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.reportComment", params, &response)
+	return vk.WallReportCommentContext(context.Background(), params)
+}
+
+// WallReportCommentContext is the same as WallReportComment, but takes a context.
+func (vk *VK) WallReportCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.reportComment", params, &response)
 	return
 }
 
@@ -301,7 +408,12 @@ func (vk *VK) WallReportComment(params Params) (response int, err error) {
 //
 // https://vk.com/dev/wall.reportPost
 func (vk *VK) WallReportPost(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.reportPost", params, &response)
+	return vk.WallReportPostContext(context.Background(), params)
+}
+
+// WallReportPostContext is the same as WallReportPost, but takes a context.
+func (vk *VK) WallReportPostContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.reportPost", params, &response)
 	return
 }
 
@@ -317,7 +429,12 @@ type WallRepostResponse struct {
 //
 // https://vk.com/dev/wall.repost
 func (vk *VK) WallRepost(params Params) (response WallRepostResponse, err error) {
-	err = vk.RequestUnmarshal("wall.repost", params, &response)
+	return vk.WallRepostContext(context.Background(), params)
+}
+
+// WallRepostContext is the same as WallRepost, but takes a context.
+func (vk *VK) WallRepostContext(ctx context.Context, params Params) (response WallRepostResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.repost", params, &response)
 	return
 }
 
@@ -325,7 +442,12 @@ func (vk *VK) WallRepost(params Params) (response WallRepostResponse, err error)
 //
 // https://vk.com/dev/wall.restore
 func (vk *VK) WallRestore(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.restore", params, &response)
+	return vk.WallRestoreContext(context.Background(), params)
+}
+
+// WallRestoreContext is the same as WallRestore, but takes a context.
+func (vk *VK) WallRestoreContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.restore", params, &response)
 	return
 }
 
@@ -333,7 +455,12 @@ func (vk *VK) WallRestore(params Params) (response int, err error) {
 //
 // https://vk.com/dev/wall.restoreComment
 func (vk *VK) WallRestoreComment(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.restoreComment", params, &response)
+	return vk.WallRestoreCommentContext(context.Background(), params)
+}
+
+// WallRestoreCommentContext is the same as WallRestoreComment, but takes a context.
+func (vk *VK) WallRestoreCommentContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.restoreComment", params, &response)
 	return
 }
 
@@ -349,8 +476,13 @@ type WallSearchResponse struct {
 //
 // https://vk.com/dev/wall.search
 func (vk *VK) WallSearch(params Params) (response WallSearchResponse, err error) {
+	return vk.WallSearchContext(context.Background(), params)
+}
+
+// WallSearchContext is the same as WallSearch, but takes a context.
+func (vk *VK) WallSearchContext(ctx context.Context, params Params) (response WallSearchResponse, err error) {
 	params["extended"] = false
-	err = vk.RequestUnmarshal("wall.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.search", params, &response)
 
 	return
 }
@@ -368,8 +500,13 @@ type WallSearchExtendedResponse struct {
 //
 // https://vk.com/dev/wall.search
 func (vk *VK) WallSearchExtended(params Params) (response WallSearchExtendedResponse, err error) {
+	return vk.WallSearchExtendedContext(context.Background(), params)
+}
+
+// WallSearchExtendedContext is the same as WallSearchExtended, but takes a context.
+func (vk *VK) WallSearchExtendedContext(ctx context.Context, params Params) (response WallSearchExtendedResponse, err error) {
 	params["extended"] = true
-	err = vk.RequestUnmarshal("wall.search", params, &response)
+	err = vk.RequestUnmarshalContext(ctx, "wall.search", params, &response)
 
 	return
 }
@@ -378,6 +515,11 @@ func (vk *VK) WallSearchExtended(params Params) (response WallSearchExtendedResp
 //
 // https://vk.com/dev/wall.unpin
 func (vk *VK) WallUnpin(params Params) (response int, err error) {
-	err = vk.RequestUnmarshal("wall.unpin", params, &response)
+	return vk.WallUnpinContext(context.Background(), params)
+}
+
+// WallUnpinContext is the same as WallUnpin, but takes a context.
+func (vk *VK) WallUnpinContext(ctx context.Context, params Params) (response int, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "wall.unpin", params, &response)
 	return
 }
@@ -1,6 +1,8 @@
 package api // import "github.com/SevereCloud/vksdk/api"
 
 import (
+	"context"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -14,7 +16,12 @@ type WidgetsGetCommentsResponse struct {
 //
 // https://vk.com/dev/widgets.getComments
 func (vk *VK) WidgetsGetComments(params Params) (response WidgetsGetCommentsResponse, err error) {
-	err = vk.RequestUnmarshal("widgets.getComments", params, &response)
+	return vk.WidgetsGetCommentsContext(context.Background(), params)
+}
+
+// WidgetsGetCommentsContext is the same as WidgetsGetComments, but takes a context.
+func (vk *VK) WidgetsGetCommentsContext(ctx context.Context, params Params) (response WidgetsGetCommentsResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "widgets.getComments", params, &response)
 	return
 }
 
@@ -28,6 +35,11 @@ type WidgetsGetPagesResponse struct {
 //
 // https://vk.com/dev/widgets.getPages
 func (vk *VK) WidgetsGetPages(params Params) (response WidgetsGetPagesResponse, err error) {
-	err = vk.RequestUnmarshal("widgets.getPages", params, &response)
+	return vk.WidgetsGetPagesContext(context.Background(), params)
+}
+
+// WidgetsGetPagesContext is the same as WidgetsGetPages, but takes a context.
+func (vk *VK) WidgetsGetPagesContext(ctx context.Context, params Params) (response WidgetsGetPagesResponse, err error) {
+	err = vk.RequestUnmarshalContext(ctx, "widgets.getPages", params, &response)
 	return
 }
@@ -0,0 +1,362 @@
+/*
+Package bot implements multi-step dialogs for bots.
+
+FSM is a finite-state machine which keeps a state of a dialog with every
+peer. A step handler is called for each message in the current state.
+Handlers move the dialog with Context.Goto, and keyboard buttons with
+a command payload trigger transitions registered with FSM.On.
+
+	fsm := bot.NewFSM(vk)
+
+	fsm.Handle(bot.StateStart, func(c *bot.Context) error {
+		c.Goto("name")
+		return c.Reply("What is your name?")
+	})
+
+	fsm.Handle("name", func(c *bot.Context) error {
+		c.End()
+		return c.Reply("Hello, " + c.Message.Text)
+	})
+
+	lp.MessageNewContext(fsm.MessageNew)
+*/
+package bot // import "github.com/SevereCloud/vksdk/bot"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// State is a name of a dialog state.
+type State string
+
+// States.
+const (
+	// StateStart is the state of a new dialog.
+	StateStart State = ""
+
+	// AnyState is used in FSM.On for transitions from every state.
+	AnyState State = "*"
+)
+
+// StepFunc handles a message in a state.
+type StepFunc func(c *Context) error
+
+// KeyFunc returns the key of the dialog which the message belongs to.
+type KeyFunc func(msg object.MessagesMessage) string
+
+// PeerKey keeps one dialog per conversation.
+func PeerKey(msg object.MessagesMessage) string {
+	return strconv.Itoa(msg.PeerID)
+}
+
+// UserKey keeps one dialog per user in all conversations.
+func UserKey(msg object.MessagesMessage) string {
+	return strconv.Itoa(msg.FromID)
+}
+
+// PeerUserKey keeps a separate dialog with every user of a conversation.
+func PeerUserKey(msg object.MessagesMessage) string {
+	return strconv.Itoa(msg.PeerID) + "_" + strconv.Itoa(msg.FromID)
+}
+
+// Payload returns a payload of a keyboard button with the command.
+//
+//	k := object.NewMessagesKeyboard(true)
+//	k.AddRow()
+//	k.AddTextButton("Cancel", bot.Payload("cancel"), "negative")
+func Payload(command string) string {
+	b, _ := json.Marshal(object.MessagesBasePayload{Command: command})
+	return string(b)
+}
+
+// Command returns the command of the message payload.
+func Command(payload string) string {
+	var p object.MessagesBasePayload
+
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		return ""
+	}
+
+	return p.Command
+}
+
+// FSM routes messages to step handlers by the state of the dialog.
+//
+// The zero value is ready to use: Storage defaults to MemoryStorage and
+// Key to PeerKey.
+//
+// Messages of one dialog must be handled in order, e.g. with
+// events.Dispatcher and OrderByPeer.
+type FSM struct {
+	VK *api.VK
+
+	// Storage keeps sessions. If nil, MemoryStorage is used.
+	Storage Storage
+
+	// Key returns the key of the dialog. The default is PeerKey.
+	Key KeyFunc
+
+	// Timeout resets the dialog to StateStart if there are no messages
+	// during the timeout. If zero, dialogs do not expire.
+	Timeout time.Duration
+
+	// OnTimeout is called with the expired session before the message is
+	// handled in StateStart. The error is returned by HandleMessage.
+	OnTimeout StepFunc
+
+	steps       map[State]StepFunc
+	transitions map[State]map[string]State
+	storageOnce sync.Once
+}
+
+// NewFSM returns a new FSM with MemoryStorage.
+func NewFSM(vk *api.VK) *FSM {
+	return &FSM{
+		VK:          vk,
+		Storage:     NewMemoryStorage(),
+		Key:         PeerKey,
+		steps:       make(map[State]StepFunc),
+		transitions: make(map[State]map[string]State),
+	}
+}
+
+// Handle sets the step handler of the state.
+func (m *FSM) Handle(state State, f StepFunc) {
+	if m.steps == nil {
+		m.steps = make(map[State]StepFunc)
+	}
+
+	m.steps[state] = f
+}
+
+// On registers a transition: if a message with the command in payload is
+// received in the state, the dialog moves to the next state and the message
+// is handled by its step handler. Use AnyState for transitions from every
+// state.
+func (m *FSM) On(state State, command string, next State) {
+	if m.transitions == nil {
+		m.transitions = make(map[State]map[string]State)
+	}
+
+	if m.transitions[state] == nil {
+		m.transitions[state] = make(map[string]State)
+	}
+
+	m.transitions[state][command] = next
+}
+
+// MessageNew handles message_new event.
+//
+//	lp.MessageNewContext(fsm.MessageNew)
+func (m *FSM) MessageNew(ctx context.Context, obj object.MessageNewObject) error {
+	return m.HandleMessage(ctx, obj.Message)
+}
+
+// HandleMessage calls the step handler of the dialog and saves the session.
+//
+// Messages in states without a handler are ignored.
+func (m *FSM) HandleMessage(ctx context.Context, msg object.MessagesMessage) error {
+	key := m.key(msg)
+	storage := m.storage()
+
+	session, ok, err := storage.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	c := &Context{
+		Context: ctx,
+		VK:      m.VK,
+		Message: msg,
+		Command: Command(msg.Payload),
+	}
+
+	if ok && m.Timeout > 0 && now.Sub(session.Updated) > m.Timeout {
+		if m.OnTimeout != nil {
+			c.Session = &session
+			if err := m.OnTimeout(c); err != nil {
+				return err
+			}
+		}
+
+		ok = false
+	}
+
+	if !ok {
+		session = Session{State: StateStart}
+	}
+
+	if session.Data == nil {
+		session.Data = make(map[string]string)
+	}
+
+	if next, ok := m.transition(session.State, c.Command); ok {
+		session.State = next
+	}
+
+	c.Session = &session
+	c.next = session.State
+
+	if step, ok := m.steps[session.State]; ok {
+		if err := step(c); err != nil {
+			return err
+		}
+	}
+
+	if c.end {
+		return storage.Delete(ctx, key)
+	}
+
+	session.State = c.next
+	session.Updated = now
+
+	return storage.Set(ctx, key, session)
+}
+
+// storage returns Storage, which is set to MemoryStorage on the first
+// message if it is nil.
+func (m *FSM) storage() Storage {
+	m.storageOnce.Do(func() {
+		if m.Storage == nil {
+			m.Storage = NewMemoryStorage()
+		}
+	})
+
+	return m.Storage
+}
+
+func (m *FSM) key(msg object.MessagesMessage) string {
+	if m.Key != nil {
+		return m.Key(msg)
+	}
+
+	return PeerKey(msg)
+}
+
+func (m *FSM) transition(state State, command string) (State, bool) {
+	if command == "" {
+		return "", false
+	}
+
+	if next, ok := m.transitions[state][command]; ok {
+		return next, true
+	}
+
+	next, ok := m.transitions[AnyState][command]
+
+	return next, ok
+}
+
+// Context is passed to step handlers.
+type Context struct {
+	context.Context
+
+	VK      *api.VK
+	Message object.MessagesMessage
+	Session *Session
+
+	// Command is the command of the message payload.
+	Command string
+
+	// Text is the text of the message without a mention of the community.
+	// It is set by Router.
+	Text string
+
+	// Args are arguments of the text command. It is set by Router.Command.
+	Args []string
+
+	// Matches are submatches of the regular expression. It is set by
+	// Router.Regexp.
+	Matches []string
+
+	next State
+	end  bool
+}
+
+// State returns the current state of the dialog.
+func (c *Context) State() State {
+	return c.Session.State
+}
+
+// Goto sets the state of the dialog for the next message.
+func (c *Context) Goto(state State) {
+	c.next = state
+	c.end = false
+}
+
+// End deletes the session after the handler returns.
+func (c *Context) End() {
+	c.end = true
+}
+
+// Get returns the value of the session data.
+func (c *Context) Get(key string) string {
+	return c.Session.Data[key]
+}
+
+// Set sets the value of the session data.
+func (c *Context) Set(key, value string) {
+	c.Session.Data[key] = value
+}
+
+// Reply sends a message to the conversation.
+func (c *Context) Reply(text string) error {
+	return c.Send(api.Params{
+		"message": text,
+	})
+}
+
+// ReplyKeyboard sends a message with the keyboard to the conversation.
+func (c *Context) ReplyKeyboard(text string, keyboard object.MessagesKeyboard) error {
+	return c.Send(api.Params{
+		"message":  text,
+		"keyboard": keyboard,
+	})
+}
+
+// Send sends a message with params to the conversation. peer_id and
+// random_id are set if they are missing.
+func (c *Context) Send(params api.Params) error {
+	p := api.Params{
+		"peer_id":   c.Message.PeerID,
+		"random_id": randomID(),
+	}
+
+	for k, v := range params {
+		p[k] = v
+	}
+
+	_, err := c.VK.MessagesSendContext(c, p)
+
+	return err
+}
+
+// randomID returns a positive random_id.
+//
+// crypto/rand is used because math/rand is not seeded before Go 1.20,
+// and VK drops messages with random_id repeated after a restart.
+func randomID() int32 {
+	var b [4]byte
+
+	if _, err := rand.Read(b[:]); err != nil {
+		return int32(time.Now().UnixNano()&(1<<31-1)) | 1
+	}
+
+	id := int32(binary.LittleEndian.Uint32(b[:]) & (1<<31 - 1))
+	if id == 0 {
+		id = 1
+	}
+
+	return id
+}
@@ -0,0 +1,228 @@
+package bot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/bot"
+	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
+)
+
+type sentMessages struct {
+	mux  sync.Mutex
+	sent []url.Values
+}
+
+func (s *sentMessages) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+
+	s.mux.Lock()
+	s.sent = append(s.sent, r.Form)
+	s.mux.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"response":1}`)
+}
+
+func (s *sentMessages) texts() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	texts := make([]string, len(s.sent))
+	for i, v := range s.sent {
+		texts[i] = v.Get("message")
+	}
+
+	return texts
+}
+
+func newFSM(t *testing.T) (*bot.FSM, *sentMessages, *httptest.Server) {
+	t.Helper()
+
+	s := &sentMessages{}
+	ts := httptest.NewServer(s)
+
+	vk := api.NewVK("token")
+	vk.MethodURL = ts.URL + "/method/"
+
+	fsm := bot.NewFSM(vk)
+
+	fsm.Handle(bot.StateStart, func(c *bot.Context) error {
+		c.Goto("name")
+		return c.Reply("name?")
+	})
+
+	fsm.Handle("name", func(c *bot.Context) error {
+		c.Set("name", c.Message.Text)
+		c.Goto("age")
+
+		return c.Reply("age?")
+	})
+
+	fsm.Handle("age", func(c *bot.Context) error {
+		c.End()
+		return c.Reply(c.Get("name") + " " + c.Message.Text)
+	})
+
+	fsm.Handle("cancel", func(c *bot.Context) error {
+		c.End()
+		return c.Reply("canceled")
+	})
+
+	fsm.On(bot.AnyState, "cancel", "cancel")
+
+	return fsm, s, ts
+}
+
+func message(peerID int, text, payload string) object.MessagesMessage {
+	return object.MessagesMessage{
+		PeerID:  peerID,
+		FromID:  peerID,
+		Text:    text,
+		Payload: payload,
+	}
+}
+
+func TestFSM(t *testing.T) {
+	t.Parallel()
+
+	fsm, s, ts := newFSM(t)
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "hi", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(2, "hi", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "Ivan", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "20", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(2, "Cancel", bot.Payload("cancel"))))
+
+	assert.Equal(t, []string{"name?", "name?", "age?", "Ivan 20", "canceled"}, s.texts())
+	assert.Equal(t, "1", s.sent[0].Get("peer_id"))
+	assert.NotEqual(t, "0", s.sent[0].Get("random_id"))
+	assert.NotEqual(t, s.sent[0].Get("random_id"), s.sent[1].Get("random_id"))
+
+	_, ok, _ := fsm.Storage.Get(ctx, "1")
+	assert.False(t, ok)
+
+	_, ok, _ = fsm.Storage.Get(ctx, "2")
+	assert.False(t, ok)
+}
+
+func TestFSM_Timeout(t *testing.T) {
+	t.Parallel()
+
+	fsm, s, ts := newFSM(t)
+	defer ts.Close()
+
+	ctx := context.Background()
+	timedOut := 0
+
+	fsm.Timeout = time.Minute
+	fsm.OnTimeout = func(c *bot.Context) error {
+		assert.Equal(t, bot.State("name"), c.State())
+
+		timedOut++
+
+		return nil
+	}
+
+	_ = fsm.Storage.Set(ctx, "1", bot.Session{
+		State:   "name",
+		Updated: time.Now().Add(-time.Hour),
+	})
+
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "hi", "")))
+	assert.Equal(t, 1, timedOut)
+	assert.Equal(t, []string{"name?"}, s.texts())
+
+	session, ok, _ := fsm.Storage.Get(ctx, "1")
+	assert.True(t, ok)
+	assert.Equal(t, bot.State("name"), session.State)
+}
+
+func TestFSM_error(t *testing.T) {
+	t.Parallel()
+
+	fsm := bot.NewFSM(nil)
+	ctx := context.Background()
+	errStep := errors.New("step")
+
+	fsm.Handle(bot.StateStart, func(c *bot.Context) error {
+		c.Goto("next")
+		return errStep
+	})
+
+	assert.Equal(t, errStep, fsm.MessageNew(ctx, object.MessageNewObject{Message: message(1, "", "")}))
+
+	_, ok, _ := fsm.Storage.Get(ctx, "1")
+	assert.False(t, ok)
+
+	// changes of session data are not saved on error
+	_ = fsm.Storage.Set(ctx, "1", bot.Session{State: "next", Data: map[string]string{"a": "1"}})
+
+	fsm.Handle("next", func(c *bot.Context) error {
+		c.Set("a", "2")
+		return errStep
+	})
+
+	assert.Equal(t, errStep, fsm.MessageNew(ctx, object.MessageNewObject{Message: message(1, "", "")}))
+
+	session, _, _ := fsm.Storage.Get(ctx, "1")
+	assert.Equal(t, "1", session.Data["a"])
+}
+
+func TestFSM_zero(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fsm   bot.FSM
+		names []string
+	)
+
+	ctx := context.Background()
+
+	fsm.Handle(bot.StateStart, func(c *bot.Context) error {
+		return nil
+	})
+	fsm.Handle("name", func(c *bot.Context) error {
+		names = append(names, c.Message.Text)
+		c.End()
+
+		return nil
+	})
+	fsm.On(bot.AnyState, "name", "name")
+
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "hi", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "Ivan", bot.Payload("name"))))
+	assert.Equal(t, []string{"Ivan"}, names)
+	assert.IsType(t, &bot.MemoryStorage{}, fsm.Storage)
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	msg := object.MessagesMessage{PeerID: 2000000001, FromID: 1}
+
+	assert.Equal(t, "2000000001", bot.PeerKey(msg))
+	assert.Equal(t, "1", bot.UserKey(msg))
+	assert.Equal(t, "2000000001_1", bot.PeerUserKey(msg))
+}
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `{"command":"start"}`, bot.Payload("start"))
+	assert.Equal(t, "start", bot.Command(bot.Payload("start")))
+	assert.Equal(t, "", bot.Command(`{"button":"1"}`))
+	assert.Equal(t, "", bot.Command(""))
+}
@@ -0,0 +1,214 @@
+package bot // import "github.com/SevereCloud/vksdk/bot"
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/internal"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// mentionRegexp matches a mention of a community at the beginning of text,
+// e.g. "[club123|@bot], ".
+var mentionRegexp = regexp.MustCompile(`^\[(?:club|public)(\d+)\|[^\]]*\][\s,:]*`) // nolint:gochecknoglobals
+
+// Filter reports whether the route matches the message.
+type Filter func(c *Context) bool
+
+// Private matches messages of private conversations.
+func Private(c *Context) bool {
+	return c.Message.PeerID < internal.ChatPeerID
+}
+
+// Chat matches messages of chats.
+func Chat(c *Context) bool {
+	return c.Message.PeerID > internal.ChatPeerID
+}
+
+type route struct {
+	match   func(c *Context) bool
+	f       StepFunc
+	filters []Filter
+}
+
+// Router routes messages to handlers by commands, regular expressions and
+// payload. Routes are checked in order of registration and the first
+// matching route handles the message.
+//
+//	r := bot.NewRouter(vk)
+//	r.Command("start", func(c *bot.Context) error {
+//		return c.Reply("Hello")
+//	})
+//
+//	lp.MessageNewContext(r.MessageNew)
+type Router struct {
+	VK *api.VK
+
+	// GroupID is the ID of the bot community. Mentions of the community
+	// are removed from the text. If zero, mentions of any community are
+	// removed.
+	GroupID int
+
+	// Prefixes of commands. The default is "/".
+	Prefixes []string
+
+	routes   []route
+	fallback StepFunc
+}
+
+// NewRouter returns a new Router.
+func NewRouter(vk *api.VK) *Router {
+	return &Router{
+		VK:       vk,
+		Prefixes: []string{"/"},
+	}
+}
+
+// Command adds a route for the text command, e.g. "/ban 123". The name
+// is case-insensitive. Arguments are passed in Context.Args.
+func (r *Router) Command(name string, f StepFunc, filters ...Filter) {
+	name = strings.ToLower(name)
+
+	r.add(func(c *Context) bool {
+		command, args, ok := r.parseCommand(c.Text)
+		if !ok || command != name {
+			return false
+		}
+
+		c.Args = args
+
+		return true
+	}, f, filters)
+}
+
+// Regexp adds a route for text matching re. Submatches are passed in
+// Context.Matches.
+func (r *Router) Regexp(re *regexp.Regexp, f StepFunc, filters ...Filter) {
+	r.add(func(c *Context) bool {
+		matches := re.FindStringSubmatch(c.Text)
+		if matches == nil {
+			return false
+		}
+
+		c.Matches = matches
+
+		return true
+	}, f, filters)
+}
+
+// Payload adds a route for the command of the payload. See Payload and
+// Context.DecodePayload. Messages without a command never match, even
+// if command is empty.
+func (r *Router) Payload(command string, f StepFunc, filters ...Filter) {
+	r.add(func(c *Context) bool {
+		return c.Command != "" && c.Command == command
+	}, f, filters)
+}
+
+// Fallback sets the handler of messages which do not match any route.
+func (r *Router) Fallback(f StepFunc) {
+	r.fallback = f
+}
+
+func (r *Router) add(match func(c *Context) bool, f StepFunc, filters []Filter) {
+	r.routes = append(r.routes, route{
+		match:   match,
+		f:       f,
+		filters: filters,
+	})
+}
+
+// MessageNew handles message_new event.
+//
+//	lp.MessageNewContext(r.MessageNew)
+func (r *Router) MessageNew(ctx context.Context, obj object.MessageNewObject) error {
+	return r.Step(&Context{
+		Context: ctx,
+		VK:      r.VK,
+		Message: obj.Message,
+		Session: &Session{Data: make(map[string]string)},
+		Command: Command(obj.Message.Payload),
+	})
+}
+
+// Step handles the message as StepFunc, so the router can be used in
+// a state of FSM.
+//
+//	fsm.Handle(bot.StateStart, r.Step)
+func (r *Router) Step(c *Context) error {
+	c.Text = r.stripMention(c.Message.Text)
+
+	for _, rt := range r.routes {
+		if !rt.match(c) || !filter(c, rt.filters) {
+			c.Args = nil
+			c.Matches = nil
+
+			continue
+		}
+
+		return rt.f(c)
+	}
+
+	if r.fallback != nil {
+		return r.fallback(c)
+	}
+
+	return nil
+}
+
+func filter(c *Context, filters []Filter) bool {
+	for _, f := range filters {
+		if !f(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Router) stripMention(text string) string {
+	m := mentionRegexp.FindStringSubmatch(text)
+	if m == nil {
+		return text
+	}
+
+	if r.GroupID != 0 && m[1] != strconv.Itoa(r.GroupID) {
+		return text
+	}
+
+	return text[len(m[0]):]
+}
+
+// parseCommand returns the lowercase name and arguments of the command.
+func (r *Router) parseCommand(text string) (string, []string, bool) {
+	for _, prefix := range r.Prefixes {
+		if !strings.HasPrefix(text, prefix) {
+			continue
+		}
+
+		fields := strings.Fields(text[len(prefix):])
+		if len(fields) == 0 {
+			return "", nil, false
+		}
+
+		return strings.ToLower(fields[0]), fields[1:], true
+	}
+
+	return "", nil, false
+}
+
+// DecodePayload decodes the JSON payload of the message into v.
+//
+//	var p struct {
+//		Command string `json:"command"`
+//		ItemID  int    `json:"item_id"`
+//	}
+//
+//	err := c.DecodePayload(&p)
+func (c *Context) DecodePayload(v interface{}) error {
+	return json.Unmarshal([]byte(c.Message.Payload), v)
+}
@@ -0,0 +1,106 @@
+package bot_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/bot"
+	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter(t *testing.T) {
+	t.Parallel()
+
+	r := bot.NewRouter(nil)
+	r.GroupID = 123
+
+	var got []string
+
+	r.Command("ban", func(c *bot.Context) error {
+		got = append(got, "ban "+c.Args[0])
+		return nil
+	}, bot.Chat)
+	r.Command("start", func(c *bot.Context) error {
+		got = append(got, "start")
+		return nil
+	}, bot.Private)
+	r.Regexp(regexp.MustCompile(`^buy (\d+)$`), func(c *bot.Context) error {
+		got = append(got, "buy "+c.Matches[1])
+		return nil
+	})
+	r.Payload("item", func(c *bot.Context) error {
+		var p struct {
+			ItemID int `json:"item_id"`
+		}
+
+		assert.NoError(t, c.DecodePayload(&p))
+		assert.Equal(t, 5, p.ItemID)
+
+		got = append(got, "item")
+
+		return nil
+	})
+	// a message without a command has no empty command
+	r.Payload("", func(c *bot.Context) error {
+		got = append(got, "empty payload")
+		return nil
+	})
+	r.Fallback(func(c *bot.Context) error {
+		got = append(got, "fallback "+c.Text)
+		return nil
+	})
+
+	messages := []object.MessagesMessage{
+		{PeerID: 2000000001, Text: "[club123|@bot] /BAN 42 spam"},
+		{PeerID: 1, Text: "/ban 42"},
+		{PeerID: 1, Text: "/start"},
+		{PeerID: 2000000001, Text: "/start"},
+		{PeerID: 1, Text: "buy 10"},
+		{PeerID: 1, Text: "Item", Payload: `{"command":"item","item_id":5}`},
+		{PeerID: 2000000001, Text: "[club456|@other] hi"},
+		{PeerID: 1, Text: "/"},
+	}
+
+	for _, msg := range messages {
+		assert.NoError(t, r.MessageNew(context.Background(), object.MessageNewObject{Message: msg}))
+	}
+
+	assert.Equal(t, []string{
+		"ban 42",
+		"fallback /ban 42",
+		"start",
+		"fallback /start",
+		"buy 10",
+		"item",
+		"fallback [club456|@other] hi",
+		"fallback /",
+	}, got)
+}
+
+func TestRouter_FSM(t *testing.T) {
+	t.Parallel()
+
+	fsm, s, ts := newFSM(t)
+	defer ts.Close()
+
+	r := bot.NewRouter(fsm.VK)
+	r.Command("help", func(c *bot.Context) error {
+		return c.Reply("help")
+	})
+	r.Fallback(func(c *bot.Context) error {
+		c.Goto("name")
+		return c.Reply("name?")
+	})
+
+	fsm.Handle(bot.StateStart, r.Step)
+
+	ctx := context.Background()
+
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "/help", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "hi", "")))
+	assert.NoError(t, fsm.HandleMessage(ctx, message(1, "Ivan", "")))
+
+	assert.Equal(t, []string{"help", "name?", "age?"}, s.texts())
+}
@@ -0,0 +1,121 @@
+package bot // import "github.com/SevereCloud/vksdk/bot"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Session is a state of a dialog.
+type Session struct {
+	State State
+	Data  map[string]string
+
+	// Updated is the time of the last message of the dialog.
+	Updated time.Time
+}
+
+// copy returns the session with a copy of Data.
+func (s Session) copy() Session {
+	if s.Data == nil {
+		return s
+	}
+
+	data := make(map[string]string, len(s.Data))
+	for k, v := range s.Data {
+		data[k] = v
+	}
+
+	s.Data = data
+
+	return s
+}
+
+// Storage stores sessions by key. See KeyFunc.
+//
+// The storage can be implemented, for example, on top of Redis to keep
+// dialogs between restarts.
+type Storage interface {
+	// Get returns the session. If there is no session, ok is false.
+	Get(ctx context.Context, key string) (s Session, ok bool, err error)
+	Set(ctx context.Context, key string, s Session) error
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryStorage keeps sessions in memory.
+//
+// Sessions which are not updated during TTL are deleted, so dialogs of
+// users who never write again do not take memory. Expired sessions are
+// swept by Set not more than once per TTL. TTL should be greater than
+// FSM.Timeout, otherwise FSM.OnTimeout is not called. If TTL is zero,
+// sessions are kept until they are deleted.
+type MemoryStorage struct {
+	TTL time.Duration
+
+	sessions map[string]Session
+	swept    time.Time
+	mux      sync.Mutex
+}
+
+// NewMemoryStorage returns a new MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		sessions: make(map[string]Session),
+	}
+}
+
+// Get returns a copy of the session, so changes of a failed handler
+// are not saved.
+func (s *MemoryStorage) Get(_ context.Context, key string) (Session, bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	session, ok := s.sessions[key]
+	if ok && s.expired(session, time.Now()) {
+		delete(s.sessions, key)
+		return Session{}, false, nil
+	}
+
+	return session.copy(), ok, nil
+}
+
+// Set saves a copy of the session.
+func (s *MemoryStorage) Set(_ context.Context, key string, session Session) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.sweep(time.Now())
+	s.sessions[key] = session.copy()
+
+	return nil
+}
+
+// expired reports whether the session is not updated during TTL.
+func (s *MemoryStorage) expired(session Session, now time.Time) bool {
+	return s.TTL > 0 && now.Sub(session.Updated) > s.TTL
+}
+
+// sweep deletes expired sessions if TTL has passed since the last sweep.
+func (s *MemoryStorage) sweep(now time.Time) {
+	if s.TTL <= 0 || now.Sub(s.swept) < s.TTL {
+		return
+	}
+
+	s.swept = now
+
+	for key, session := range s.sessions {
+		if s.expired(session, now) {
+			delete(s.sessions, key)
+		}
+	}
+}
+
+// Delete deletes the session.
+func (s *MemoryStorage) Delete(_ context.Context, key string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.sessions, key)
+
+	return nil
+}
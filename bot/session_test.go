@@ -0,0 +1,48 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStorage_TTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	s := NewMemoryStorage()
+	s.TTL = time.Minute
+
+	assert.NoError(t, s.Set(ctx, "old", Session{Updated: now.Add(-time.Hour)}))
+	assert.NoError(t, s.Set(ctx, "new", Session{Updated: now}))
+
+	// the expired session is not returned
+	_, ok, err := s.Get(ctx, "old")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, _ = s.Get(ctx, "new")
+	assert.True(t, ok)
+
+	// sessions of peers which never write again are swept by Set
+	assert.NoError(t, s.Set(ctx, "gone", Session{Updated: now.Add(-time.Hour)}))
+	assert.Len(t, s.sessions, 2)
+
+	s.swept = time.Time{} // TTL has passed since the last sweep
+
+	assert.NoError(t, s.Set(ctx, "next", Session{Updated: now}))
+	assert.Len(t, s.sessions, 2)
+	assert.NotContains(t, s.sessions, "gone")
+
+	// without TTL sessions are kept
+	s.TTL = 0
+	s.swept = time.Time{}
+
+	assert.NoError(t, s.Set(ctx, "old", Session{Updated: now.Add(-time.Hour)}))
+	assert.NoError(t, s.Set(ctx, "gone", Session{Updated: now.Add(-time.Hour)}))
+	assert.Len(t, s.sessions, 4)
+}
@@ -6,10 +6,13 @@ See more https://vk.com/dev/callback_api
 package callback // import "github.com/SevereCloud/vksdk/callback"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/SevereCloud/vksdk/events"
 	"github.com/SevereCloud/vksdk/object"
@@ -22,7 +25,26 @@ type Callback struct {
 	SecretKeys       map[int]string
 	SecretKey        string
 
+	// DedupStore skips events which are delivered again by VK. If nil,
+	// events are not deduplicated.
+	DedupStore events.DedupStore
+
+	// Timeout sets the deadline of the context passed to event handlers.
+	// If zero, the context is canceled only when the request is done.
+	// With Dispatcher the deadline is counted from the start of handling.
+	Timeout time.Duration
+
+	// Dispatcher enables asynchronous mode: events are acknowledged
+	// immediately and handled in the background. If the queue of
+	// the Dispatcher is full or Shutdown is called, VK gets 503 and
+	// delivers the event later.
+	//
+	// 	cb.Dispatcher = events.NewDispatcher(&cb.FuncList, 8)
+	Dispatcher *events.Dispatcher
+
 	events.FuncList
+
+	mux sync.RWMutex
 }
 
 // NewCallback return *Callback.
@@ -48,16 +70,21 @@ func (cb *Callback) HandleFunc(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if cb.SecretKeys[e.GroupID] != "" || cb.SecretKey != "" {
-		if e.Secret != cb.SecretKeys[e.GroupID] && e.Secret != cb.SecretKey {
+	cb.mux.RLock()
+	secretKey := cb.SecretKeys[e.GroupID]
+	confirmationKey := cb.ConfirmationKeys[e.GroupID]
+	cb.mux.RUnlock()
+
+	if secretKey != "" || cb.SecretKey != "" {
+		if e.Secret != secretKey && e.Secret != cb.SecretKey {
 			http.Error(w, "Bad Secret", http.StatusForbidden)
 			return
 		}
 	}
 
 	if e.Type == object.EventConfirmation {
-		if cb.ConfirmationKeys[e.GroupID] != "" {
-			fmt.Fprintf(w, cb.ConfirmationKeys[e.GroupID])
+		if confirmationKey != "" {
+			fmt.Fprintf(w, confirmationKey)
 		} else {
 			fmt.Fprintf(w, cb.ConfirmationKey)
 		}
@@ -65,7 +92,20 @@ func (cb *Callback) HandleFunc(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := cb.Handler(e); err != nil {
+	if cb.Dispatcher != nil {
+		cb.dispatch(w, e)
+		return
+	}
+
+	ctx := events.WithTransport(r.Context(), events.TransportCallback)
+	ctx = events.WithTimeout(ctx, cb.Timeout)
+
+	handler := cb.HandlerContext
+	if cb.DedupStore != nil {
+		handler = events.DedupMiddleware(cb.DedupStore)(handler)
+	}
+
+	if err := handler(ctx, e); err != nil {
 		log.Printf("Callback.HandleFunc: %v", err)
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 
@@ -74,3 +114,41 @@ func (cb *Callback) HandleFunc(w http.ResponseWriter, r *http.Request) {
 
 	fmt.Fprintf(w, "ok")
 }
+
+// dispatch passes the event to Dispatcher and acknowledges it.
+func (cb *Callback) dispatch(w http.ResponseWriter, e object.GroupEvent) {
+	// The request context is canceled after the response
+	ctx := events.WithTransport(context.Background(), events.TransportCallback)
+	ctx = events.WithTimeout(ctx, cb.Timeout)
+
+	dedup := cb.DedupStore != nil && e.EventID != ""
+	if dedup {
+		isNew, err := cb.DedupStore.Add(ctx, e.EventID)
+		if err == nil && !isNew {
+			fmt.Fprintf(w, "ok")
+			return
+		}
+	}
+
+	if !cb.Dispatcher.TryDispatchContext(ctx, e) {
+		if dedup {
+			_ = cb.DedupStore.Remove(ctx, e.EventID)
+		}
+
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	fmt.Fprintf(w, "ok")
+}
+
+// Shutdown waits until events passed to Dispatcher are handled.
+//
+// Call it after http.Server.Shutdown, so no new events are received.
+// Events received after Shutdown get 503, so VK delivers them again.
+func (cb *Callback) Shutdown() {
+	if cb.Dispatcher != nil {
+		cb.Dispatcher.Shutdown()
+	}
+}
@@ -2,10 +2,15 @@ package callback
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/object"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -210,3 +215,140 @@ func TestNewCallback(t *testing.T) {
 	cb := NewCallback()
 	assert.NotNil(t, cb)
 }
+
+func TestCallback_HandleFunc_context(t *testing.T) {
+	t.Parallel()
+
+	cb := NewCallback()
+	cb.Timeout = time.Second
+	cb.MessageNewContext(func(ctx context.Context, obj object.MessageNewObject) error {
+		assert.Equal(t, events.TransportCallback, events.TransportFromContext(ctx))
+		assert.Equal(t, 123456, events.GroupIDFromContext(ctx))
+
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+
+		if obj.Message.Text == "fail" {
+			return errors.New("handler error")
+		}
+
+		return nil
+	})
+
+	f := func(body, expected string) {
+		t.Helper()
+
+		req := httptest.NewRequest("POST", "/callback", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+
+		cb.HandleFunc(rr, req)
+		assert.Equal(t, expected, rr.Body.String())
+	}
+
+	f(`{"type":"message_new","object":{"message":{"text":"ok"}},"group_id":123456}`, "ok")
+	f(`{"type":"message_new","object":{"message":{"text":"fail"}},"group_id":123456}`, "Bad Request\n")
+}
+
+func TestCallback_HandleFunc_dedup(t *testing.T) {
+	t.Parallel()
+
+	handled := 0
+	fail := true
+
+	cb := NewCallback()
+	cb.DedupStore = events.NewMemoryDedupStore(0, 0)
+	cb.MessageNewContext(func(ctx context.Context, obj object.MessageNewObject) error {
+		handled++
+
+		if fail {
+			return errors.New("handler error")
+		}
+
+		return nil
+	})
+
+	f := func(eventID string) {
+		t.Helper()
+
+		body := `{"type":"message_new","object":{},"group_id":1,"event_id":"` + eventID + `"}`
+		req := httptest.NewRequest("POST", "/callback", bytes.NewBufferString(body))
+		cb.HandleFunc(httptest.NewRecorder(), req)
+	}
+
+	// a failed event is handled again
+	f("a")
+
+	fail = false
+
+	f("a")
+	f("a")
+	f("b")
+
+	assert.Equal(t, 3, handled)
+
+	cb.DedupStore = nil
+
+	f("b")
+	assert.Equal(t, 4, handled)
+}
+
+func TestCallback_HandleFunc_async(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	cb := NewCallback()
+	cb.Timeout = time.Minute
+	cb.MessageNewContext(func(ctx context.Context, obj object.MessageNewObject) error {
+		assert.NoError(t, ctx.Err())
+		assert.Equal(t, events.TransportCallback, events.TransportFromContext(ctx))
+
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+
+		started <- struct{}{}
+		<-release
+
+		return nil
+	})
+
+	cb.DedupStore = events.NewMemoryDedupStore(0, 0)
+	cb.Dispatcher = events.NewDispatcher(&cb.FuncList, 1)
+	cb.Dispatcher.QueueSize = 1
+
+	f := func(eventID, expected string) {
+		t.Helper()
+
+		body := `{"type":"message_new","object":{},"group_id":1,"event_id":"` + eventID + `"}`
+		req := httptest.NewRequest("POST", "/callback", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+
+		cb.HandleFunc(rr, req)
+		assert.Equal(t, expected, rr.Body.String())
+	}
+
+	f("a", "ok")
+	<-started
+
+	f("b", "ok")
+	f("a", "ok") // duplicate
+	f("c", "Service Unavailable\n")
+
+	stats := cb.Dispatcher.Stats()
+	assert.Equal(t, int64(2), stats.Dispatched)
+	assert.Equal(t, int64(1), stats.Rejected)
+	assert.Equal(t, 1, stats.Queued)
+
+	close(release)
+	cb.Shutdown()
+
+	assert.Equal(t, int64(2), cb.Dispatcher.Stats().Handled)
+
+	// events are not accepted after Shutdown, so VK delivers them again
+	f("c", "Service Unavailable\n")
+	f("d", "Service Unavailable\n")
+
+	assert.Equal(t, int64(3), cb.Dispatcher.Stats().Rejected)
+	assert.Equal(t, int64(2), cb.Dispatcher.Stats().Handled)
+}
@@ -0,0 +1,150 @@
+package callback // import "github.com/SevereCloud/vksdk/callback"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/internal"
+)
+
+// DefaultServerTitle is the title of a server added by Setup.
+const DefaultServerTitle = "vksdk"
+
+// secretKeyLength is the number of random bytes in a generated secret key.
+// VK allows up to 50 characters.
+const secretKeyLength = 16
+
+// Setup registers the server with serverURL in the group and returns its ID.
+//
+// If the server with the same URL exists, it is updated, otherwise a new
+// server is added. Setup stores the confirmation code in ConfirmationKeys,
+// sets the secret key (a random key is generated if SecretKeys and
+// SecretKey are empty) and enables exactly the event types which have
+// handlers.
+//
+// Register handlers before calling Setup. The token of vk requires
+// the manage access right.
+//
+//	cb := callback.NewCallback()
+//	cb.MessageNew(...)
+//
+//	http.HandleFunc("/callback", cb.HandleFunc)
+//	go http.ListenAndServe(":8080", nil)
+//
+//	_, err := cb.Setup(ctx, vk, groupID, "https://example.com/callback")
+func (cb *Callback) Setup(ctx context.Context, vk *api.VK, groupID int, serverURL string) (int, error) {
+	code, err := vk.GroupsGetCallbackConfirmationCodeContext(ctx, api.Params{
+		"group_id": groupID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	secretKey, err := cb.setupKeys(groupID, code.Code)
+	if err != nil {
+		return 0, err
+	}
+
+	serverID, err := cb.setupServer(ctx, vk, groupID, serverURL, secretKey)
+	if err != nil {
+		return 0, err
+	}
+
+	params := api.Params{
+		"group_id":    groupID,
+		"server_id":   serverID,
+		"api_version": vk.Version,
+	}
+
+	enabled := cb.ListEvents()
+	for _, eventType := range events.Types() {
+		params[eventType] = internal.Contains(enabled, eventType)
+	}
+
+	_, err = vk.GroupsSetCallbackSettingsContext(ctx, params)
+
+	return serverID, err
+}
+
+// setupKeys stores the confirmation code and returns the secret key
+// of the group.
+func (cb *Callback) setupKeys(groupID int, code string) (string, error) {
+	cb.mux.Lock()
+	defer cb.mux.Unlock()
+
+	if cb.ConfirmationKeys == nil {
+		cb.ConfirmationKeys = make(map[int]string)
+	}
+
+	cb.ConfirmationKeys[groupID] = code
+
+	secretKey := cb.SecretKeys[groupID]
+	if secretKey == "" {
+		secretKey = cb.SecretKey
+	}
+
+	if secretKey == "" {
+		b := make([]byte, secretKeyLength)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+
+		secretKey = hex.EncodeToString(b)
+
+		if cb.SecretKeys == nil {
+			cb.SecretKeys = make(map[int]string)
+		}
+
+		cb.SecretKeys[groupID] = secretKey
+	}
+
+	return secretKey, nil
+}
+
+// setupServer adds or edits the server and returns its ID.
+func (cb *Callback) setupServer(
+	ctx context.Context,
+	vk *api.VK,
+	groupID int,
+	serverURL, secretKey string,
+) (int, error) {
+	servers, err := vk.GroupsGetCallbackServersContext(ctx, api.Params{
+		"group_id": groupID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, server := range servers.Items {
+		if server.URL != serverURL {
+			continue
+		}
+
+		title := server.Title
+		if title == "" {
+			title = DefaultServerTitle
+		}
+
+		_, err = vk.GroupsEditCallbackServerContext(ctx, api.Params{
+			"group_id":   groupID,
+			"server_id":  server.ID,
+			"url":        serverURL,
+			"title":      title,
+			"secret_key": secretKey,
+		})
+
+		return server.ID, err
+	}
+
+	server, err := vk.GroupsAddCallbackServerContext(ctx, api.Params{
+		"group_id":   groupID,
+		"url":        serverURL,
+		"title":      DefaultServerTitle,
+		"secret_key": secretKey,
+	})
+
+	return server.ServerID, err
+}
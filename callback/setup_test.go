@@ -0,0 +1,134 @@
+package callback
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeVK struct {
+	servers string
+
+	mux    sync.Mutex
+	params map[string]url.Values
+}
+
+func (f *fakeVK) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+
+	method := strings.TrimPrefix(r.URL.Path, "/method/")
+
+	f.mux.Lock()
+	f.params[method] = r.Form
+	f.mux.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch method {
+	case "groups.getCallbackConfirmationCode":
+		fmt.Fprint(w, `{"response":{"code":"abc123"}}`)
+	case "groups.getCallbackServers":
+		fmt.Fprint(w, `{"response":`+f.servers+`}`)
+	case "groups.addCallbackServer":
+		fmt.Fprint(w, `{"response":{"server_id":7}}`)
+	default:
+		fmt.Fprint(w, `{"response":1}`)
+	}
+}
+
+func newFakeVK(t *testing.T, servers string) (*api.VK, *fakeVK, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeVK{
+		servers: servers,
+		params:  make(map[string]url.Values),
+	}
+	ts := httptest.NewServer(f)
+
+	vk := api.NewVK("token")
+	vk.MethodURL = ts.URL + "/method/"
+
+	return vk, f, ts
+}
+
+func TestCallback_Setup(t *testing.T) {
+	vk, f, ts := newFakeVK(t, `{"count":0,"items":[]}`)
+	defer ts.Close()
+
+	cb := NewCallback()
+	cb.MessageNew(func(obj object.MessageNewObject, groupID int) {})
+
+	serverID, err := cb.Setup(context.Background(), vk, 1, "https://example.com/cb")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, serverID)
+
+	assert.Equal(t, "abc123", cb.ConfirmationKeys[1])
+	assert.Len(t, cb.SecretKeys[1], secretKeyLength*2)
+
+	add := f.params["groups.addCallbackServer"]
+	assert.Equal(t, "https://example.com/cb", add.Get("url"))
+	assert.Equal(t, DefaultServerTitle, add.Get("title"))
+	assert.Equal(t, cb.SecretKeys[1], add.Get("secret_key"))
+
+	settings := f.params["groups.setCallbackSettings"]
+	assert.Equal(t, "7", settings.Get("server_id"))
+	assert.Equal(t, vk.Version, settings.Get("api_version"))
+	assert.Equal(t, "1", settings.Get("message_new"))
+	assert.Equal(t, "0", settings.Get("message_reply"))
+	assert.Equal(t, "0", settings.Get("like_add"))
+}
+
+func TestCallback_Setup_edit(t *testing.T) {
+	vk, f, ts := newFakeVK(t, `{"count":2,"items":[
+		{"id":3,"url":"https://example.com/other","title":"other"},
+		{"id":5,"url":"https://example.com/cb","title":"bot"}
+	]}`)
+	defer ts.Close()
+
+	cb := NewCallback()
+	cb.SecretKey = "secret"
+	cb.OnEvent("like_add", func(e object.GroupEvent) {})
+
+	serverID, err := cb.Setup(context.Background(), vk, 1, "https://example.com/cb")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, serverID)
+	assert.Empty(t, cb.SecretKeys[1])
+
+	assert.Nil(t, f.params["groups.addCallbackServer"])
+
+	edit := f.params["groups.editCallbackServer"]
+	assert.Equal(t, "5", edit.Get("server_id"))
+	assert.Equal(t, "bot", edit.Get("title"))
+	assert.Equal(t, "secret", edit.Get("secret_key"))
+
+	settings := f.params["groups.setCallbackSettings"]
+	assert.Equal(t, "5", settings.Get("server_id"))
+	assert.Equal(t, "1", settings.Get("like_add"))
+	assert.Equal(t, "0", settings.Get("message_new"))
+}
+
+func TestCallback_Setup_error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"error":{"error_code":15,"error_msg":"Access denied"}}`)
+	}))
+	defer ts.Close()
+
+	vk := api.NewVK("token")
+	vk.MethodURL = ts.URL + "/method/"
+
+	cb := NewCallback()
+
+	_, err := cb.Setup(context.Background(), vk, 1, "https://example.com/cb")
+	assert.Error(t, err)
+	assert.Empty(t, cb.ConfirmationKeys)
+}
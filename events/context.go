@@ -0,0 +1,87 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"context"
+	"time"
+
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// Transport is a way the event is received.
+type Transport int
+
+// Transports.
+const (
+	TransportUnknown Transport = iota
+	TransportCallback
+	TransportLongPoll
+)
+
+// String returns the name of the transport.
+func (t Transport) String() string {
+	switch t {
+	case TransportCallback:
+		return "callback"
+	case TransportLongPoll:
+		return "longpoll"
+	}
+
+	return "unknown"
+}
+
+type contextKey int
+
+const (
+	groupIDKey contextKey = iota
+	eventIDKey
+	transportKey
+	timeoutKey
+)
+
+// WithTransport returns a copy of ctx with the transport.
+func WithTransport(ctx context.Context, t Transport) context.Context {
+	return context.WithValue(ctx, transportKey, t)
+}
+
+// WithTimeout returns a copy of ctx with the timeout of handlers. FuncList
+// sets the deadline when it starts handling the event, so the time in
+// the queue of Dispatcher is not counted.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey, timeout)
+}
+
+// withDeadline returns a copy of ctx with the deadline set by WithTimeout.
+func withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, _ := ctx.Value(timeoutKey).(time.Duration)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// withEvent returns a copy of ctx with the group ID and the event ID.
+func withEvent(ctx context.Context, e object.GroupEvent) context.Context {
+	ctx = context.WithValue(ctx, groupIDKey, e.GroupID)
+	ctx = context.WithValue(ctx, eventIDKey, e.EventID)
+
+	return ctx
+}
+
+// GroupIDFromContext returns the ID of the group which received the event.
+func GroupIDFromContext(ctx context.Context) int {
+	groupID, _ := ctx.Value(groupIDKey).(int)
+	return groupID
+}
+
+// EventIDFromContext returns the ID of the event.
+func EventIDFromContext(ctx context.Context) string {
+	eventID, _ := ctx.Value(eventIDKey).(string)
+	return eventID
+}
+
+// TransportFromContext returns the transport of the event.
+func TransportFromContext(ctx context.Context) Transport {
+	t, _ := ctx.Value(transportKey).(Transport)
+	return t
+}
@@ -0,0 +1,86 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+func TestFuncList_HandlerContext(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler error")
+
+	var calls []string
+
+	fl := events.NewFuncList()
+	fl.OnEventContext(object.EventMessageNew, func(ctx context.Context, e object.GroupEvent) error {
+		calls = append(calls, "special")
+		return nil
+	})
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		calls = append(calls, "old")
+
+		assert.Equal(t, GID, groupID)
+	})
+	fl.MessageNewContext(func(ctx context.Context, obj object.MessageNewObject) error {
+		calls = append(calls, "new")
+
+		assert.Equal(t, GID, events.GroupIDFromContext(ctx))
+		assert.Equal(t, "abc", events.EventIDFromContext(ctx))
+		assert.Equal(t, events.TransportLongPoll, events.TransportFromContext(ctx))
+		assert.Equal(t, 1, obj.Message.PeerID)
+
+		return errHandler
+	})
+	fl.MessageNewContext(func(ctx context.Context, obj object.MessageNewObject) error {
+		calls = append(calls, "skipped")
+		return nil
+	})
+
+	ctx := events.WithTransport(context.Background(), events.TransportLongPoll)
+	err := fl.HandlerContext(ctx, object.GroupEvent{
+		Type:    object.EventMessageNew,
+		Object:  []byte(`{"message":{"peer_id":1}}`),
+		GroupID: GID,
+		EventID: "abc",
+	})
+
+	assert.Equal(t, errHandler, err)
+	assert.Equal(t, []string{"special", "old", "new"}, calls)
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	assert.Equal(t, 0, events.GroupIDFromContext(ctx))
+	assert.Equal(t, "", events.EventIDFromContext(ctx))
+	assert.Equal(t, events.TransportUnknown, events.TransportFromContext(ctx))
+
+	assert.Equal(t, "unknown", events.TransportUnknown.String())
+	assert.Equal(t, "callback", events.TransportCallback.String())
+	assert.Equal(t, "longpoll", events.TransportLongPoll.String())
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.OnEventContext("custom", func(ctx context.Context, e object.GroupEvent) error {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+
+		return nil
+	})
+
+	ctx := events.WithTimeout(context.Background(), time.Minute)
+	assert.NoError(t, fl.HandlerContext(ctx, object.GroupEvent{Type: "custom"}))
+}
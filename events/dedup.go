@@ -0,0 +1,114 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Default parameters of MemoryDedupStore.
+const (
+	DefaultDedupSize = 10000
+	DefaultDedupTTL  = time.Hour
+)
+
+// DedupStore remembers IDs of handled events.
+//
+// A store can be shared between Callback API and Long Poll API, e.g.
+// a store based on Redis SET NX EX and DEL.
+type DedupStore interface {
+	// Add remembers the event ID and reports whether it is new.
+	Add(ctx context.Context, eventID string) (bool, error)
+
+	// Remove forgets the event ID, so the event can be handled again.
+	Remove(ctx context.Context, eventID string) error
+}
+
+type dedupEntry struct {
+	eventID string
+	expires time.Time
+}
+
+// MemoryDedupStore is an in-memory LRU DedupStore with TTL.
+type MemoryDedupStore struct {
+	size int
+	ttl  time.Duration
+
+	items map[string]*list.Element
+	order *list.List // the front is the most recently used
+	mux   sync.Mutex
+}
+
+// NewMemoryDedupStore returns a new MemoryDedupStore which keeps up to size
+// event IDs for ttl. If size or ttl is zero, the default value is used.
+func NewMemoryDedupStore(size int, ttl time.Duration) *MemoryDedupStore {
+	if size <= 0 {
+		size = DefaultDedupSize
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultDedupTTL
+	}
+
+	return &MemoryDedupStore{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[string]*list.Element),
+		order: list.New(),
+	}
+}
+
+// Add remembers the event ID and reports whether it is new.
+func (s *MemoryDedupStore) Add(_ context.Context, eventID string) (bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	now := time.Now()
+
+	if elem, ok := s.items[eventID]; ok {
+		entry := elem.Value.(*dedupEntry)
+		if now.Before(entry.expires) {
+			s.order.MoveToFront(elem)
+			return false, nil
+		}
+
+		s.remove(elem)
+	}
+
+	s.items[eventID] = s.order.PushFront(&dedupEntry{
+		eventID: eventID,
+		expires: now.Add(s.ttl),
+	})
+
+	for s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+
+	return true, nil
+}
+
+// Remove forgets the event ID.
+func (s *MemoryDedupStore) Remove(_ context.Context, eventID string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if elem, ok := s.items[eventID]; ok {
+		s.remove(elem)
+	}
+
+	return nil
+}
+
+// Len returns the number of remembered event IDs.
+func (s *MemoryDedupStore) Len() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.order.Len()
+}
+
+func (s *MemoryDedupStore) remove(elem *list.Element) {
+	entry := s.order.Remove(elem).(*dedupEntry)
+	delete(s.items, entry.eventID)
+}
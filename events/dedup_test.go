@@ -0,0 +1,41 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/events"
+)
+
+func TestMemoryDedupStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := events.NewMemoryDedupStore(2, 50*time.Millisecond)
+
+	f := func(eventID string, want bool) {
+		t.Helper()
+
+		got, err := s.Add(ctx, eventID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, eventID)
+	}
+
+	f("a", true)
+	f("b", true)
+	f("a", false) // "a" is recently used
+	f("c", true)  // "b" is evicted
+	f("b", true)  // "a" is evicted
+	f("c", false)
+	assert.Equal(t, 2, s.Len())
+
+	assert.NoError(t, s.Remove(ctx, "c"))
+	f("c", true)
+
+	time.Sleep(50 * time.Millisecond)
+
+	f("b", true)
+}
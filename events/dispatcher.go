@@ -0,0 +1,350 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// EventHandler handles a group event.
+//
+// *FuncList implements EventHandler.
+type EventHandler interface {
+	HandlerContext(ctx context.Context, e object.GroupEvent) error
+}
+
+// PanicError is an error of a handler which panicked.
+type PanicError struct {
+	Event object.GroupEvent
+	Value interface{}
+	Stack []byte
+}
+
+// Error returns the message of PanicError.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("events: panic in %s handler: %v", e.Event.Type, e.Value)
+}
+
+// ErrDispatcherClosed is returned by Dispatch after Shutdown.
+var ErrDispatcherClosed = fmt.Errorf("events: dispatcher is shut down")
+
+// Dispatcher handles events in a bounded pool of goroutines.
+//
+// Dispatch blocks if all workers are busy and the queue is full, so
+// a slow handler slows down receiving of events instead of exhausting
+// memory.
+type Dispatcher struct {
+	// Workers is the number of goroutines.
+	Workers int
+
+	// QueueSize is the number of events waiting for a worker.
+	QueueSize int
+
+	// OrderByPeer handles events with the same peer in order
+	// of Dispatch calls. See PeerID.
+	OrderByPeer bool
+
+	// ErrorHandler is called on errors and panics of handlers.
+	ErrorHandler func(err error)
+
+	dispatched int64
+	handled    int64
+	rejected   int64
+
+	handler EventHandler
+	shared  chan dispatchedEvent
+	queues  []chan dispatchedEvent
+	done    chan struct{}
+	closed  bool
+	senders sync.WaitGroup
+	wg      sync.WaitGroup
+	mux     sync.RWMutex
+}
+
+type dispatchedEvent struct {
+	ctx context.Context
+	e   object.GroupEvent
+}
+
+// NewDispatcher returns a new Dispatcher with workers goroutines.
+//
+//	d := events.NewDispatcher(&lp.FuncList, 8)
+//	d.OrderByPeer = true
+func NewDispatcher(handler EventHandler, workers int) *Dispatcher {
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &Dispatcher{
+		Workers:   workers,
+		QueueSize: workers,
+		handler:   handler,
+	}
+}
+
+// start starts the workers if they are not running.
+func (d *Dispatcher) start() {
+	if d.shared != nil || d.closed {
+		return
+	}
+
+	workers := d.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	d.shared = make(chan dispatchedEvent, d.QueueSize)
+	d.done = make(chan struct{})
+	d.queues = make([]chan dispatchedEvent, workers)
+
+	for i := range d.queues {
+		d.queues[i] = make(chan dispatchedEvent, d.QueueSize)
+
+		d.wg.Add(1)
+
+		go d.work(d.queues[i], d.shared)
+	}
+}
+
+// work handles events from the own queue and the shared queue.
+func (d *Dispatcher) work(own, shared chan dispatchedEvent) {
+	defer d.wg.Done()
+
+	for own != nil || shared != nil {
+		select {
+		case de, ok := <-own:
+			if !ok {
+				own = nil
+				continue
+			}
+
+			d.handle(de.ctx, de.e)
+		case de, ok := <-shared:
+			if !ok {
+				shared = nil
+				continue
+			}
+
+			d.handle(de.ctx, de.e)
+		}
+	}
+}
+
+// handle calls the handler and recovers a panic. FuncList recovers
+// panics of each handler itself, so here are recovered only panics
+// of other EventHandler implementations.
+func (d *Dispatcher) handle(ctx context.Context, e object.GroupEvent) {
+	defer atomic.AddInt64(&d.handled, 1)
+
+	defer func() {
+		if r := recover(); r != nil {
+			d.handleError(&PanicError{
+				Event: e,
+				Value: r,
+				Stack: debug.Stack(),
+			})
+		}
+	}()
+
+	err := d.handler.HandlerContext(ctx, e)
+	if err != nil {
+		d.handleError(err)
+	}
+}
+
+func (d *Dispatcher) handleError(err error) {
+	if d.ErrorHandler != nil {
+		d.ErrorHandler(err)
+	}
+}
+
+// Dispatch passes the event to a worker.
+//
+// Workers are started on the first call. After Shutdown,
+// ErrDispatcherClosed is returned.
+func (d *Dispatcher) Dispatch(e object.GroupEvent) error {
+	return d.DispatchContext(context.Background(), e)
+}
+
+// DispatchContext passes the event and ctx to a worker.
+//
+// If Shutdown is called while DispatchContext waits for the queue,
+// ErrDispatcherClosed is returned.
+func (d *Dispatcher) DispatchContext(ctx context.Context, e object.GroupEvent) error {
+	queue, ok := d.acquire(e)
+	if !ok {
+		return ErrDispatcherClosed
+	}
+	defer d.senders.Done()
+
+	select {
+	case queue <- dispatchedEvent{ctx, e}:
+		atomic.AddInt64(&d.dispatched, 1)
+		return nil
+	case <-d.done:
+		return ErrDispatcherClosed
+	}
+}
+
+// TryDispatchContext passes the event and ctx to a worker if the queue
+// is not full. It reports whether the event is accepted. After Shutdown,
+// events are not accepted.
+func (d *Dispatcher) TryDispatchContext(ctx context.Context, e object.GroupEvent) bool {
+	queue, ok := d.acquire(e)
+	if !ok {
+		atomic.AddInt64(&d.rejected, 1)
+		return false
+	}
+	defer d.senders.Done()
+
+	select {
+	case queue <- dispatchedEvent{ctx, e}:
+		atomic.AddInt64(&d.dispatched, 1)
+		return true
+	default:
+		atomic.AddInt64(&d.rejected, 1)
+		return false
+	}
+}
+
+// acquire starts the workers and returns the queue for the event.
+// The caller is counted as a sender until it calls d.senders.Done, so
+// Shutdown does not close the queue before the event is sent. The lock
+// is not held while sending, so a full queue does not block Stats and
+// Shutdown. If the Dispatcher is shut down, false is returned.
+func (d *Dispatcher) acquire(e object.GroupEvent) (chan dispatchedEvent, bool) {
+	peerID := 0
+	if d.OrderByPeer {
+		peerID = PeerID(e)
+		if peerID < 0 {
+			peerID = -peerID
+		}
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.closed {
+		return nil, false
+	}
+
+	d.start()
+	d.senders.Add(1)
+
+	if peerID != 0 {
+		return d.queues[peerID%len(d.queues)], true
+	}
+
+	return d.shared, true
+}
+
+// DispatcherStats is a snapshot of Dispatcher counters.
+type DispatcherStats struct {
+	Dispatched int64 // accepted events
+	Handled    int64 // handled events
+	Rejected   int64 // events rejected by TryDispatchContext
+	Queued     int   // events waiting for a worker
+}
+
+// Stats returns a snapshot of the counters.
+func (d *Dispatcher) Stats() DispatcherStats {
+	d.mux.RLock()
+
+	queued := len(d.shared)
+	for _, queue := range d.queues {
+		queued += len(queue)
+	}
+
+	d.mux.RUnlock()
+
+	return DispatcherStats{
+		Dispatched: atomic.LoadInt64(&d.dispatched),
+		Handled:    atomic.LoadInt64(&d.handled),
+		Rejected:   atomic.LoadInt64(&d.rejected),
+		Queued:     queued,
+	}
+}
+
+// Shutdown waits until all dispatched events are handled and stops
+// the workers. The Dispatcher cannot be used after Shutdown.
+//
+// Blocked Dispatch calls return ErrDispatcherClosed. The lock is released
+// before waiting, so handlers can call Stats and Dispatch, which returns
+// ErrDispatcherClosed.
+func (d *Dispatcher) Shutdown() {
+	d.mux.Lock()
+
+	first := !d.closed
+	d.closed = true
+
+	if first && d.done != nil {
+		close(d.done)
+	}
+
+	d.mux.Unlock()
+
+	// No new senders after closed is set, so the queues can be closed
+	// when the current ones return.
+	d.senders.Wait()
+
+	d.mux.Lock()
+
+	if first && d.shared != nil {
+		close(d.shared)
+
+		for _, queue := range d.queues {
+			close(queue)
+		}
+	}
+
+	d.mux.Unlock()
+
+	d.wg.Wait()
+}
+
+// PeerID returns the ID of the conversation or the user which
+// the event belongs to. If the event does not belong to a peer,
+// 0 is returned.
+func PeerID(e object.GroupEvent) int {
+	var obj struct {
+		PeerID  int `json:"peer_id"`
+		UserID  int `json:"user_id"`
+		FromID  int `json:"from_id"`
+		Message struct {
+			PeerID int `json:"peer_id"`
+		} `json:"message"`
+	}
+
+	switch e.Type {
+	case object.EventMessageNew,
+		object.EventMessageReply,
+		object.EventMessageEdit,
+		object.EventMessageAllow,
+		object.EventMessageDeny,
+		object.EventMessageTypingState,
+		object.EventMessageRead,
+		object.EventMessageEvent:
+	default:
+		return 0
+	}
+
+	if err := json.Unmarshal(e.Object, &obj); err != nil {
+		return 0
+	}
+
+	switch {
+	case obj.Message.PeerID != 0:
+		return obj.Message.PeerID
+	case obj.PeerID != 0:
+		return obj.PeerID
+	case obj.UserID != 0:
+		return obj.UserID
+	}
+
+	return obj.FromID
+}
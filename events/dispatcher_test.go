@@ -0,0 +1,291 @@
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+func messageNew(peerID, id int) object.GroupEvent {
+	return object.GroupEvent{
+		Type:   object.EventMessageNew,
+		Object: []byte(fmt.Sprintf(`{"message":{"peer_id":%d,"id":%d}}`, peerID, id)),
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		running, maxRunning int32
+		handled             int32
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&handled, 1)
+	})
+
+	d := events.NewDispatcher(fl, 4)
+
+	for i := 0; i < 20; i++ {
+		assert.NoError(t, d.Dispatch(messageNew(i, i)))
+	}
+
+	d.Shutdown()
+
+	assert.Equal(t, int32(20), handled)
+	assert.True(t, maxRunning > 1 && maxRunning <= 4)
+
+	// the dispatcher cannot be used after Shutdown
+	assert.Equal(t, events.ErrDispatcherClosed, d.Dispatch(messageNew(1, 1)))
+	assert.False(t, d.TryDispatchContext(context.Background(), messageNew(1, 1)))
+	d.Shutdown()
+
+	assert.Equal(t, int32(20), handled)
+}
+
+func TestDispatcher_ShutdownFromHandler(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var (
+		d   *events.Dispatcher
+		err error
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		close(started)
+		<-release
+
+		_ = d.Stats()
+		err = d.Dispatch(messageNew(2, 2))
+	})
+
+	d = events.NewDispatcher(fl, 1)
+	assert.NoError(t, d.Dispatch(messageNew(1, 1)))
+
+	<-started
+
+	done := make(chan struct{})
+
+	go func() {
+		d.Shutdown()
+		close(done)
+	}()
+
+	// Shutdown closes the queues before the handler continues
+	for d.Dispatch(messageNew(3, 3)) == nil {
+		time.Sleep(time.Millisecond)
+	}
+
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown deadlocked")
+	}
+
+	assert.Equal(t, events.ErrDispatcherClosed, err)
+}
+
+func TestDispatcher_ShutdownFullQueue(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var (
+		d    *events.Dispatcher
+		err  error
+		once sync.Once
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		once.Do(func() {
+			close(started)
+			<-release
+
+			_ = d.Stats()
+			err = d.Dispatch(messageNew(4, 4))
+		})
+	})
+
+	d = events.NewDispatcher(fl, 1)
+	d.QueueSize = 1
+
+	assert.NoError(t, d.Dispatch(messageNew(1, 1)))
+	<-started
+	assert.NoError(t, d.Dispatch(messageNew(2, 2)))
+
+	// the queue is full, so the sender is blocked
+	blocked := make(chan error)
+
+	go func() {
+		blocked <- d.Dispatch(messageNew(3, 3))
+	}()
+
+	done := make(chan struct{})
+
+	go func() {
+		d.Shutdown()
+		close(done)
+	}()
+
+	assert.Equal(t, events.ErrDispatcherClosed, <-blocked)
+
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown deadlocked")
+	}
+
+	assert.Equal(t, events.ErrDispatcherClosed, err)
+	assert.Equal(t, int64(2), d.Stats().Handled)
+}
+
+func TestDispatcher_OrderByPeer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		got = make(map[int][]int)
+		mux sync.Mutex
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		time.Sleep(time.Millisecond)
+
+		mux.Lock()
+		got[obj.Message.PeerID] = append(got[obj.Message.PeerID], obj.Message.ID)
+		mux.Unlock()
+	})
+
+	d := events.NewDispatcher(fl, 3)
+	d.OrderByPeer = true
+
+	want := make(map[int][]int)
+
+	for i := 0; i < 30; i++ {
+		peerID := 2000000000 + i%5
+		want[peerID] = append(want[peerID], i)
+		assert.NoError(t, d.Dispatch(messageNew(peerID, i)))
+	}
+
+	d.Shutdown()
+
+	assert.Equal(t, want, got)
+}
+
+func TestDispatcher_errors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errs []error
+		mux  sync.Mutex
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		panic("test")
+	})
+
+	d := events.NewDispatcher(fl, 2)
+	d.ErrorHandler = func(err error) {
+		mux.Lock()
+		errs = append(errs, err)
+		mux.Unlock()
+	}
+
+	assert.NoError(t, d.Dispatch(messageNew(1, 1)))
+	assert.NoError(t, d.Dispatch(object.GroupEvent{Type: object.EventMessageNew, Object: []byte("1")}))
+	d.Shutdown()
+
+	assert.Len(t, errs, 2)
+
+	for _, err := range errs {
+		if panicErr, ok := err.(*events.PanicError); ok {
+			assert.Equal(t, "test", panicErr.Value)
+			assert.NotEmpty(t, panicErr.Stack)
+			assert.Equal(t, "events: panic in message_new handler: test", panicErr.Error())
+		}
+	}
+}
+
+func TestDispatcher_panicHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errs   []error
+		called int32
+	)
+
+	fl := events.NewFuncList()
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		panic("test")
+	})
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		atomic.AddInt32(&called, 1)
+	})
+
+	d := events.NewDispatcher(fl, 1)
+	d.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+	}
+
+	assert.NoError(t, d.Dispatch(messageNew(1, 1)))
+	d.Shutdown()
+
+	// the panic does not skip the next handler
+	assert.Equal(t, int32(1), called)
+
+	if assert.Len(t, errs, 1) {
+		assert.IsType(t, &events.PanicError{}, errs[0])
+	}
+}
+
+func TestPeerID(t *testing.T) {
+	t.Parallel()
+
+	f := func(eventType, obj string, want int) {
+		t.Helper()
+
+		got := events.PeerID(object.GroupEvent{Type: eventType, Object: []byte(obj)})
+		assert.Equal(t, want, got)
+	}
+
+	f(object.EventMessageNew, `{"message":{"peer_id":1}}`, 1)
+	f(object.EventMessageNew, `{"peer_id":2}`, 2)
+	f(object.EventMessageReply, `{"peer_id":3}`, 3)
+	f(object.EventMessageAllow, `{"user_id":4}`, 4)
+	f(object.EventMessageTypingState, `{"from_id":5,"to_id":-1}`, 5)
+	f(object.EventMessageRead, `{"from_id":6,"peer_id":7}`, 7)
+	f(object.EventWallPostNew, `{"owner_id":-1}`, 0)
+	f(object.EventMessageNew, `[]`, 0)
+}
@@ -6,71 +6,106 @@ See more https://vk.com/dev/groups_events
 package events // import "github.com/SevereCloud/vksdk/events"
 
 import (
+	"context"
 	"encoding/json"
+	"runtime/debug"
 
 	"github.com/SevereCloud/vksdk/object"
 )
 
 // FuncList struct.
 type FuncList struct {
-	messageNew           []object.MessageNewFunc
-	messageReply         []object.MessageReplyFunc
-	messageEdit          []object.MessageEditFunc
-	messageAllow         []object.MessageAllowFunc
-	messageDeny          []object.MessageDenyFunc
-	messageTypingState   []object.MessageTypingStateFunc
-	photoNew             []object.PhotoNewFunc
-	photoCommentNew      []object.PhotoCommentNewFunc
-	photoCommentEdit     []object.PhotoCommentEditFunc
-	photoCommentRestore  []object.PhotoCommentRestoreFunc
-	photoCommentDelete   []object.PhotoCommentDeleteFunc
-	audioNew             []object.AudioNewFunc
-	videoNew             []object.VideoNewFunc
-	videoCommentNew      []object.VideoCommentNewFunc
-	videoCommentEdit     []object.VideoCommentEditFunc
-	videoCommentRestore  []object.VideoCommentRestoreFunc
-	videoCommentDelete   []object.VideoCommentDeleteFunc
-	wallPostNew          []object.WallPostNewFunc
-	wallRepost           []object.WallRepostFunc
-	wallReplyNew         []object.WallReplyNewFunc
-	wallReplyEdit        []object.WallReplyEditFunc
-	wallReplyRestore     []object.WallReplyRestoreFunc
-	wallReplyDelete      []object.WallReplyDeleteFunc
-	boardPostNew         []object.BoardPostNewFunc
-	boardPostEdit        []object.BoardPostEditFunc
-	boardPostRestore     []object.BoardPostRestoreFunc
-	boardPostDelete      []object.BoardPostDeleteFunc
-	marketCommentNew     []object.MarketCommentNewFunc
-	marketCommentEdit    []object.MarketCommentEditFunc
-	marketCommentRestore []object.MarketCommentRestoreFunc
-	marketCommentDelete  []object.MarketCommentDeleteFunc
-	groupLeave           []object.GroupLeaveFunc
-	groupJoin            []object.GroupJoinFunc
-	userBlock            []object.UserBlockFunc
-	userUnblock          []object.UserUnblockFunc
-	pollVoteNew          []object.PollVoteNewFunc
-	groupOfficersEdit    []object.GroupOfficersEditFunc
-	groupChangeSettings  []object.GroupChangeSettingsFunc
-	groupChangePhoto     []object.GroupChangePhotoFunc
-	vkpayTransaction     []object.VkpayTransactionFunc
-	leadFormsNew         []object.LeadFormsNewFunc
-	appPayload           []object.AppPayloadFunc
-	messageRead          []object.MessageReadFunc
-	special              map[string][]func(object.GroupEvent)
+	messageNew           []MessageNewFunc
+	messageReply         []MessageReplyFunc
+	messageEdit          []MessageEditFunc
+	messageAllow         []MessageAllowFunc
+	messageDeny          []MessageDenyFunc
+	messageTypingState   []MessageTypingStateFunc
+	photoNew             []PhotoNewFunc
+	photoCommentNew      []PhotoCommentNewFunc
+	photoCommentEdit     []PhotoCommentEditFunc
+	photoCommentRestore  []PhotoCommentRestoreFunc
+	photoCommentDelete   []PhotoCommentDeleteFunc
+	audioNew             []AudioNewFunc
+	videoNew             []VideoNewFunc
+	videoCommentNew      []VideoCommentNewFunc
+	videoCommentEdit     []VideoCommentEditFunc
+	videoCommentRestore  []VideoCommentRestoreFunc
+	videoCommentDelete   []VideoCommentDeleteFunc
+	wallPostNew          []WallPostNewFunc
+	wallRepost           []WallRepostFunc
+	wallReplyNew         []WallReplyNewFunc
+	wallReplyEdit        []WallReplyEditFunc
+	wallReplyRestore     []WallReplyRestoreFunc
+	wallReplyDelete      []WallReplyDeleteFunc
+	boardPostNew         []BoardPostNewFunc
+	boardPostEdit        []BoardPostEditFunc
+	boardPostRestore     []BoardPostRestoreFunc
+	boardPostDelete      []BoardPostDeleteFunc
+	marketCommentNew     []MarketCommentNewFunc
+	marketCommentEdit    []MarketCommentEditFunc
+	marketCommentRestore []MarketCommentRestoreFunc
+	marketCommentDelete  []MarketCommentDeleteFunc
+	groupLeave           []GroupLeaveFunc
+	groupJoin            []GroupJoinFunc
+	userBlock            []UserBlockFunc
+	userUnblock          []UserUnblockFunc
+	pollVoteNew          []PollVoteNewFunc
+	groupOfficersEdit    []GroupOfficersEditFunc
+	groupChangeSettings  []GroupChangeSettingsFunc
+	groupChangePhoto     []GroupChangePhotoFunc
+	vkpayTransaction     []VkpayTransactionFunc
+	leadFormsNew         []LeadFormsNewFunc
+	appPayload           []AppPayloadFunc
+	messageRead          []MessageReadFunc
+	messageEvent         []MessageEventFunc
+	special              map[string][]EventFunc
+	middlewares          []Middleware
 }
 
 // NewFuncList returns a new FuncList.
 func NewFuncList() *FuncList {
 	return &FuncList{
-		special: make(map[string][]func(object.GroupEvent)),
+		special: make(map[string][]EventFunc),
 	}
 }
 
 // Handler group event handler.
-func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
+func (fl FuncList) Handler(e object.GroupEvent) error {
+	return fl.HandlerContext(context.Background(), e)
+}
+
+// HandlerContext group event handler with a context.
+//
+// The group ID and the event ID are added to ctx. Handlers are called
+// in order of registration until one of them returns an error. A panic
+// of a handler does not stop the next handlers and is returned
+// as *PanicError.
+// Middlewares added by Use wrap all handlers. If ctx carries a timeout
+// set by WithTimeout, the deadline is set here.
+func (fl FuncList) HandlerContext(ctx context.Context, e object.GroupEvent) error {
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+
+	ctx = withEvent(ctx, e)
+
+	handler := fl.handle
+	for i := len(fl.middlewares) - 1; i >= 0; i-- {
+		handler = fl.middlewares[i](handler)
+	}
+
+	return handler(ctx, e)
+}
+
+// handle calls handlers of the event.
+func (fl FuncList) handle(ctx context.Context, e object.GroupEvent) error { // nolint:gocyclo
+	var panicErr error
+
 	if sliceFunc, ok := fl.special[e.Type]; ok {
 		for _, f := range sliceFunc {
-			f(e)
+			if err := call(e, &panicErr, func() error { return f(ctx, e) }); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -82,7 +117,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageReply:
 		var obj object.MessageReplyObject
@@ -91,7 +128,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageReply {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageEdit:
 		var obj object.MessageEditObject
@@ -100,7 +139,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageAllow:
 		var obj object.MessageAllowObject
@@ -109,7 +150,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageAllow {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageDeny:
 		var obj object.MessageDenyObject
@@ -118,7 +161,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageDeny {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageTypingState: // На основе ответа
 		var obj object.MessageTypingStateObject
@@ -127,7 +172,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageTypingState {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPhotoNew:
 		var obj object.PhotoNewObject
@@ -136,7 +183,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.photoNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPhotoCommentNew:
 		var obj object.PhotoCommentNewObject
@@ -145,7 +194,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.photoCommentNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPhotoCommentEdit:
 		var obj object.PhotoCommentEditObject
@@ -154,7 +205,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.photoCommentEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPhotoCommentRestore:
 		var obj object.PhotoCommentRestoreObject
@@ -163,7 +216,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.photoCommentRestore {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPhotoCommentDelete:
 		var obj object.PhotoCommentDeleteObject
@@ -172,7 +227,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.photoCommentDelete {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventAudioNew:
 		var obj object.AudioNewObject
@@ -181,7 +238,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.audioNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVideoNew:
 		var obj object.VideoNewObject
@@ -190,7 +249,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.videoNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVideoCommentNew:
 		var obj object.VideoCommentNewObject
@@ -199,7 +260,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.videoCommentNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVideoCommentEdit:
 		var obj object.VideoCommentEditObject
@@ -208,7 +271,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.videoCommentEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVideoCommentRestore:
 		var obj object.VideoCommentRestoreObject
@@ -217,7 +282,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.videoCommentRestore {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVideoCommentDelete:
 		var obj object.VideoCommentDeleteObject
@@ -226,7 +293,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.videoCommentDelete {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallPostNew:
 		var obj object.WallPostNewObject
@@ -235,7 +304,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallPostNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallRepost:
 		var obj object.WallRepostObject
@@ -244,7 +315,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallRepost {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallReplyNew:
 		var obj object.WallReplyNewObject
@@ -253,7 +326,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallReplyNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallReplyEdit:
 		var obj object.WallReplyEditObject
@@ -262,7 +337,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallReplyEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallReplyRestore:
 		var obj object.WallReplyRestoreObject
@@ -271,7 +348,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallReplyRestore {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventWallReplyDelete:
 		var obj object.WallReplyDeleteObject
@@ -280,7 +359,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.wallReplyDelete {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventBoardPostNew:
 		var obj object.BoardPostNewObject
@@ -289,7 +370,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.boardPostNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventBoardPostEdit:
 		var obj object.BoardPostEditObject
@@ -298,7 +381,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.boardPostEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventBoardPostRestore:
 		var obj object.BoardPostRestoreObject
@@ -307,7 +392,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.boardPostRestore {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventBoardPostDelete:
 		var obj object.BoardPostDeleteObject
@@ -316,7 +403,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.boardPostDelete {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMarketCommentNew:
 		var obj object.MarketCommentNewObject
@@ -325,7 +414,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.marketCommentNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMarketCommentEdit:
 		var obj object.MarketCommentEditObject
@@ -334,7 +425,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.marketCommentEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMarketCommentRestore:
 		var obj object.MarketCommentRestoreObject
@@ -343,7 +436,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.marketCommentRestore {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMarketCommentDelete:
 		var obj object.MarketCommentDeleteObject
@@ -352,7 +447,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.marketCommentDelete {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventGroupLeave:
 		var obj object.GroupLeaveObject
@@ -361,7 +458,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.groupLeave {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventGroupJoin:
 		var obj object.GroupJoinObject
@@ -370,7 +469,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.groupJoin {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventUserBlock:
 		var obj object.UserBlockObject
@@ -379,7 +480,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.userBlock {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventUserUnblock:
 		var obj object.UserUnblockObject
@@ -388,7 +491,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.userUnblock {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventPollVoteNew:
 		var obj object.PollVoteNewObject
@@ -397,7 +502,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.pollVoteNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventGroupOfficersEdit:
 		var obj object.GroupOfficersEditObject
@@ -406,7 +513,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.groupOfficersEdit {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventGroupChangeSettings:
 		var obj object.GroupChangeSettingsObject
@@ -415,7 +524,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.groupChangeSettings {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventGroupChangePhoto:
 		var obj object.GroupChangePhotoObject
@@ -424,7 +535,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.groupChangePhoto {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventVkpayTransaction:
 		var obj object.VkpayTransactionObject
@@ -433,7 +546,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.vkpayTransaction {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventLeadFormsNew:
 		var obj object.LeadFormsNewObject
@@ -442,7 +557,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.leadFormsNew {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventAppPayload:
 		var obj object.AppPayloadObject
@@ -451,7 +568,9 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.appPayload {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	case object.EventMessageRead:
 		var obj object.MessageReadObject
@@ -460,17 +579,65 @@ func (fl FuncList) Handler(e object.GroupEvent) error { // nolint:gocyclo
 		}
 
 		for _, f := range fl.messageRead {
-			f(obj, e.GroupID)
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
+		}
+	case object.EventMessageEvent:
+		var obj object.MessageEventObject
+		if err := json.Unmarshal(e.Object, &obj); err != nil {
+			return err
+		}
+
+		for _, f := range fl.messageEvent {
+			if err := call(e, &panicErr, func() error { return f(ctx, obj) }); err != nil {
+				return err
+			}
 		}
 	}
 	// NOTE: like_add like_remove
-	return nil
+	return panicErr
+}
+
+// call calls the handler and recovers its panic, so the next handlers
+// are called anyway. The first panic is saved to panicErr as *PanicError.
+// If the handler returns an error, the first panic or the error is
+// returned.
+func call(e object.GroupEvent, panicErr *error, f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if *panicErr == nil {
+				*panicErr = &PanicError{
+					Event: e,
+					Value: r,
+					Stack: debug.Stack(),
+				}
+			}
+
+			err = nil
+		}
+	}()
+
+	err = f()
+	if err != nil && *panicErr != nil {
+		return *panicErr
+	}
+
+	return err
 }
 
 // OnEvent handler.
 func (fl *FuncList) OnEvent(eventType string, f func(object.GroupEvent)) {
+	fl.OnEventContext(eventType, func(_ context.Context, e object.GroupEvent) error {
+		f(e)
+		return nil
+	})
+}
+
+// OnEventContext handler.
+func (fl *FuncList) OnEventContext(eventType string, f EventFunc) {
 	if fl.special == nil {
-		fl.special = make(map[string][]func(object.GroupEvent))
+		fl.special = make(map[string][]EventFunc)
 	}
 
 	fl.special[eventType] = append(fl.special[eventType], f)
@@ -478,217 +645,574 @@ func (fl *FuncList) OnEvent(eventType string, f func(object.GroupEvent)) {
 
 // MessageNew handler.
 func (fl *FuncList) MessageNew(f object.MessageNewFunc) {
+	fl.messageNew = append(fl.messageNew, func(ctx context.Context, obj object.MessageNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageNewContext handler.
+func (fl *FuncList) MessageNewContext(f MessageNewFunc) {
 	fl.messageNew = append(fl.messageNew, f)
 }
 
 // MessageReply handler.
 func (fl *FuncList) MessageReply(f object.MessageReplyFunc) {
+	fl.messageReply = append(fl.messageReply, func(ctx context.Context, obj object.MessageReplyObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageReplyContext handler.
+func (fl *FuncList) MessageReplyContext(f MessageReplyFunc) {
 	fl.messageReply = append(fl.messageReply, f)
 }
 
 // MessageEdit handler.
 func (fl *FuncList) MessageEdit(f object.MessageEditFunc) {
+	fl.messageEdit = append(fl.messageEdit, func(ctx context.Context, obj object.MessageEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageEditContext handler.
+func (fl *FuncList) MessageEditContext(f MessageEditFunc) {
 	fl.messageEdit = append(fl.messageEdit, f)
 }
 
 // MessageAllow handler.
 func (fl *FuncList) MessageAllow(f object.MessageAllowFunc) {
+	fl.messageAllow = append(fl.messageAllow, func(ctx context.Context, obj object.MessageAllowObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageAllowContext handler.
+func (fl *FuncList) MessageAllowContext(f MessageAllowFunc) {
 	fl.messageAllow = append(fl.messageAllow, f)
 }
 
 // MessageDeny handler.
 func (fl *FuncList) MessageDeny(f object.MessageDenyFunc) {
+	fl.messageDeny = append(fl.messageDeny, func(ctx context.Context, obj object.MessageDenyObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageDenyContext handler.
+func (fl *FuncList) MessageDenyContext(f MessageDenyFunc) {
 	fl.messageDeny = append(fl.messageDeny, f)
 }
 
 // MessageTypingState handler.
 func (fl *FuncList) MessageTypingState(f object.MessageTypingStateFunc) {
+	fl.messageTypingState = append(fl.messageTypingState, func(ctx context.Context, obj object.MessageTypingStateObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageTypingStateContext handler.
+func (fl *FuncList) MessageTypingStateContext(f MessageTypingStateFunc) {
 	fl.messageTypingState = append(fl.messageTypingState, f)
 }
 
 // PhotoNew handler.
 func (fl *FuncList) PhotoNew(f object.PhotoNewFunc) {
+	fl.photoNew = append(fl.photoNew, func(ctx context.Context, obj object.PhotoNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PhotoNewContext handler.
+func (fl *FuncList) PhotoNewContext(f PhotoNewFunc) {
 	fl.photoNew = append(fl.photoNew, f)
 }
 
 // PhotoCommentNew handler.
 func (fl *FuncList) PhotoCommentNew(f object.PhotoCommentNewFunc) {
+	fl.photoCommentNew = append(fl.photoCommentNew, func(ctx context.Context, obj object.PhotoCommentNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PhotoCommentNewContext handler.
+func (fl *FuncList) PhotoCommentNewContext(f PhotoCommentNewFunc) {
 	fl.photoCommentNew = append(fl.photoCommentNew, f)
 }
 
 // PhotoCommentEdit handler.
 func (fl *FuncList) PhotoCommentEdit(f object.PhotoCommentEditFunc) {
+	fl.photoCommentEdit = append(fl.photoCommentEdit, func(ctx context.Context, obj object.PhotoCommentEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PhotoCommentEditContext handler.
+func (fl *FuncList) PhotoCommentEditContext(f PhotoCommentEditFunc) {
 	fl.photoCommentEdit = append(fl.photoCommentEdit, f)
 }
 
 // PhotoCommentRestore handler.
 func (fl *FuncList) PhotoCommentRestore(f object.PhotoCommentRestoreFunc) {
+	fl.photoCommentRestore = append(fl.photoCommentRestore, func(ctx context.Context, obj object.PhotoCommentRestoreObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PhotoCommentRestoreContext handler.
+func (fl *FuncList) PhotoCommentRestoreContext(f PhotoCommentRestoreFunc) {
 	fl.photoCommentRestore = append(fl.photoCommentRestore, f)
 }
 
 // PhotoCommentDelete handler.
 func (fl *FuncList) PhotoCommentDelete(f object.PhotoCommentDeleteFunc) {
+	fl.photoCommentDelete = append(fl.photoCommentDelete, func(ctx context.Context, obj object.PhotoCommentDeleteObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PhotoCommentDeleteContext handler.
+func (fl *FuncList) PhotoCommentDeleteContext(f PhotoCommentDeleteFunc) {
 	fl.photoCommentDelete = append(fl.photoCommentDelete, f)
 }
 
 // AudioNew handler.
 func (fl *FuncList) AudioNew(f object.AudioNewFunc) {
+	fl.audioNew = append(fl.audioNew, func(ctx context.Context, obj object.AudioNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// AudioNewContext handler.
+func (fl *FuncList) AudioNewContext(f AudioNewFunc) {
 	fl.audioNew = append(fl.audioNew, f)
 }
 
 // VideoNew handler.
 func (fl *FuncList) VideoNew(f object.VideoNewFunc) {
+	fl.videoNew = append(fl.videoNew, func(ctx context.Context, obj object.VideoNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VideoNewContext handler.
+func (fl *FuncList) VideoNewContext(f VideoNewFunc) {
 	fl.videoNew = append(fl.videoNew, f)
 }
 
 // VideoCommentNew handler.
 func (fl *FuncList) VideoCommentNew(f object.VideoCommentNewFunc) {
+	fl.videoCommentNew = append(fl.videoCommentNew, func(ctx context.Context, obj object.VideoCommentNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VideoCommentNewContext handler.
+func (fl *FuncList) VideoCommentNewContext(f VideoCommentNewFunc) {
 	fl.videoCommentNew = append(fl.videoCommentNew, f)
 }
 
 // VideoCommentEdit handler.
 func (fl *FuncList) VideoCommentEdit(f object.VideoCommentEditFunc) {
+	fl.videoCommentEdit = append(fl.videoCommentEdit, func(ctx context.Context, obj object.VideoCommentEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VideoCommentEditContext handler.
+func (fl *FuncList) VideoCommentEditContext(f VideoCommentEditFunc) {
 	fl.videoCommentEdit = append(fl.videoCommentEdit, f)
 }
 
 // VideoCommentRestore handler.
 func (fl *FuncList) VideoCommentRestore(f object.VideoCommentRestoreFunc) {
+	fl.videoCommentRestore = append(fl.videoCommentRestore, func(ctx context.Context, obj object.VideoCommentRestoreObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VideoCommentRestoreContext handler.
+func (fl *FuncList) VideoCommentRestoreContext(f VideoCommentRestoreFunc) {
 	fl.videoCommentRestore = append(fl.videoCommentRestore, f)
 }
 
 // VideoCommentDelete handler.
 func (fl *FuncList) VideoCommentDelete(f object.VideoCommentDeleteFunc) {
+	fl.videoCommentDelete = append(fl.videoCommentDelete, func(ctx context.Context, obj object.VideoCommentDeleteObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VideoCommentDeleteContext handler.
+func (fl *FuncList) VideoCommentDeleteContext(f VideoCommentDeleteFunc) {
 	fl.videoCommentDelete = append(fl.videoCommentDelete, f)
 }
 
 // WallPostNew handler.
 func (fl *FuncList) WallPostNew(f object.WallPostNewFunc) {
+	fl.wallPostNew = append(fl.wallPostNew, func(ctx context.Context, obj object.WallPostNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallPostNewContext handler.
+func (fl *FuncList) WallPostNewContext(f WallPostNewFunc) {
 	fl.wallPostNew = append(fl.wallPostNew, f)
 }
 
 // WallRepost handler.
 func (fl *FuncList) WallRepost(f object.WallRepostFunc) {
+	fl.wallRepost = append(fl.wallRepost, func(ctx context.Context, obj object.WallRepostObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallRepostContext handler.
+func (fl *FuncList) WallRepostContext(f WallRepostFunc) {
 	fl.wallRepost = append(fl.wallRepost, f)
 }
 
 // WallReplyNew handler.
 func (fl *FuncList) WallReplyNew(f object.WallReplyNewFunc) {
+	fl.wallReplyNew = append(fl.wallReplyNew, func(ctx context.Context, obj object.WallReplyNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallReplyNewContext handler.
+func (fl *FuncList) WallReplyNewContext(f WallReplyNewFunc) {
 	fl.wallReplyNew = append(fl.wallReplyNew, f)
 }
 
 // WallReplyEdit handler.
 func (fl *FuncList) WallReplyEdit(f object.WallReplyEditFunc) {
+	fl.wallReplyEdit = append(fl.wallReplyEdit, func(ctx context.Context, obj object.WallReplyEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallReplyEditContext handler.
+func (fl *FuncList) WallReplyEditContext(f WallReplyEditFunc) {
 	fl.wallReplyEdit = append(fl.wallReplyEdit, f)
 }
 
 // WallReplyRestore handler.
 func (fl *FuncList) WallReplyRestore(f object.WallReplyRestoreFunc) {
+	fl.wallReplyRestore = append(fl.wallReplyRestore, func(ctx context.Context, obj object.WallReplyRestoreObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallReplyRestoreContext handler.
+func (fl *FuncList) WallReplyRestoreContext(f WallReplyRestoreFunc) {
 	fl.wallReplyRestore = append(fl.wallReplyRestore, f)
 }
 
 // WallReplyDelete handler.
 func (fl *FuncList) WallReplyDelete(f object.WallReplyDeleteFunc) {
+	fl.wallReplyDelete = append(fl.wallReplyDelete, func(ctx context.Context, obj object.WallReplyDeleteObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// WallReplyDeleteContext handler.
+func (fl *FuncList) WallReplyDeleteContext(f WallReplyDeleteFunc) {
 	fl.wallReplyDelete = append(fl.wallReplyDelete, f)
 }
 
 // BoardPostNew handler.
 func (fl *FuncList) BoardPostNew(f object.BoardPostNewFunc) {
+	fl.boardPostNew = append(fl.boardPostNew, func(ctx context.Context, obj object.BoardPostNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// BoardPostNewContext handler.
+func (fl *FuncList) BoardPostNewContext(f BoardPostNewFunc) {
 	fl.boardPostNew = append(fl.boardPostNew, f)
 }
 
 // BoardPostEdit handler.
 func (fl *FuncList) BoardPostEdit(f object.BoardPostEditFunc) {
+	fl.boardPostEdit = append(fl.boardPostEdit, func(ctx context.Context, obj object.BoardPostEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// BoardPostEditContext handler.
+func (fl *FuncList) BoardPostEditContext(f BoardPostEditFunc) {
 	fl.boardPostEdit = append(fl.boardPostEdit, f)
 }
 
 // BoardPostRestore handler.
 func (fl *FuncList) BoardPostRestore(f object.BoardPostRestoreFunc) {
+	fl.boardPostRestore = append(fl.boardPostRestore, func(ctx context.Context, obj object.BoardPostRestoreObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// BoardPostRestoreContext handler.
+func (fl *FuncList) BoardPostRestoreContext(f BoardPostRestoreFunc) {
 	fl.boardPostRestore = append(fl.boardPostRestore, f)
 }
 
 // BoardPostDelete handler.
 func (fl *FuncList) BoardPostDelete(f object.BoardPostDeleteFunc) {
+	fl.boardPostDelete = append(fl.boardPostDelete, func(ctx context.Context, obj object.BoardPostDeleteObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// BoardPostDeleteContext handler.
+func (fl *FuncList) BoardPostDeleteContext(f BoardPostDeleteFunc) {
 	fl.boardPostDelete = append(fl.boardPostDelete, f)
 }
 
 // MarketCommentNew handler.
 func (fl *FuncList) MarketCommentNew(f object.MarketCommentNewFunc) {
+	fl.marketCommentNew = append(fl.marketCommentNew, func(ctx context.Context, obj object.MarketCommentNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MarketCommentNewContext handler.
+func (fl *FuncList) MarketCommentNewContext(f MarketCommentNewFunc) {
 	fl.marketCommentNew = append(fl.marketCommentNew, f)
 }
 
 // MarketCommentEdit handler.
 func (fl *FuncList) MarketCommentEdit(f object.MarketCommentEditFunc) {
+	fl.marketCommentEdit = append(fl.marketCommentEdit, func(ctx context.Context, obj object.MarketCommentEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MarketCommentEditContext handler.
+func (fl *FuncList) MarketCommentEditContext(f MarketCommentEditFunc) {
 	fl.marketCommentEdit = append(fl.marketCommentEdit, f)
 }
 
 // MarketCommentRestore handler.
 func (fl *FuncList) MarketCommentRestore(f object.MarketCommentRestoreFunc) {
+	fl.marketCommentRestore = append(fl.marketCommentRestore, func(ctx context.Context, obj object.MarketCommentRestoreObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MarketCommentRestoreContext handler.
+func (fl *FuncList) MarketCommentRestoreContext(f MarketCommentRestoreFunc) {
 	fl.marketCommentRestore = append(fl.marketCommentRestore, f)
 }
 
 // MarketCommentDelete handler.
 func (fl *FuncList) MarketCommentDelete(f object.MarketCommentDeleteFunc) {
+	fl.marketCommentDelete = append(fl.marketCommentDelete, func(ctx context.Context, obj object.MarketCommentDeleteObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MarketCommentDeleteContext handler.
+func (fl *FuncList) MarketCommentDeleteContext(f MarketCommentDeleteFunc) {
 	fl.marketCommentDelete = append(fl.marketCommentDelete, f)
 }
 
 // GroupLeave handler.
 func (fl *FuncList) GroupLeave(f object.GroupLeaveFunc) {
+	fl.groupLeave = append(fl.groupLeave, func(ctx context.Context, obj object.GroupLeaveObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// GroupLeaveContext handler.
+func (fl *FuncList) GroupLeaveContext(f GroupLeaveFunc) {
 	fl.groupLeave = append(fl.groupLeave, f)
 }
 
 // GroupJoin handler.
 func (fl *FuncList) GroupJoin(f object.GroupJoinFunc) {
+	fl.groupJoin = append(fl.groupJoin, func(ctx context.Context, obj object.GroupJoinObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// GroupJoinContext handler.
+func (fl *FuncList) GroupJoinContext(f GroupJoinFunc) {
 	fl.groupJoin = append(fl.groupJoin, f)
 }
 
 // UserBlock handler.
 func (fl *FuncList) UserBlock(f object.UserBlockFunc) {
+	fl.userBlock = append(fl.userBlock, func(ctx context.Context, obj object.UserBlockObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// UserBlockContext handler.
+func (fl *FuncList) UserBlockContext(f UserBlockFunc) {
 	fl.userBlock = append(fl.userBlock, f)
 }
 
 // UserUnblock handler.
 func (fl *FuncList) UserUnblock(f object.UserUnblockFunc) {
+	fl.userUnblock = append(fl.userUnblock, func(ctx context.Context, obj object.UserUnblockObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// UserUnblockContext handler.
+func (fl *FuncList) UserUnblockContext(f UserUnblockFunc) {
 	fl.userUnblock = append(fl.userUnblock, f)
 }
 
 // PollVoteNew handler.
 func (fl *FuncList) PollVoteNew(f object.PollVoteNewFunc) {
+	fl.pollVoteNew = append(fl.pollVoteNew, func(ctx context.Context, obj object.PollVoteNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// PollVoteNewContext handler.
+func (fl *FuncList) PollVoteNewContext(f PollVoteNewFunc) {
 	fl.pollVoteNew = append(fl.pollVoteNew, f)
 }
 
 // GroupOfficersEdit handler.
 func (fl *FuncList) GroupOfficersEdit(f object.GroupOfficersEditFunc) {
+	fl.groupOfficersEdit = append(fl.groupOfficersEdit, func(ctx context.Context, obj object.GroupOfficersEditObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// GroupOfficersEditContext handler.
+func (fl *FuncList) GroupOfficersEditContext(f GroupOfficersEditFunc) {
 	fl.groupOfficersEdit = append(fl.groupOfficersEdit, f)
 }
 
 // GroupChangeSettings handler.
 func (fl *FuncList) GroupChangeSettings(f object.GroupChangeSettingsFunc) {
+	fl.groupChangeSettings = append(fl.groupChangeSettings, func(ctx context.Context, obj object.GroupChangeSettingsObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// GroupChangeSettingsContext handler.
+func (fl *FuncList) GroupChangeSettingsContext(f GroupChangeSettingsFunc) {
 	fl.groupChangeSettings = append(fl.groupChangeSettings, f)
 }
 
 // GroupChangePhoto handler.
 func (fl *FuncList) GroupChangePhoto(f object.GroupChangePhotoFunc) {
+	fl.groupChangePhoto = append(fl.groupChangePhoto, func(ctx context.Context, obj object.GroupChangePhotoObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// GroupChangePhotoContext handler.
+func (fl *FuncList) GroupChangePhotoContext(f GroupChangePhotoFunc) {
 	fl.groupChangePhoto = append(fl.groupChangePhoto, f)
 }
 
 // VkpayTransaction handler.
 func (fl *FuncList) VkpayTransaction(f object.VkpayTransactionFunc) {
+	fl.vkpayTransaction = append(fl.vkpayTransaction, func(ctx context.Context, obj object.VkpayTransactionObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// VkpayTransactionContext handler.
+func (fl *FuncList) VkpayTransactionContext(f VkpayTransactionFunc) {
 	fl.vkpayTransaction = append(fl.vkpayTransaction, f)
 }
 
 // LeadFormsNew handler.
 func (fl *FuncList) LeadFormsNew(f object.LeadFormsNewFunc) {
+	fl.leadFormsNew = append(fl.leadFormsNew, func(ctx context.Context, obj object.LeadFormsNewObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// LeadFormsNewContext handler.
+func (fl *FuncList) LeadFormsNewContext(f LeadFormsNewFunc) {
 	fl.leadFormsNew = append(fl.leadFormsNew, f)
 }
 
 // AppPayload handler.
 func (fl *FuncList) AppPayload(f object.AppPayloadFunc) {
+	fl.appPayload = append(fl.appPayload, func(ctx context.Context, obj object.AppPayloadObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// AppPayloadContext handler.
+func (fl *FuncList) AppPayloadContext(f AppPayloadFunc) {
 	fl.appPayload = append(fl.appPayload, f)
 }
 
 // MessageRead handler.
 func (fl *FuncList) MessageRead(f object.MessageReadFunc) {
+	fl.messageRead = append(fl.messageRead, func(ctx context.Context, obj object.MessageReadObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageReadContext handler.
+func (fl *FuncList) MessageReadContext(f MessageReadFunc) {
 	fl.messageRead = append(fl.messageRead, f)
 }
 
+// MessageEvent handler.
+func (fl *FuncList) MessageEvent(f object.MessageEventFunc) {
+	fl.messageEvent = append(fl.messageEvent, func(ctx context.Context, obj object.MessageEventObject) error {
+		f(obj, GroupIDFromContext(ctx))
+		return nil
+	})
+}
+
+// MessageEventContext handler.
+func (fl *FuncList) MessageEventContext(f MessageEventFunc) {
+	fl.messageEvent = append(fl.messageEvent, f)
+}
+
 // NOTE: like_add like_remove
@@ -1431,3 +1431,37 @@ func TestFuncList_OnEvent(t *testing.T) {
 		false,
 	)
 }
+
+func TestFuncList_HandlerMessageEvent(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+
+	fl.MessageEvent(func(obj object.MessageEventObject, groupID int) {
+		assert.Equal(t, groupID, GID)
+		assert.Equal(t, "abc", obj.EventID)
+		assert.JSONEq(t, `{"command":"start"}`, string(obj.Payload))
+	})
+
+	f := func(e object.GroupEvent, wantErr bool) {
+		if err := fl.Handler(e); (err != nil) != wantErr {
+			t.Errorf("FuncList.Handler() error = %v, wantErr %v", err, wantErr)
+		}
+	}
+
+	f(
+		object.GroupEvent{
+			Type:    "message_event",
+			Object:  []byte(`{"user_id":1,"peer_id":1,"event_id":"abc","payload":{"command":"start"}}`),
+			GroupID: GID,
+		},
+		false,
+	)
+	f(
+		object.GroupEvent{
+			Type:   "message_event",
+			Object: []byte(""),
+		},
+		true,
+	)
+}
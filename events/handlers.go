@@ -0,0 +1,142 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"context"
+
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// EventFunc handles a raw group event.
+type EventFunc func(context.Context, object.GroupEvent) error
+
+// MessageNewFunc handles message_new event.
+type MessageNewFunc func(context.Context, object.MessageNewObject) error
+
+// MessageReplyFunc handles message_reply event.
+type MessageReplyFunc func(context.Context, object.MessageReplyObject) error
+
+// MessageEditFunc handles message_edit event.
+type MessageEditFunc func(context.Context, object.MessageEditObject) error
+
+// MessageAllowFunc handles message_allow event.
+type MessageAllowFunc func(context.Context, object.MessageAllowObject) error
+
+// MessageDenyFunc handles message_deny event.
+type MessageDenyFunc func(context.Context, object.MessageDenyObject) error
+
+// MessageTypingStateFunc handles message_typing_state event.
+type MessageTypingStateFunc func(context.Context, object.MessageTypingStateObject) error
+
+// PhotoNewFunc handles photo_new event.
+type PhotoNewFunc func(context.Context, object.PhotoNewObject) error
+
+// PhotoCommentNewFunc handles photo_comment_new event.
+type PhotoCommentNewFunc func(context.Context, object.PhotoCommentNewObject) error
+
+// PhotoCommentEditFunc handles photo_comment_edit event.
+type PhotoCommentEditFunc func(context.Context, object.PhotoCommentEditObject) error
+
+// PhotoCommentRestoreFunc handles photo_comment_restore event.
+type PhotoCommentRestoreFunc func(context.Context, object.PhotoCommentRestoreObject) error
+
+// PhotoCommentDeleteFunc handles photo_comment_delete event.
+type PhotoCommentDeleteFunc func(context.Context, object.PhotoCommentDeleteObject) error
+
+// AudioNewFunc handles audio_new event.
+type AudioNewFunc func(context.Context, object.AudioNewObject) error
+
+// VideoNewFunc handles video_new event.
+type VideoNewFunc func(context.Context, object.VideoNewObject) error
+
+// VideoCommentNewFunc handles video_comment_new event.
+type VideoCommentNewFunc func(context.Context, object.VideoCommentNewObject) error
+
+// VideoCommentEditFunc handles video_comment_edit event.
+type VideoCommentEditFunc func(context.Context, object.VideoCommentEditObject) error
+
+// VideoCommentRestoreFunc handles video_comment_restore event.
+type VideoCommentRestoreFunc func(context.Context, object.VideoCommentRestoreObject) error
+
+// VideoCommentDeleteFunc handles video_comment_delete event.
+type VideoCommentDeleteFunc func(context.Context, object.VideoCommentDeleteObject) error
+
+// WallPostNewFunc handles wall_post_new event.
+type WallPostNewFunc func(context.Context, object.WallPostNewObject) error
+
+// WallRepostFunc handles wall_repost event.
+type WallRepostFunc func(context.Context, object.WallRepostObject) error
+
+// WallReplyNewFunc handles wall_reply_new event.
+type WallReplyNewFunc func(context.Context, object.WallReplyNewObject) error
+
+// WallReplyEditFunc handles wall_reply_edit event.
+type WallReplyEditFunc func(context.Context, object.WallReplyEditObject) error
+
+// WallReplyRestoreFunc handles wall_reply_restore event.
+type WallReplyRestoreFunc func(context.Context, object.WallReplyRestoreObject) error
+
+// WallReplyDeleteFunc handles wall_reply_delete event.
+type WallReplyDeleteFunc func(context.Context, object.WallReplyDeleteObject) error
+
+// BoardPostNewFunc handles board_post_new event.
+type BoardPostNewFunc func(context.Context, object.BoardPostNewObject) error
+
+// BoardPostEditFunc handles board_post_edit event.
+type BoardPostEditFunc func(context.Context, object.BoardPostEditObject) error
+
+// BoardPostRestoreFunc handles board_post_restore event.
+type BoardPostRestoreFunc func(context.Context, object.BoardPostRestoreObject) error
+
+// BoardPostDeleteFunc handles board_post_delete event.
+type BoardPostDeleteFunc func(context.Context, object.BoardPostDeleteObject) error
+
+// MarketCommentNewFunc handles market_comment_new event.
+type MarketCommentNewFunc func(context.Context, object.MarketCommentNewObject) error
+
+// MarketCommentEditFunc handles market_comment_edit event.
+type MarketCommentEditFunc func(context.Context, object.MarketCommentEditObject) error
+
+// MarketCommentRestoreFunc handles market_comment_restore event.
+type MarketCommentRestoreFunc func(context.Context, object.MarketCommentRestoreObject) error
+
+// MarketCommentDeleteFunc handles market_comment_delete event.
+type MarketCommentDeleteFunc func(context.Context, object.MarketCommentDeleteObject) error
+
+// GroupLeaveFunc handles group_leave event.
+type GroupLeaveFunc func(context.Context, object.GroupLeaveObject) error
+
+// GroupJoinFunc handles group_join event.
+type GroupJoinFunc func(context.Context, object.GroupJoinObject) error
+
+// UserBlockFunc handles user_block event.
+type UserBlockFunc func(context.Context, object.UserBlockObject) error
+
+// UserUnblockFunc handles user_unblock event.
+type UserUnblockFunc func(context.Context, object.UserUnblockObject) error
+
+// PollVoteNewFunc handles poll_vote_new event.
+type PollVoteNewFunc func(context.Context, object.PollVoteNewObject) error
+
+// GroupOfficersEditFunc handles group_officers_edit event.
+type GroupOfficersEditFunc func(context.Context, object.GroupOfficersEditObject) error
+
+// GroupChangeSettingsFunc handles group_change_settings event.
+type GroupChangeSettingsFunc func(context.Context, object.GroupChangeSettingsObject) error
+
+// GroupChangePhotoFunc handles group_change_photo event.
+type GroupChangePhotoFunc func(context.Context, object.GroupChangePhotoObject) error
+
+// VkpayTransactionFunc handles vkpay_transaction event.
+type VkpayTransactionFunc func(context.Context, object.VkpayTransactionObject) error
+
+// LeadFormsNewFunc handles lead_forms_new event.
+type LeadFormsNewFunc func(context.Context, object.LeadFormsNewObject) error
+
+// AppPayloadFunc handles app_payload event.
+type AppPayloadFunc func(context.Context, object.AppPayloadObject) error
+
+// MessageReadFunc handles message_read event.
+type MessageReadFunc func(context.Context, object.MessageReadObject) error
+
+// MessageEventFunc handles message_event event.
+type MessageEventFunc func(context.Context, object.MessageEventObject) error
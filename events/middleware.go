@@ -0,0 +1,127 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// Middleware wraps an EventFunc to add logic before and after handlers
+// of every event.
+type Middleware func(next EventFunc) EventFunc
+
+// Use adds middlewares around handlers of all events.
+//
+// Middlewares are applied in the order they are added, so the first one
+// is the outermost.
+//
+//	lp.Use(events.RecoverMiddleware(), events.LoggingMiddleware(nil))
+func (fl *FuncList) Use(middlewares ...Middleware) {
+	fl.middlewares = append(fl.middlewares, middlewares...)
+}
+
+// RecoverMiddleware recovers a panic of the next middlewares and returns
+// it as *PanicError. Panics of handlers are recovered by FuncList itself.
+func RecoverMiddleware() Middleware {
+	return func(next EventFunc) EventFunc {
+		return func(ctx context.Context, e object.GroupEvent) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &PanicError{
+						Event: e,
+						Value: r,
+						Stack: debug.Stack(),
+					}
+				}
+			}()
+
+			return next(ctx, e)
+		}
+	}
+}
+
+// LoggingMiddleware logs every event with its duration and error.
+//
+// If logger is nil, the standard logger is used.
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	printf := log.Printf
+	if logger != nil {
+		printf = logger.Printf
+	}
+
+	return func(next EventFunc) EventFunc {
+		return func(ctx context.Context, e object.GroupEvent) error {
+			start := time.Now()
+			err := next(ctx, e)
+
+			msg := fmt.Sprintf(
+				"events: %s group_id=%d event_id=%s transport=%s (%s)",
+				e.Type, e.GroupID, e.EventID, TransportFromContext(ctx), time.Since(start),
+			)
+			if err != nil {
+				msg += fmt.Sprintf(" error: %v", err)
+			}
+
+			printf("%s", msg)
+
+			return err
+		}
+	}
+}
+
+// TimingMiddleware calls observe with the duration of handlers of every
+// event. It can be used with api.LatencyHistogram:
+//
+//	h := api.NewLatencyHistogram()
+//	lp.Use(events.TimingMiddleware(h.Observe))
+func TimingMiddleware(observe func(eventType string, d time.Duration, err error)) Middleware {
+	return func(next EventFunc) EventFunc {
+		return func(ctx context.Context, e object.GroupEvent) error {
+			start := time.Now()
+			err := next(ctx, e)
+			observe(e.Type, time.Since(start), err)
+
+			return err
+		}
+	}
+}
+
+// DedupMiddleware skips events which event_id is already in the store.
+// If store is nil, a new MemoryDedupStore with default parameters is used.
+//
+// Events without event_id are always handled. If handlers return an error,
+// the event is removed from the store, so it can be handled again. If the
+// store fails, the event is handled.
+//
+//	store := events.NewMemoryDedupStore(0, 0)
+//	cb.Use(events.DedupMiddleware(store))
+//	lp.Use(events.DedupMiddleware(store))
+func DedupMiddleware(store DedupStore) Middleware {
+	if store == nil {
+		store = NewMemoryDedupStore(0, 0)
+	}
+
+	return func(next EventFunc) EventFunc {
+		return func(ctx context.Context, e object.GroupEvent) error {
+			if e.EventID == "" {
+				return next(ctx, e)
+			}
+
+			isNew, err := store.Add(ctx, e.EventID)
+			if err == nil && !isNew {
+				return nil
+			}
+
+			err = next(ctx, e)
+			if err != nil {
+				_ = store.Remove(ctx, e.EventID)
+			}
+
+			return err
+		}
+	}
+}
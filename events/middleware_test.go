@@ -0,0 +1,148 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+func TestFuncList_Use(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	mw := func(name string) events.Middleware {
+		return func(next events.EventFunc) events.EventFunc {
+			return func(ctx context.Context, e object.GroupEvent) error {
+				calls = append(calls, name+" before")
+				assert.Equal(t, GID, events.GroupIDFromContext(ctx))
+
+				err := next(ctx, e)
+
+				calls = append(calls, name+" after")
+
+				return err
+			}
+		}
+	}
+
+	fl := events.NewFuncList()
+	fl.Use(mw("a"), mw("b"))
+	fl.Use(mw("c"))
+	fl.OnEvent("custom", func(e object.GroupEvent) {
+		calls = append(calls, "handler")
+	})
+
+	assert.NoError(t, fl.Handler(object.GroupEvent{Type: "custom", GroupID: GID}))
+	assert.Equal(t, []string{
+		"a before", "b before", "c before",
+		"handler",
+		"c after", "b after", "a after",
+	}, calls)
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	fl.Use(events.RecoverMiddleware())
+	fl.OnEvent("custom", func(e object.GroupEvent) {
+		panic("test")
+	})
+
+	err := fl.Handler(object.GroupEvent{Type: "custom"})
+	panicErr, ok := err.(*events.PanicError)
+
+	if assert.True(t, ok) {
+		assert.Equal(t, "test", panicErr.Value)
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	fl := events.NewFuncList()
+	fl.Use(events.LoggingMiddleware(log.New(&buf, "", 0)))
+	fl.OnEventContext("custom", func(ctx context.Context, e object.GroupEvent) error {
+		return errors.New("handler error")
+	})
+
+	ctx := events.WithTransport(context.Background(), events.TransportCallback)
+	_ = fl.HandlerContext(ctx, object.GroupEvent{Type: "custom", GroupID: 1, EventID: "abc"})
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "events: custom group_id=1 event_id=abc transport=callback ("), out)
+	assert.Contains(t, out, "error: handler error")
+}
+
+func TestTimingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var (
+		gotType string
+		gotErr  error
+	)
+
+	fl := events.NewFuncList()
+	fl.Use(events.TimingMiddleware(func(eventType string, d time.Duration, err error) {
+		gotType = eventType
+		gotErr = err
+	}))
+
+	assert.NoError(t, fl.Handler(object.GroupEvent{Type: "custom"}))
+	assert.Equal(t, "custom", gotType)
+	assert.NoError(t, gotErr)
+}
+
+func TestDedupMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var (
+		handled []string
+		fail    bool
+	)
+
+	fl := events.NewFuncList()
+	fl.Use(events.DedupMiddleware(events.NewMemoryDedupStore(2, 0)))
+	fl.OnEventContext("custom", func(ctx context.Context, e object.GroupEvent) error {
+		handled = append(handled, e.EventID)
+
+		if fail {
+			return errors.New("handler error")
+		}
+
+		return nil
+	})
+
+	f := func(eventID string) {
+		t.Helper()
+
+		_ = fl.Handler(object.GroupEvent{Type: "custom", EventID: eventID})
+	}
+
+	f("a")
+	f("a")
+	f("b")
+	f("c") // "a" is forgotten
+	f("a")
+	f("")
+	f("")
+
+	fail = true
+
+	f("d")
+	f("d")
+
+	assert.Equal(t, []string{"a", "b", "c", "a", "", "", "d", "d"}, handled)
+}
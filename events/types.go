@@ -0,0 +1,252 @@
+package events // import "github.com/SevereCloud/vksdk/events"
+
+import (
+	"sort"
+
+	"github.com/SevereCloud/vksdk/internal"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// Types returns all event types which can be enabled in settings of
+// Callback API and Bots Long Poll API.
+func Types() []string {
+	return []string{
+		"message_new",
+		"message_reply",
+		"photo_new",
+		"audio_new",
+		"video_new",
+		"wall_reply_new",
+		"wall_reply_edit",
+		"wall_reply_delete",
+		"wall_reply_restore",
+		"wall_post_new",
+		"board_post_new",
+		"board_post_edit",
+		"board_post_restore",
+		"board_post_delete",
+		"photo_comment_new",
+		"photo_comment_edit",
+		"photo_comment_delete",
+		"photo_comment_restore",
+		"video_comment_new",
+		"video_comment_edit",
+		"video_comment_delete",
+		"video_comment_restore",
+		"market_comment_new",
+		"market_comment_edit",
+		"market_comment_delete",
+		"market_comment_restore",
+		"poll_vote_new",
+		"group_join",
+		"group_leave",
+		"group_change_settings",
+		"group_change_photo",
+		"group_officers_edit",
+		"message_allow",
+		"message_deny",
+		"wall_repost",
+		"user_block",
+		"user_unblock",
+		"message_edit",
+		"message_typing_state",
+		"lead_forms_new",
+		"like_add",
+		"like_remove",
+		"vkpay_transaction",
+		"app_payload",
+		"message_read",
+		"message_event",
+	}
+}
+
+// ListEvents returns sorted event types which have handlers.
+func (fl FuncList) ListEvents() []string {
+	var types []string
+
+	if len(fl.messageNew) > 0 {
+		types = append(types, object.EventMessageNew)
+	}
+
+	if len(fl.messageReply) > 0 {
+		types = append(types, object.EventMessageReply)
+	}
+
+	if len(fl.messageEdit) > 0 {
+		types = append(types, object.EventMessageEdit)
+	}
+
+	if len(fl.messageAllow) > 0 {
+		types = append(types, object.EventMessageAllow)
+	}
+
+	if len(fl.messageDeny) > 0 {
+		types = append(types, object.EventMessageDeny)
+	}
+
+	if len(fl.messageTypingState) > 0 {
+		types = append(types, object.EventMessageTypingState)
+	}
+
+	if len(fl.photoNew) > 0 {
+		types = append(types, object.EventPhotoNew)
+	}
+
+	if len(fl.photoCommentNew) > 0 {
+		types = append(types, object.EventPhotoCommentNew)
+	}
+
+	if len(fl.photoCommentEdit) > 0 {
+		types = append(types, object.EventPhotoCommentEdit)
+	}
+
+	if len(fl.photoCommentRestore) > 0 {
+		types = append(types, object.EventPhotoCommentRestore)
+	}
+
+	if len(fl.photoCommentDelete) > 0 {
+		types = append(types, object.EventPhotoCommentDelete)
+	}
+
+	if len(fl.audioNew) > 0 {
+		types = append(types, object.EventAudioNew)
+	}
+
+	if len(fl.videoNew) > 0 {
+		types = append(types, object.EventVideoNew)
+	}
+
+	if len(fl.videoCommentNew) > 0 {
+		types = append(types, object.EventVideoCommentNew)
+	}
+
+	if len(fl.videoCommentEdit) > 0 {
+		types = append(types, object.EventVideoCommentEdit)
+	}
+
+	if len(fl.videoCommentRestore) > 0 {
+		types = append(types, object.EventVideoCommentRestore)
+	}
+
+	if len(fl.videoCommentDelete) > 0 {
+		types = append(types, object.EventVideoCommentDelete)
+	}
+
+	if len(fl.wallPostNew) > 0 {
+		types = append(types, object.EventWallPostNew)
+	}
+
+	if len(fl.wallRepost) > 0 {
+		types = append(types, object.EventWallRepost)
+	}
+
+	if len(fl.wallReplyNew) > 0 {
+		types = append(types, object.EventWallReplyNew)
+	}
+
+	if len(fl.wallReplyEdit) > 0 {
+		types = append(types, object.EventWallReplyEdit)
+	}
+
+	if len(fl.wallReplyRestore) > 0 {
+		types = append(types, object.EventWallReplyRestore)
+	}
+
+	if len(fl.wallReplyDelete) > 0 {
+		types = append(types, object.EventWallReplyDelete)
+	}
+
+	if len(fl.boardPostNew) > 0 {
+		types = append(types, object.EventBoardPostNew)
+	}
+
+	if len(fl.boardPostEdit) > 0 {
+		types = append(types, object.EventBoardPostEdit)
+	}
+
+	if len(fl.boardPostRestore) > 0 {
+		types = append(types, object.EventBoardPostRestore)
+	}
+
+	if len(fl.boardPostDelete) > 0 {
+		types = append(types, object.EventBoardPostDelete)
+	}
+
+	if len(fl.marketCommentNew) > 0 {
+		types = append(types, object.EventMarketCommentNew)
+	}
+
+	if len(fl.marketCommentEdit) > 0 {
+		types = append(types, object.EventMarketCommentEdit)
+	}
+
+	if len(fl.marketCommentRestore) > 0 {
+		types = append(types, object.EventMarketCommentRestore)
+	}
+
+	if len(fl.marketCommentDelete) > 0 {
+		types = append(types, object.EventMarketCommentDelete)
+	}
+
+	if len(fl.groupLeave) > 0 {
+		types = append(types, object.EventGroupLeave)
+	}
+
+	if len(fl.groupJoin) > 0 {
+		types = append(types, object.EventGroupJoin)
+	}
+
+	if len(fl.userBlock) > 0 {
+		types = append(types, object.EventUserBlock)
+	}
+
+	if len(fl.userUnblock) > 0 {
+		types = append(types, object.EventUserUnblock)
+	}
+
+	if len(fl.pollVoteNew) > 0 {
+		types = append(types, object.EventPollVoteNew)
+	}
+
+	if len(fl.groupOfficersEdit) > 0 {
+		types = append(types, object.EventGroupOfficersEdit)
+	}
+
+	if len(fl.groupChangeSettings) > 0 {
+		types = append(types, object.EventGroupChangeSettings)
+	}
+
+	if len(fl.groupChangePhoto) > 0 {
+		types = append(types, object.EventGroupChangePhoto)
+	}
+
+	if len(fl.vkpayTransaction) > 0 {
+		types = append(types, object.EventVkpayTransaction)
+	}
+
+	if len(fl.leadFormsNew) > 0 {
+		types = append(types, object.EventLeadFormsNew)
+	}
+
+	if len(fl.appPayload) > 0 {
+		types = append(types, object.EventAppPayload)
+	}
+
+	if len(fl.messageRead) > 0 {
+		types = append(types, object.EventMessageRead)
+	}
+
+	if len(fl.messageEvent) > 0 {
+		types = append(types, object.EventMessageEvent)
+	}
+
+	for eventType, sliceFunc := range fl.special {
+		if len(sliceFunc) > 0 && !internal.Contains(types, eventType) {
+			types = append(types, eventType)
+		}
+	}
+
+	sort.Strings(types)
+
+	return types
+}
@@ -0,0 +1,44 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+func TestFuncList_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	fl := events.NewFuncList()
+	assert.Empty(t, fl.ListEvents())
+
+	fl.MessageNew(func(obj object.MessageNewObject, groupID int) {})
+	fl.GroupJoinContext(func(ctx context.Context, obj object.GroupJoinObject) error {
+		return nil
+	})
+	fl.OnEvent("like_add", func(e object.GroupEvent) {})
+	fl.OnEvent(object.EventMessageNew, func(e object.GroupEvent) {})
+
+	assert.Equal(t, []string{"group_join", "like_add", "message_new"}, fl.ListEvents())
+}
+
+func TestTypes(t *testing.T) {
+	t.Parallel()
+
+	types := events.Types()
+
+	assert.Contains(t, types, object.EventMessageNew)
+	assert.Contains(t, types, object.EventMessageRead)
+	assert.NotContains(t, types, object.EventConfirmation)
+
+	seen := make(map[string]bool)
+
+	for _, eventType := range types {
+		assert.False(t, seen[eventType], eventType)
+		seen[eventType] = true
+	}
+}
@@ -0,0 +1,4 @@
+package internal // import "github.com/SevereCloud/vksdk/internal"
+
+// ChatPeerID is the minimal peer_id of a chat.
+const ChatPeerID = 2000000000
@@ -0,0 +1,118 @@
+package internal // import "github.com/SevereCloud/vksdk/internal"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Default delays between reconnects.
+const (
+	DefaultReconnectDelay    = time.Second
+	DefaultMaxReconnectDelay = time.Minute
+)
+
+// Backoff returns delays between reconnects. The delay starts from Min
+// and doubles after each failure up to Max.
+type Backoff struct {
+	Min time.Duration // DefaultReconnectDelay if zero
+	Max time.Duration // DefaultMaxReconnectDelay if zero
+
+	delay time.Duration
+}
+
+// Next returns the delay before the next reconnect.
+func (b *Backoff) Next() time.Duration {
+	minDelay := b.Min
+	if minDelay <= 0 {
+		minDelay = DefaultReconnectDelay
+	}
+
+	maxDelay := b.Max
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxReconnectDelay
+	}
+
+	b.delay *= 2
+	if b.delay < minDelay {
+		b.delay = minDelay
+	}
+
+	if b.delay > maxDelay {
+		b.delay = maxDelay
+	}
+
+	return b.delay
+}
+
+// Reset starts the delays from Min after a successful connect.
+func (b *Backoff) Reset() {
+	b.delay = 0
+}
+
+// Shutdown is a channel which is closed on shutdown. The zero value is
+// ready to use.
+type Shutdown struct {
+	done chan struct{}
+	mux  sync.Mutex
+}
+
+// Done returns the channel which is closed by Close.
+func (s *Shutdown) Done() <-chan struct{} {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.done == nil {
+		s.done = make(chan struct{})
+	}
+
+	return s.done
+}
+
+// Close closes the channel. It can be called more than once.
+func (s *Shutdown) Close() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.done == nil {
+		s.done = make(chan struct{})
+	}
+
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+}
+
+// Reset replaces the closed channel, so the next run can be shut down.
+func (s *Shutdown) Reset() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	select {
+	case <-s.done:
+		s.done = nil
+	default:
+	}
+}
+
+// Sleep pauses the current goroutine for d. It returns early if done
+// is closed or ctx is canceled.
+func Sleep(ctx context.Context, d time.Duration, done <-chan struct{}) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// HandleError passes err to handler if handler is not nil.
+func HandleError(handler func(err error), err error) {
+	if handler != nil {
+		handler(err)
+	}
+}
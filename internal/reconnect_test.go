@@ -0,0 +1,72 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SevereCloud/vksdk/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	b := internal.Backoff{Min: time.Second, Max: 3 * time.Second}
+
+	assert.Equal(t, time.Second, b.Next())
+	assert.Equal(t, 2*time.Second, b.Next())
+	assert.Equal(t, 3*time.Second, b.Next())
+	assert.Equal(t, 3*time.Second, b.Next())
+
+	b.Reset()
+	assert.Equal(t, time.Second, b.Next())
+
+	var d internal.Backoff
+
+	assert.Equal(t, internal.DefaultReconnectDelay, d.Next())
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	var s internal.Shutdown
+
+	done := s.Done()
+
+	start := time.Now()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.Close()
+	}()
+
+	internal.Sleep(context.Background(), time.Minute, done)
+	assert.True(t, time.Since(start) < time.Second)
+
+	s.Close()
+	s.Reset()
+
+	select {
+	case <-s.Done():
+		t.Error("Done is closed after Reset")
+	default:
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	internal.Sleep(ctx, time.Minute, s.Done())
+}
+
+func TestHandleError(t *testing.T) {
+	t.Parallel()
+
+	var got error
+
+	internal.HandleError(nil, errors.New("ignored"))
+	internal.HandleError(func(err error) { got = err }, errors.New("err"))
+
+	assert.EqualError(t, got, "err")
+}
@@ -0,0 +1,12 @@
+package internal // import "github.com/SevereCloud/vksdk/internal"
+
+// Contains reports whether s is in list.
+func Contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
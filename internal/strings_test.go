@@ -0,0 +1,17 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/internal"
+)
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, internal.Contains([]string{"a", "b"}, "b"))
+	assert.False(t, internal.Contains([]string{"a", "b"}, "c"))
+	assert.False(t, internal.Contains(nil, "a"))
+}
@@ -6,15 +6,18 @@ See more https://vk.com/dev/bots_longpoll
 package longpoll // import "github.com/SevereCloud/vksdk/longpoll-bot"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync/atomic"
+	"time"
 
 	"github.com/SevereCloud/vksdk/object"
 
 	"github.com/SevereCloud/vksdk/api"
 	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/internal"
 )
 
 // Longpoll struct.
@@ -27,8 +30,36 @@ type Longpoll struct {
 	VK      *api.VK
 	Client  *http.Client
 
+	// Dispatcher handles events concurrently. If nil, events are handled
+	// synchronously by FuncList. The Dispatcher is shut down when Run
+	// returns, so set a new one before running the Longpoll again.
+	Dispatcher *events.Dispatcher
+
+	// DedupStore skips events which are already received, e.g. after
+	// a restart with an old Ts. The store can be shared with Callback API.
+	DedupStore events.DedupStore
+
+	// Timeout sets the deadline of the context passed to event handlers.
+	// If zero, the context has no deadline.
+	Timeout time.Duration
+
+	// TsStore persists Ts between restarts in RunResilient.
+	TsStore TsStore
+
+	// ErrorHandler is called by RunResilient on errors of requests
+	// and event handlers.
+	ErrorHandler func(err error)
+
+	// ReconnectDelay is the pause before RunResilient repeats a failed
+	// request to the long poll server, 1 second by default. It doubles
+	// after each failed request in a row up to MaxReconnectDelay,
+	// 1 minute by default.
+	ReconnectDelay    time.Duration
+	MaxReconnectDelay time.Duration
+
 	funcFullResponseList []func(object.LongpollBotResponse)
 	inShutdown           int32
+	shutdown             internal.Shutdown
 
 	events.FuncList
 }
@@ -47,7 +78,7 @@ func NewLongpoll(vk *api.VK, groupID int) (*Longpoll, error) {
 	}
 	lp.FuncList = *events.NewFuncList()
 
-	err := lp.updateServer(true)
+	err := lp.updateServer(context.Background(), true)
 
 	return lp, err
 }
@@ -71,7 +102,7 @@ func NewLongpollCommunity(vk *api.VK) (*Longpoll, error) {
 	}
 	lp.FuncList = *events.NewFuncList()
 
-	err = lp.updateServer(true)
+	err = lp.updateServer(context.Background(), true)
 
 	return lp, err
 }
@@ -85,17 +116,17 @@ func Init(vk *api.VK, groupID int) (lp Longpoll, err error) {
 	lp.Wait = 25
 	lp.Client = &http.Client{}
 	lp.FuncList = *events.NewFuncList()
-	err = lp.updateServer(true)
+	err = lp.updateServer(context.Background(), true)
 
 	return
 }
 
-func (lp *Longpoll) updateServer(updateTs bool) error {
+func (lp *Longpoll) updateServer(ctx context.Context, updateTs bool) error {
 	params := api.Params{
 		"group_id": lp.GroupID,
 	}
 
-	serverSetting, err := lp.VK.GroupsGetLongPollServer(params)
+	serverSetting, err := lp.VK.GroupsGetLongPollServerContext(ctx, params)
 	if err != nil {
 		return err
 	}
@@ -110,12 +141,17 @@ func (lp *Longpoll) updateServer(updateTs bool) error {
 	return nil
 }
 
-func (lp *Longpoll) check() (object.LongpollBotResponse, error) {
+func (lp *Longpoll) check(ctx context.Context) (object.LongpollBotResponse, error) {
 	var response object.LongpollBotResponse
 
 	u := fmt.Sprintf("%s?act=a_check&key=%s&ts=%s&wait=%d", lp.Server, lp.Key, lp.Ts, lp.Wait)
 
-	resp, err := lp.Client.Get(u)
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return response, err
+	}
+
+	resp, err := lp.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return response, err
 	}
@@ -126,21 +162,21 @@ func (lp *Longpoll) check() (object.LongpollBotResponse, error) {
 		return response, err
 	}
 
-	err = lp.checkResponse(response)
+	err = lp.checkResponse(ctx, response)
 
 	return response, err
 }
 
-func (lp *Longpoll) checkResponse(response object.LongpollBotResponse) (err error) {
+func (lp *Longpoll) checkResponse(ctx context.Context, response object.LongpollBotResponse) (err error) {
 	switch response.Failed {
 	case 0:
 		lp.Ts = response.Ts
 	case 1:
 		lp.Ts = response.Ts
 	case 2:
-		err = lp.updateServer(false)
+		err = lp.updateServer(ctx, false)
 	case 3:
-		err = lp.updateServer(true)
+		err = lp.updateServer(ctx, true)
 	default:
 		err = fmt.Errorf(`"failed":%d`, response.Failed)
 	}
@@ -150,17 +186,32 @@ func (lp *Longpoll) checkResponse(response object.LongpollBotResponse) (err erro
 
 // Run handler.
 func (lp *Longpoll) Run() error {
+	return lp.RunContext(context.Background())
+}
+
+// RunContext handler.
+//
+// ctx is passed to event handlers. If ctx is canceled, the current
+// request is interrupted and ctx.Err() is returned.
+func (lp *Longpoll) RunContext(ctx context.Context) error {
 	atomic.StoreInt32(&lp.inShutdown, 0)
 
 	for atomic.LoadInt32(&lp.inShutdown) == 0 {
-		resp, err := lp.check()
+		resp, err := lp.check(ctx)
 		if err != nil {
+			lp.shutdownDispatcher()
+
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
 			return err
 		}
 
 		for _, event := range resp.Updates {
-			err = lp.Handler(event)
+			err = lp.handle(ctx, event)
 			if err != nil {
+				lp.shutdownDispatcher()
 				return err
 			}
 		}
@@ -170,12 +221,134 @@ func (lp *Longpoll) Run() error {
 		}
 	}
 
+	lp.shutdownDispatcher()
+
 	return nil
 }
 
+// handle passes the event to Dispatcher or handles it synchronously.
+func (lp *Longpoll) handle(ctx context.Context, event object.GroupEvent) error {
+	ctx = events.WithTransport(ctx, events.TransportLongPoll)
+	ctx = events.WithTimeout(ctx, lp.Timeout)
+
+	dedup := lp.DedupStore != nil && event.EventID != ""
+	if dedup {
+		isNew, err := lp.DedupStore.Add(ctx, event.EventID)
+		if err == nil && !isNew {
+			return nil
+		}
+	}
+
+	var err error
+
+	if lp.Dispatcher != nil {
+		err = lp.Dispatcher.DispatchContext(ctx, event)
+	} else {
+		err = lp.HandlerContext(ctx, event)
+	}
+
+	if err != nil && dedup {
+		// The event can be handled again, as with DedupMiddleware
+		_ = lp.DedupStore.Remove(ctx, event.EventID)
+	}
+
+	return err
+}
+
+// shutdownDispatcher waits until dispatched events are handled.
+func (lp *Longpoll) shutdownDispatcher() {
+	if lp.Dispatcher != nil {
+		lp.Dispatcher.Shutdown()
+	}
+}
+
+// RunResilient handler.
+//
+// Unlike Run, it does not return on errors. Errors of requests are passed
+// to ErrorHandler and the request is repeated with the same Ts after
+// a delay, so events are not lost. Errors of event handlers are passed
+// to ErrorHandler and the remaining events are handled.
+//
+// If TsStore is set, Ts is loaded on start and saved whenever it changes,
+// after all events of a response are handled or passed to Dispatcher.
+func (lp *Longpoll) RunResilient() error {
+	return lp.RunResilientContext(context.Background())
+}
+
+// RunResilientContext handler.
+//
+// ctx is passed to event handlers. If ctx is canceled, the current
+// request or delay is interrupted and ctx.Err() is returned.
+func (lp *Longpoll) RunResilientContext(ctx context.Context) error {
+	atomic.StoreInt32(&lp.inShutdown, 0)
+	lp.shutdown.Reset()
+
+	savedTs := ""
+
+	if lp.TsStore != nil {
+		ts, err := lp.TsStore.Load()
+		if err != nil {
+			return err
+		}
+
+		if ts != "" {
+			lp.Ts = ts
+		}
+
+		savedTs = lp.Ts
+	}
+
+	backoff := internal.Backoff{Min: lp.ReconnectDelay, Max: lp.MaxReconnectDelay}
+
+	for atomic.LoadInt32(&lp.inShutdown) == 0 {
+		resp, err := lp.check(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			internal.HandleError(lp.ErrorHandler, err)
+			internal.Sleep(ctx, backoff.Next(), lp.shutdown.Done())
+
+			continue
+		}
+
+		backoff.Reset()
+
+		for _, event := range resp.Updates {
+			err = lp.handle(ctx, event)
+			if err != nil {
+				internal.HandleError(lp.ErrorHandler, err)
+			}
+		}
+
+		for _, f := range lp.funcFullResponseList {
+			f(resp)
+		}
+
+		// Ts also changes without updates, e.g. after failed 1 or 3,
+		// so it is saved on every change to not restart from a stale Ts.
+		if lp.TsStore != nil && lp.Ts != savedTs {
+			err = lp.TsStore.Save(lp.Ts)
+			if err != nil {
+				internal.HandleError(lp.ErrorHandler, err)
+			} else {
+				savedTs = lp.Ts
+			}
+		}
+	}
+
+	lp.shutdownDispatcher()
+
+	return ctx.Err()
+}
+
 // Shutdown gracefully shuts down the longpoll without interrupting any active connections.
+//
+// Run returns after events passed to Dispatcher are handled.
 func (lp *Longpoll) Shutdown() {
 	atomic.StoreInt32(&lp.inShutdown, 1)
+	lp.shutdown.Close()
 }
 
 // FullResponse handler.
@@ -1,12 +1,23 @@
 package longpoll
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/events"
 	"github.com/SevereCloud/vksdk/object"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestLongpoll_Shutdown(t *testing.T) {
@@ -119,7 +130,7 @@ func TestLongpoll_checkResponse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := lp.checkResponse(tt.argResponse); (err != nil) != tt.wantErr {
+			if err := lp.checkResponse(context.Background(), tt.argResponse); (err != nil) != tt.wantErr {
 				t.Errorf("Longpoll.checkResponse() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -163,3 +174,323 @@ func TestLongpoll_RunError(t *testing.T) {
 		}
 	})
 }
+
+func TestLongpoll_RunResilient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requests []string
+		mux      sync.Mutex
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/method/groups.getLongPollServer" {
+			fmt.Fprintf(w, `{"response":{"key":"key2","server":"http://%s/lp","ts":"100"}}`, r.Host)
+			return
+		}
+
+		q := r.URL.Query()
+
+		mux.Lock()
+		requests = append(requests, q.Get("key")+":"+q.Get("ts"))
+		n := len(requests)
+		mux.Unlock()
+
+		switch n {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			_, _ = w.Write([]byte(`{"failed":2}`))
+		case 3:
+			_, _ = w.Write([]byte(`{"ts":"11","updates":[
+				{"type":"message_new","object":1},
+				{"type":"message_new","object":{}}
+			]}`))
+		default:
+			_, _ = w.Write([]byte(`{"ts":"11","updates":[]}`))
+		}
+	}))
+	defer ts.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = ts.URL + "/method/"
+
+	store := &MemoryTsStore{}
+	_ = store.Save("10")
+
+	lp := &Longpoll{
+		VK:             vk,
+		Server:         ts.URL + "/lp",
+		Key:            "key1",
+		Ts:             "1",
+		Client:         http.DefaultClient,
+		TsStore:        store,
+		ReconnectDelay: time.Millisecond,
+	}
+	lp.FuncList = *events.NewFuncList()
+
+	var errs []error
+
+	lp.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+	}
+
+	handled := 0
+
+	lp.MessageNew(func(obj object.MessageNewObject, groupID int) {
+		handled++
+	})
+
+	lp.FullResponse(func(resp object.LongpollBotResponse) {
+		if resp.Ts == "11" {
+			lp.Shutdown()
+		}
+	})
+
+	assert.NoError(t, lp.RunResilient())
+
+	assert.Equal(t, []string{"key1:10", "key1:10", "key2:10"}, requests)
+	assert.Equal(t, 1, handled)
+	assert.Len(t, errs, 2)
+
+	ts2, _ := store.Load()
+	assert.Equal(t, "11", ts2)
+}
+
+func TestLongpoll_RunResilientContext(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requests []string
+		mux      sync.Mutex
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		mux.Lock()
+		requests = append(requests, r.URL.Query().Get("ts"))
+		n := len(requests)
+		mux.Unlock()
+
+		switch n {
+		case 1:
+			_, _ = w.Write([]byte(`{"failed":1,"ts":"20"}`))
+		default:
+			<-r.Context().Done()
+		}
+	}))
+	defer ts.Close()
+
+	store := &MemoryTsStore{}
+	_ = store.Save("10")
+
+	lp := &Longpoll{
+		Server:  ts.URL,
+		Key:     "key",
+		Client:  http.DefaultClient,
+		TsStore: store,
+	}
+	lp.FuncList = *events.NewFuncList()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lp.FullResponse(func(resp object.LongpollBotResponse) {
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+	})
+
+	assert.Equal(t, context.Canceled, lp.RunResilientContext(ctx))
+
+	mux.Lock()
+	assert.Equal(t, []string{"10", "20"}, requests)
+	mux.Unlock()
+
+	ts2, _ := store.Load()
+	assert.Equal(t, "20", ts2)
+}
+
+func TestLongpoll_RunContext_updateServer(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/method/groups.getLongPollServer" {
+			_ = r.ParseForm()
+			<-r.Context().Done()
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"failed":3}`))
+	}))
+	defer ts.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = ts.URL + "/method/"
+
+	lp := &Longpoll{
+		VK:     vk,
+		Server: ts.URL + "/lp",
+		Key:    "key",
+		Client: http.DefaultClient,
+	}
+	lp.FuncList = *events.NewFuncList()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// the request of a new server is interrupted too
+	assert.Equal(t, context.DeadlineExceeded, lp.RunContext(ctx))
+}
+
+func TestFileTsStore(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "longpoll")
+	assert.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	store := FileTsStore{Path: filepath.Join(dir, "ts")}
+
+	ts, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "", ts)
+
+	assert.NoError(t, store.Save("123"))
+
+	ts, err = store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "123", ts)
+}
+
+func TestLongpoll_DedupStore(t *testing.T) {
+	t.Parallel()
+
+	lp := &Longpoll{DedupStore: events.NewMemoryDedupStore(0, 0)}
+	lp.FuncList = *events.NewFuncList()
+
+	handled := 0
+
+	lp.OnEvent("custom", func(e object.GroupEvent) {
+		handled++
+	})
+
+	ctx := context.Background()
+
+	for _, eventID := range []string{"a", "a", "b", "", ""} {
+		assert.NoError(t, lp.handle(ctx, object.GroupEvent{Type: "custom", EventID: eventID}))
+	}
+
+	assert.Equal(t, 4, handled)
+
+	// a failed event is handled again
+	fail := true
+
+	lp.OnEventContext("failing", func(ctx context.Context, e object.GroupEvent) error {
+		handled++
+
+		if fail {
+			return errors.New("handler error")
+		}
+
+		return nil
+	})
+
+	assert.Error(t, lp.handle(ctx, object.GroupEvent{Type: "failing", EventID: "c"}))
+
+	fail = false
+
+	assert.NoError(t, lp.handle(ctx, object.GroupEvent{Type: "failing", EventID: "c"}))
+	assert.NoError(t, lp.handle(ctx, object.GroupEvent{Type: "failing", EventID: "c"}))
+	assert.Equal(t, 6, handled)
+}
+
+func TestLongpoll_Timeout(t *testing.T) {
+	t.Parallel()
+
+	lp := &Longpoll{Timeout: time.Minute}
+	lp.FuncList = *events.NewFuncList()
+
+	deadlines := make(chan bool, 2)
+
+	lp.OnEventContext("custom", func(ctx context.Context, e object.GroupEvent) error {
+		_, ok := ctx.Deadline()
+		deadlines <- ok
+
+		return nil
+	})
+
+	assert.NoError(t, lp.handle(context.Background(), object.GroupEvent{Type: "custom"}))
+	assert.True(t, <-deadlines)
+
+	// the deadline is set when the worker starts handling the event
+	lp.Dispatcher = events.NewDispatcher(&lp.FuncList, 1)
+
+	assert.NoError(t, lp.handle(context.Background(), object.GroupEvent{Type: "custom"}))
+	lp.shutdownDispatcher()
+	assert.True(t, <-deadlines)
+}
+
+func TestLongpoll_Setup(t *testing.T) {
+	t.Parallel()
+
+	var (
+		settings = `{"is_enabled":0,"api_version":"5.50","events":{"message_new":0,"messages_edit":1,"wall_post_new":1}}`
+		set      []string
+		mux      sync.Mutex
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		mux.Lock()
+		defer mux.Unlock()
+
+		switch r.URL.Path {
+		case "/method/groups.getLongPollSettings":
+			fmt.Fprintf(w, `{"response":%s}`, settings)
+		case "/method/groups.setLongPollSettings":
+			_ = r.ParseForm()
+			set = append(set, r.Form.Encode())
+			settings = `{"is_enabled":1,"api_version":"` + api.Version +
+				`","events":{"message_new":1,"messages_edit":1}}`
+
+			fmt.Fprint(w, `{"response":1}`)
+		}
+	}))
+	defer ts.Close()
+
+	vk := api.NewVK("")
+	vk.MethodURL = ts.URL + "/method/"
+
+	lp := &Longpoll{VK: vk, GroupID: 1}
+	lp.FuncList = *events.NewFuncList()
+	lp.MessageNew(func(obj object.MessageNewObject, groupID int) {})
+	lp.MessageEdit(func(obj object.MessageEditObject, groupID int) {})
+
+	diff, err := lp.Setup(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, SettingsDiff{
+		Enabled:        true,
+		APIVersion:     "5.50",
+		EnabledEvents:  []string{"message_new"},
+		DisabledEvents: []string{"wall_post_new"},
+	}, diff)
+	assert.True(t, diff.Changed())
+	assert.Len(t, set, 1)
+	assert.Contains(t, set[0], "message_new=1")
+	assert.Contains(t, set[0], "message_edit=1")
+	assert.Contains(t, set[0], "wall_post_new=0")
+	assert.Contains(t, set[0], "enabled=1")
+
+	diff, err = lp.Setup(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, diff.Changed())
+	assert.Len(t, set, 1)
+}
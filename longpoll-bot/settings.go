@@ -0,0 +1,117 @@
+package longpoll // import "github.com/SevereCloud/vksdk/longpoll-bot"
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/events"
+	"github.com/SevereCloud/vksdk/internal"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// SettingsDiff describes changes of Bots Long Poll API settings made
+// by Setup.
+type SettingsDiff struct {
+	// Enabled is true if Bots Long Poll API was disabled.
+	Enabled bool
+
+	// APIVersion is the previous API version if it was changed.
+	APIVersion string
+
+	// EnabledEvents and DisabledEvents are the changed event types.
+	EnabledEvents  []string
+	DisabledEvents []string
+}
+
+// Changed reports whether the settings were changed.
+func (d SettingsDiff) Changed() bool {
+	return d.Enabled || d.APIVersion != "" ||
+		len(d.EnabledEvents) > 0 || len(d.DisabledEvents) > 0
+}
+
+// Setup enables Bots Long Poll API in the group, sets the API version
+// to api.Version and enables exactly the event types which have handlers.
+//
+// The settings are updated only if they differ from the current ones,
+// so Setup can be called on every start. Register handlers before
+// calling Setup. The token requires the manage access right.
+//
+//	lp.MessageNew(...)
+//
+//	diff, err := lp.Setup(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	log.Printf("enabled %v, disabled %v", diff.EnabledEvents, diff.DisabledEvents)
+func (lp *Longpoll) Setup(ctx context.Context) (SettingsDiff, error) {
+	var diff SettingsDiff
+
+	settings, err := lp.VK.GroupsGetLongPollSettingsContext(ctx, api.Params{
+		"group_id": lp.GroupID,
+	})
+	if err != nil {
+		return diff, err
+	}
+
+	current, err := enabledEvents(settings.Events)
+	if err != nil {
+		return diff, err
+	}
+
+	diff.Enabled = !bool(settings.IsEnabled)
+
+	if settings.APIVersion != api.Version {
+		diff.APIVersion = settings.APIVersion
+	}
+
+	params := api.Params{
+		"group_id":    lp.GroupID,
+		"enabled":     true,
+		"api_version": api.Version,
+	}
+
+	handled := lp.ListEvents()
+
+	for _, eventType := range events.Types() {
+		want := internal.Contains(handled, eventType)
+
+		switch {
+		case want && !current[eventType]:
+			diff.EnabledEvents = append(diff.EnabledEvents, eventType)
+		case !want && current[eventType]:
+			diff.DisabledEvents = append(diff.DisabledEvents, eventType)
+		}
+
+		params[eventType] = want
+	}
+
+	if !diff.Changed() {
+		return diff, nil
+	}
+
+	_, err = lp.VK.GroupsSetLongPollSettingsContext(ctx, params)
+
+	return diff, err
+}
+
+// enabledEvents returns the event types enabled in settings.
+func enabledEvents(e object.GroupsLongPollEvents) (map[string]bool, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]bool
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	// VK may return message_edit in the messages_edit field, see
+	// GroupsLongPollEvents.MessagesEdit. It is not an event type.
+	m[object.EventMessageEdit] = bool(e.MessageEdit || e.MessagesEdit)
+	delete(m, "messages_edit")
+
+	return m, nil
+}
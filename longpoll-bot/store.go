@@ -0,0 +1,75 @@
+package longpoll // import "github.com/SevereCloud/vksdk/longpoll-bot"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// TsStore persists the last Ts of the longpoll.
+type TsStore interface {
+	// Load returns the saved Ts or an empty string if there is no Ts.
+	Load() (string, error)
+	Save(ts string) error
+}
+
+// MemoryTsStore keeps Ts in memory.
+type MemoryTsStore struct {
+	ts  string
+	mux sync.Mutex
+}
+
+// Load returns the saved Ts.
+func (s *MemoryTsStore) Load() (string, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.ts, nil
+}
+
+// Save saves Ts.
+func (s *MemoryTsStore) Save(ts string) error {
+	s.mux.Lock()
+	s.ts = ts
+	s.mux.Unlock()
+
+	return nil
+}
+
+// FileTsStore keeps Ts in the file.
+type FileTsStore struct {
+	Path string
+}
+
+// Load returns Ts from the file. If the file does not exist, an empty
+// string is returned.
+func (s FileTsStore) Load() (string, error) {
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+
+	return strings.TrimSpace(string(b)), err
+}
+
+// Save writes Ts to the file. The file is replaced atomically.
+func (s FileTsStore) Save(ts string) error {
+	f, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path))
+	if err != nil {
+		return err
+	}
+
+	_, err = f.WriteString(ts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), s.Path)
+}
@@ -1,8 +1,14 @@
 package longpoll
 
+import "context"
+
 // EventNewFunc struct.
 type EventNewFunc func([]interface{}) error
 
+// EventNewContextFunc handles an event with a context. The context
+// has the deadline set by Longpoll.Timeout.
+type EventNewContextFunc func(ctx context.Context, event []interface{}) error
+
 // FuncList struct.
 type FuncList map[int][]EventNewFunc
 
@@ -18,3 +24,19 @@ func (funcList FuncList) Handler(event []interface{}) error {
 
 	return nil
 }
+
+// funcListContext is a list of handlers with a context.
+type funcListContext map[int][]EventNewContextFunc
+
+// handler calls handlers of the event until one of them returns an error.
+func (funcList funcListContext) handler(ctx context.Context, event []interface{}) error {
+	key := int(event[0].(float64))
+
+	for _, f := range funcList[key] {
+		if err := f(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package longpoll
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/internal"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+// Message flags of events.
+const (
+	flagOutbox    = 1 << 1
+	flagImportant = 1 << 3
+	flagDeleted   = 1 << 7
+	flagMedia     = 1 << 9
+	flagHidden    = 1 << 16
+)
+
+// History returns events since Pts in the format of Long Poll updates
+// and moves Pts to the last event.
+//
+// Events of new and edited messages are filled with the messages from
+// the response, so they can be parsed by the wrapper. Attachments of
+// such messages are not filled; use messages.getById to get them.
+//
+// https://vk.com/dev/messages.getLongPollHistory
+func (lp *Longpoll) History(ctx context.Context) ([][]interface{}, error) {
+	var updates [][]interface{}
+
+	pts := lp.Pts
+
+	for {
+		resp, err := lp.VK.MessagesGetLongPollHistoryContext(ctx, api.Params{
+			"pts":        pts,
+			"lp_version": lp.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		messages := make(map[int]object.MessagesMessage, len(resp.Messages.Items))
+		for _, msg := range resp.Messages.Items {
+			messages[msg.ID] = msg
+		}
+
+		for _, event := range resp.History {
+			updates = append(updates, historyEvent(event, messages))
+		}
+
+		if resp.NewPTS == 0 || resp.NewPTS == pts {
+			break
+		}
+
+		pts = resp.NewPTS
+
+		if !resp.More {
+			break
+		}
+	}
+
+	lp.Pts = pts
+
+	return updates, nil
+}
+
+// historyEvent converts the event of messages.getLongPollHistory
+// into the format of Long Poll updates.
+func historyEvent(event []int, messages map[int]object.MessagesMessage) []interface{} {
+	if len(event) > 1 && (event[0] == 4 || event[0] == 5) {
+		if msg, ok := messages[event[1]]; ok {
+			return messageEvent(event[0], msg)
+		}
+	}
+
+	result := make([]interface{}, len(event))
+	for i, v := range event {
+		result[i] = float64(v)
+	}
+
+	return result
+}
+
+// messageEvent returns the event of a new or edited message with extra
+// fields.
+func messageEvent(code int, msg object.MessagesMessage) []interface{} {
+	flags := 0
+
+	if msg.Out {
+		flags |= flagOutbox
+	}
+
+	if msg.Important {
+		flags |= flagImportant
+	}
+
+	if msg.Deleted {
+		flags |= flagDeleted
+	}
+
+	if len(msg.Attachments) > 0 {
+		flags |= flagMedia
+	}
+
+	if msg.IsHidden {
+		flags |= flagHidden
+	}
+
+	date := msg.Date
+	if code == 5 && msg.UpdateTime != 0 {
+		date = msg.UpdateTime
+	}
+
+	extra := map[string]interface{}{
+		"title": "",
+	}
+
+	if msg.PeerID > internal.ChatPeerID {
+		extra["from"] = strconv.Itoa(msg.FromID)
+	}
+
+	if msg.RefSource != "" {
+		extra["ref_source"] = msg.RefSource
+	}
+
+	return []interface{}{
+		float64(code),
+		float64(msg.ID),
+		float64(flags),
+		float64(msg.PeerID),
+		float64(date),
+		msg.Text,
+		extra,
+		map[string]interface{}{},
+	}
+}
@@ -47,7 +47,29 @@ Wrapper for v3 https://pkg.go.dev/github.com/SevereCloud/vksdk/longpoll-user/v3
 
 Run and shutdown
 
-TODO: write about lp.Run() and lp.Shutdown()
+Run handles events until Shutdown is called or an error occurs.
+RunResilient passes errors to ErrorHandler and reconnects after a delay.
+RunResilientContext also returns when the context is canceled.
+
+	lp.ErrorHandler = func(err error) {
+		log.Println(err)
+	}
+
+	go lp.RunResilient()
+	defer lp.Shutdown()
+
+History
+
+If VK returns "failed":1 or "failed":3, events between the old and the new
+Ts are lost. With ReturnPts mode the lost events are requested with
+messages.getLongPollHistory using the last Pts and are passed to the
+handlers before the next request. Events missed after a restart can be
+requested with History.
+
+	lp, err := longpoll.NewLongpoll(vk, longpoll.ReturnPts)
+	lp.Pts = savedPts
+
+	updates, err := lp.History(ctx)
 
 
 VK documentation https://vk.com/dev/using_longpoll
@@ -55,12 +77,15 @@ VK documentation https://vk.com/dev/using_longpoll
 package longpoll // import "github.com/SevereCloud/vksdk/longpoll-user"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync/atomic"
+	"time"
 
 	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/internal"
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -92,9 +117,28 @@ type Longpoll struct {
 	VK      *api.VK
 	Client  *http.Client
 
-	funcList             FuncList
+	// Pts is the persistent timestamp of the last event. It is used with
+	// ReturnPts mode to request lost events.
+	Pts int
+
+	// ErrorHandler is called by RunResilient on errors of requests
+	// and event handlers.
+	ErrorHandler func(err error)
+
+	// ReconnectDelay is how long RunResilient waits after a failed
+	// request of updates or history. Each failure in a row doubles
+	// the wait up to MaxReconnectDelay.
+	ReconnectDelay    time.Duration
+	MaxReconnectDelay time.Duration
+
+	// Timeout sets the deadline of the context passed to handlers added
+	// by EventNewContext. If zero, the context has no deadline.
+	Timeout time.Duration
+
+	funcList             funcListContext
 	funcFullResponseList []func(object.LongpollResponse)
 	inShutdown           int32
+	shutdown             internal.Shutdown
 }
 
 // NewLongpoll returns a new Longpoll.
@@ -108,7 +152,7 @@ func NewLongpoll(vk *api.VK, mode Mode) (*Longpoll, error) {
 		Mode:     mode,
 		Version:  3,
 		Wait:     25,
-		funcList: make(FuncList),
+		funcList: make(funcListContext),
 		Client:   http.DefaultClient,
 	}
 
@@ -126,7 +170,7 @@ func Init(vk *api.VK, mode Mode) (lp Longpoll, err error) {
 	lp.Mode = mode
 	lp.Version = 3
 	lp.Wait = 25
-	lp.funcList = make(FuncList)
+	lp.funcList = make(funcListContext)
 	lp.Client = &http.Client{}
 	err = lp.updateServer(true)
 
@@ -137,6 +181,11 @@ func (lp *Longpoll) updateServer(updateTs bool) error {
 	params := api.Params{
 		"lp_version": lp.Version,
 	}
+
+	if lp.Mode&ReturnPts != 0 {
+		params["need_pts"] = 1
+	}
+
 	serverSetting, err := lp.VK.MessagesGetLongPollServer(params)
 
 	if err != nil {
@@ -150,10 +199,15 @@ func (lp *Longpoll) updateServer(updateTs bool) error {
 		lp.Ts = serverSetting.Ts
 	}
 
+	// The old Pts is kept to request lost events.
+	if lp.Pts == 0 {
+		lp.Pts = serverSetting.Pts
+	}
+
 	return nil
 }
 
-func (lp *Longpoll) check() (response object.LongpollResponse, err error) {
+func (lp *Longpoll) check(ctx context.Context) (response object.LongpollResponse, err error) {
 	u := fmt.Sprintf(
 		"https://%s?act=a_check&key=%s&ts=%d&wait=%d&mode=%d&version=%d",
 		lp.Server,
@@ -164,7 +218,12 @@ func (lp *Longpoll) check() (response object.LongpollResponse, err error) {
 		lp.Version,
 	)
 
-	resp, err := lp.Client.Get(u)
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return
+	}
+
+	resp, err := lp.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return
 	}
@@ -184,6 +243,10 @@ func (lp *Longpoll) checkResponse(response object.LongpollResponse) (err error)
 	switch response.Failed {
 	case 0:
 		lp.Ts = response.Ts
+
+		if response.Pts != 0 {
+			lp.Pts = response.Pts
+		}
 	case 1:
 		lp.Ts = response.Ts
 	case 2:
@@ -204,13 +267,22 @@ func (lp *Longpoll) Run() error {
 	atomic.StoreInt32(&lp.inShutdown, 0)
 
 	for atomic.LoadInt32(&lp.inShutdown) == 0 {
-		resp, err := lp.check()
+		resp, err := lp.check(context.Background())
 		if err != nil {
 			return err
 		}
 
+		if lp.lost(resp) {
+			updates, err := lp.History(context.Background())
+			if err != nil {
+				return err
+			}
+
+			resp.Updates = append(updates, resp.Updates...)
+		}
+
 		for _, event := range resp.Updates {
-			if err := lp.funcList.Handler(event); err != nil {
+			if err := lp.handle(context.Background(), event); err != nil {
 				return err
 			}
 		}
@@ -223,13 +295,127 @@ func (lp *Longpoll) Run() error {
 	return nil
 }
 
+// RunResilient handler.
+//
+// Unlike Run, it does not return on errors. Errors of requests are passed
+// to ErrorHandler and the request is repeated after a delay. If lost
+// events can not be requested, the request of history is repeated before
+// the next request to the server. Errors of event handlers are passed to
+// ErrorHandler and the remaining events are handled.
+//
+// Events which happen while the history is requested may be handled twice.
+func (lp *Longpoll) RunResilient() error {
+	return lp.RunResilientContext(context.Background())
+}
+
+// RunResilientContext handler.
+//
+// If ctx is canceled, the current request or delay is interrupted and
+// ctx.Err() is returned.
+func (lp *Longpoll) RunResilientContext(ctx context.Context) error {
+	atomic.StoreInt32(&lp.inShutdown, 0)
+	lp.shutdown.Reset()
+
+	backoff := internal.Backoff{Min: lp.ReconnectDelay, Max: lp.MaxReconnectDelay}
+	lost := false
+
+	for atomic.LoadInt32(&lp.inShutdown) == 0 && ctx.Err() == nil {
+		var updates [][]interface{}
+
+		if lost {
+			var err error
+
+			updates, err = lp.History(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+
+				internal.HandleError(lp.ErrorHandler, err)
+				internal.Sleep(ctx, backoff.Next(), lp.shutdown.Done())
+
+				continue
+			}
+
+			lost = false
+		}
+
+		resp, err := lp.check(ctx)
+		if lp.lost(resp) {
+			lost = true
+		}
+
+		if err != nil {
+			lp.handleEvents(ctx, updates)
+
+			if ctx.Err() != nil {
+				break
+			}
+
+			internal.HandleError(lp.ErrorHandler, err)
+			internal.Sleep(ctx, backoff.Next(), lp.shutdown.Done())
+
+			continue
+		}
+
+		backoff.Reset()
+
+		lp.handleEvents(ctx, append(updates, resp.Updates...))
+
+		for _, f := range lp.funcFullResponseList {
+			f(resp)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// lost reports whether events between the old and the new Ts are lost
+// and can be requested with History.
+//
+// "failed":2 is not checked: only the key is expired, the old Ts is
+// kept by updateServer(false), so the next request with the new key
+// returns the events since the old Ts.
+func (lp *Longpoll) lost(resp object.LongpollResponse) bool {
+	return (resp.Failed == 1 || resp.Failed == 3) &&
+		lp.Mode&ReturnPts != 0 && lp.Pts != 0
+}
+
+func (lp *Longpoll) handleEvents(ctx context.Context, updates [][]interface{}) {
+	for _, event := range updates {
+		if err := lp.handle(ctx, event); err != nil {
+			internal.HandleError(lp.ErrorHandler, err)
+		}
+	}
+}
+
+// handle calls handlers of the event with the deadline set by Timeout.
+func (lp *Longpoll) handle(ctx context.Context, event []interface{}) error {
+	if lp.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, lp.Timeout)
+		defer cancel()
+	}
+
+	return lp.funcList.handler(ctx, event)
+}
+
 // Shutdown gracefully shuts down the longpoll without interrupting any active connections.
 func (lp *Longpoll) Shutdown() {
 	atomic.StoreInt32(&lp.inShutdown, 1)
+	lp.shutdown.Close()
 }
 
 // EventNew handler.
 func (lp *Longpoll) EventNew(key int, f EventNewFunc) {
+	lp.EventNewContext(key, func(_ context.Context, event []interface{}) error {
+		return f(event)
+	})
+}
+
+// EventNewContext handler with a context.
+func (lp *Longpoll) EventNewContext(key int, f EventNewContextFunc) {
 	lp.funcList[key] = append(lp.funcList[key], f)
 }
 
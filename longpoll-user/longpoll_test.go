@@ -0,0 +1,240 @@
+package longpoll_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/longpoll-user"
+	wrapper "github.com/SevereCloud/vksdk/longpoll-user/v3"
+	"github.com/SevereCloud/vksdk/object"
+)
+
+func newFakeVK(t *testing.T, lp func(n int) string) (*api.VK, *httptest.Server, *[]string) {
+	t.Helper()
+
+	var history []string
+
+	n := 0
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/method/messages.getLongPollServer":
+			_, _ = w.Write([]byte(`{"response":{"key":"key","server":"` + r.Host + `/lp","ts":100,"pts":10}}`))
+		case "/method/messages.getLongPollHistory":
+			history = append(history, r.Form.Get("pts"))
+
+			if r.Form.Get("pts") == "10" {
+				_, _ = w.Write([]byte(`{"response":{
+					"history":[[4,1,1,2000000001],[6,5,1]],
+					"messages":{"count":1,"items":[
+						{"id":1,"date":50,"from_id":7,"peer_id":2000000001,"text":"lost","out":1}
+					]},
+					"new_pts":11,
+					"more":1
+				}}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"response":{"history":[[4,2,0,8]],"messages":{"count":0,"items":[]},"new_pts":12}}`))
+		case "/lp":
+			n++
+			_, _ = w.Write([]byte(lp(n)))
+		}
+	}))
+
+	vk := api.NewVK("")
+	vk.MethodURL = ts.URL + "/method/"
+	vk.Client = ts.Client()
+
+	return vk, ts, &history
+}
+
+func TestLongpoll_History(t *testing.T) {
+	t.Parallel()
+
+	vk, ts, history := newFakeVK(t, nil)
+	defer ts.Close()
+
+	lp, err := longpoll.NewLongpoll(vk, longpoll.ReturnPts)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, lp.Pts)
+
+	updates, err := lp.History(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "11"}, *history)
+	assert.Equal(t, 12, lp.Pts)
+	assert.Equal(t, [][]interface{}{
+		{
+			4.0, 1.0, 2.0, 2000000001.0, 50.0, "lost",
+			map[string]interface{}{"title": "", "from": "7"},
+			map[string]interface{}{},
+		},
+		{6.0, 5.0, 1.0},
+		{4.0, 2.0, 0.0, 8.0},
+	}, updates)
+}
+
+func TestLongpoll_RunResilient(t *testing.T) {
+	t.Parallel()
+
+	vk, ts, history := newFakeVK(t, func(n int) string {
+		switch n {
+		case 1:
+			return `{"failed":3}`
+		case 2:
+			return `bad json`
+		case 3:
+			return `{"ts":101,"pts":13,"updates":[[4,3,0,9,60,"new",{},{}]]}`
+		default:
+			return `{"ts":102,"updates":[]}`
+		}
+	})
+	defer ts.Close()
+
+	lp, err := longpoll.NewLongpoll(vk, longpoll.ReturnPts)
+	assert.NoError(t, err)
+
+	lp.Client = ts.Client()
+	lp.ReconnectDelay = time.Millisecond
+
+	var (
+		messages []string
+		errs     []error
+	)
+
+	w := wrapper.NewWrapper(lp)
+	w.OnNewMessage(func(m wrapper.NewMessage) {
+		messages = append(messages, m.Text)
+	})
+
+	lp.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+	}
+
+	lp.FullResponse(func(resp object.LongpollResponse) {
+		if resp.Ts == 102 {
+			lp.Shutdown()
+		}
+	})
+
+	assert.NoError(t, lp.RunResilient())
+	assert.Equal(t, []string{"10", "11"}, *history)
+	assert.Equal(t, []string{"lost", "", "new"}, messages)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, 13, lp.Pts)
+}
+
+func TestLongpoll_RunResilientContext(t *testing.T) {
+	t.Parallel()
+
+	// "failed":2 only expires the key, events since Ts are returned with
+	// the new key, so the history is not requested
+	vk, ts, history := newFakeVK(t, func(n int) string {
+		switch n {
+		case 1:
+			return `{"failed":2}`
+		case 2:
+			return `{"ts":101,"updates":[[4,3,0,9,60,"new",{},{}]]}`
+		default:
+			return `{"failed":5}`
+		}
+	})
+	defer ts.Close()
+
+	lp, err := longpoll.NewLongpoll(vk, longpoll.ReturnPts)
+	assert.NoError(t, err)
+
+	lp.Client = ts.Client()
+	lp.ReconnectDelay = time.Hour
+
+	var messages []string
+
+	w := wrapper.NewWrapper(lp)
+	w.OnNewMessage(func(m wrapper.NewMessage) {
+		messages = append(messages, m.Text)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// the delay after the error is interrupted
+	lp.ErrorHandler = func(err error) {
+		cancel()
+	}
+
+	assert.Equal(t, context.Canceled, lp.RunResilientContext(ctx))
+	assert.Empty(t, *history)
+	assert.Equal(t, []string{"new"}, messages)
+	assert.Equal(t, 101, lp.Ts)
+}
+
+func TestLongpoll_Run(t *testing.T) {
+	t.Parallel()
+
+	vk, ts, history := newFakeVK(t, func(n int) string {
+		if n == 1 {
+			return `{"failed":1,"ts":101}`
+		}
+
+		return `bad json`
+	})
+	defer ts.Close()
+
+	lp, err := longpoll.NewLongpoll(vk, longpoll.ReturnPts)
+	assert.NoError(t, err)
+
+	lp.Client = ts.Client()
+
+	var messages []string
+
+	w := wrapper.NewWrapper(lp)
+	w.OnNewMessage(func(m wrapper.NewMessage) {
+		messages = append(messages, m.Text)
+	})
+
+	assert.Error(t, lp.Run())
+	assert.Equal(t, []string{"10", "11"}, *history)
+	assert.Equal(t, []string{"lost", ""}, messages)
+	assert.Equal(t, 101, lp.Ts)
+}
+
+func TestLongpoll_Timeout(t *testing.T) {
+	t.Parallel()
+
+	vk, ts, _ := newFakeVK(t, func(n int) string {
+		if n == 1 {
+			return `{"ts":101,"updates":[[4,1,1,8]]}`
+		}
+
+		return `bad json`
+	})
+	defer ts.Close()
+
+	lp, err := longpoll.NewLongpoll(vk, 0)
+	assert.NoError(t, err)
+
+	lp.Client = ts.Client()
+	lp.Timeout = time.Minute
+
+	var deadlines []bool
+
+	lp.EventNewContext(4, func(ctx context.Context, event []interface{}) error {
+		_, ok := ctx.Deadline()
+		deadlines = append(deadlines, ok)
+
+		return nil
+	})
+
+	assert.Error(t, lp.Run())
+	assert.Equal(t, []bool{true}, deadlines)
+}
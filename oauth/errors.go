@@ -0,0 +1,55 @@
+package oauth // import "github.com/SevereCloud/vksdk/oauth"
+
+import (
+	"fmt"
+)
+
+// ErrorType is the type of an OAuth error.
+type ErrorType string
+
+// Error types.
+const (
+	ErrInvalidRequest   ErrorType = "invalid_request"
+	ErrInvalidClient    ErrorType = "invalid_client"
+	ErrInvalidGrant     ErrorType = "invalid_grant"
+	ErrInvalidScope     ErrorType = "invalid_scope"
+	ErrUnauthorized     ErrorType = "unauthorized_client"
+	ErrUnsupportedGrant ErrorType = "unsupported_grant_type"
+	ErrAccessDenied     ErrorType = "access_denied"
+	ErrNeedValidation   ErrorType = "need_validation"
+	ErrNeedCaptcha      ErrorType = "need_captcha"
+	ErrInvalidResponse  ErrorType = "invalid_response"
+)
+
+// Error is an error returned by oauth.vk.com.
+type Error struct {
+	Type        ErrorType `json:"error"`
+	Reason      string    `json:"error_reason"`
+	Description string    `json:"error_description"`
+
+	// Fields of ErrNeedValidation.
+	RedirectURI string `json:"redirect_uri"`
+
+	// Fields of ErrNeedCaptcha.
+	CaptchaSID string `json:"captcha_sid"`
+	CaptchaImg string `json:"captcha_img"`
+}
+
+// Error returns the message of Error.
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return "oauth: " + string(e.Type)
+	}
+
+	return fmt.Sprintf("oauth: %s: %s", e.Type, e.Description)
+}
+
+// GetType returns the type of the error. If err is not *Error,
+// the empty type is returned.
+func GetType(err error) ErrorType {
+	if e, ok := err.(*Error); ok {
+		return e.Type
+	}
+
+	return ""
+}
@@ -0,0 +1,295 @@
+/*
+Package oauth implements VK authorization flows.
+
+Authorization code flow for users:
+
+	conf := &oauth.Config{
+		ClientID:     6888183,
+		ClientSecret: "secret",
+		RedirectURI:  "https://example.com/callback",
+		Scope:        oauth.ScopeUserFriends | oauth.ScopeUserOffline,
+	}
+
+	http.Redirect(w, r, conf.AuthCodeURL(state), http.StatusFound)
+
+	// in the callback handler
+	code, state, err := oauth.ParseCode(r.URL)
+	token, err := conf.Exchange(ctx, code)
+
+See more https://vk.com/dev/access_token
+*/
+package oauth // import "github.com/SevereCloud/vksdk/oauth"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/internal"
+)
+
+// OAuth endpoints.
+const (
+	AuthorizeURL       = "https://oauth.vk.com/authorize"
+	AccessTokenURL     = "https://oauth.vk.com/access_token"
+	DefaultRedirectURI = "https://oauth.vk.com/blank.html"
+)
+
+// Context keys to use with https://golang.org/pkg/context
+// WithValue function to associate.
+const (
+	HTTPClient = internal.HTTPClientKey
+	UserAgent  = internal.UserAgentKey
+)
+
+// Display types of the authorization page.
+const (
+	DisplayPage   = "page"
+	DisplayPopup  = "popup"
+	DisplayMobile = "mobile"
+)
+
+// Config describes a VK application.
+type Config struct {
+	ClientID     int
+	ClientSecret string
+
+	// RedirectURI is the address the user is redirected to after
+	// authorization. If empty, DefaultRedirectURI is used.
+	RedirectURI string
+
+	// Scope is a bitmask of ScopeUser* or ScopeGroup* permissions.
+	Scope int
+
+	// Display is a type of the authorization page.
+	Display string
+
+	// Revoke asks the user to grant permissions again, even if they
+	// are already granted.
+	Revoke bool
+
+	// Version of VK API. If empty, api.Version is used.
+	Version string
+
+	// AuthorizeURL and AccessTokenURL replace the default endpoints.
+	AuthorizeURL   string
+	AccessTokenURL string
+}
+
+// UserToken is an access token of a user.
+type UserToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"` // 0 means the token never expires
+	UserID      int    `json:"user_id"`
+	Email       string `json:"email"`
+	State       string `json:"state"`
+}
+
+// GroupToken contains access tokens of communities.
+type GroupToken struct {
+	Tokens    map[int]string // community ID => access token
+	ExpiresIn int
+	State     string
+}
+
+// ServiceToken is an access token of an application.
+type ServiceToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (c *Config) redirectURI() string {
+	if c.RedirectURI == "" {
+		return DefaultRedirectURI
+	}
+
+	return c.RedirectURI
+}
+
+func (c *Config) version() string {
+	if c.Version == "" {
+		return api.Version
+	}
+
+	return c.Version
+}
+
+func (c *Config) authURL(responseType, state string, groupIDs []int) string {
+	u := c.AuthorizeURL
+	if u == "" {
+		u = AuthorizeURL
+	}
+
+	q := url.Values{}
+	q.Set("client_id", strconv.Itoa(c.ClientID))
+	q.Set("redirect_uri", c.redirectURI())
+	q.Set("response_type", responseType)
+	q.Set("v", c.version())
+
+	if c.Scope != 0 {
+		q.Set("scope", strconv.Itoa(c.Scope))
+	}
+
+	if c.Display != "" {
+		q.Set("display", c.Display)
+	}
+
+	if c.Revoke {
+		q.Set("revoke", "1")
+	}
+
+	if state != "" {
+		q.Set("state", state)
+	}
+
+	if len(groupIDs) > 0 {
+		ids := make([]string, len(groupIDs))
+		for i, id := range groupIDs {
+			ids[i] = strconv.Itoa(id)
+		}
+
+		q.Set("group_ids", strings.Join(ids, ","))
+	}
+
+	return u + "?" + q.Encode()
+}
+
+// AuthCodeURL returns the URL of the authorization page for the
+// authorization code flow.
+//
+// See https://vk.com/dev/authcode_flow_user
+func (c *Config) AuthCodeURL(state string) string {
+	return c.authURL("code", state, nil)
+}
+
+// ImplicitURL returns the URL of the authorization page for the
+// implicit flow. The token is passed in the fragment of the redirect URI,
+// use ParseUserToken.
+//
+// See https://vk.com/dev/implicit_flow_user
+func (c *Config) ImplicitURL(state string) string {
+	return c.authURL("token", state, nil)
+}
+
+// GroupAuthCodeURL returns the URL of the authorization page for the
+// authorization code flow of communities.
+//
+// See https://vk.com/dev/authcode_flow_group
+func (c *Config) GroupAuthCodeURL(state string, groupIDs ...int) string {
+	return c.authURL("code", state, groupIDs)
+}
+
+// GroupImplicitURL returns the URL of the authorization page for the
+// implicit flow of communities. The tokens are passed in the fragment
+// of the redirect URI, use ParseGroupToken.
+//
+// See https://vk.com/dev/implicit_flow_group
+func (c *Config) GroupImplicitURL(state string, groupIDs ...int) string {
+	return c.authURL("token", state, groupIDs)
+}
+
+// Exchange exchanges the code for the user token.
+//
+// See https://vk.com/dev/authcode_flow_user
+func (c *Config) Exchange(ctx context.Context, code string) (*UserToken, error) {
+	var token UserToken
+
+	err := c.accessToken(ctx, url.Values{"code": {code}}, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// ExchangeGroup exchanges the code for the community tokens.
+//
+// See https://vk.com/dev/authcode_flow_group
+func (c *Config) ExchangeGroup(ctx context.Context, code string) (*GroupToken, error) {
+	var raw map[string]json.RawMessage
+
+	err := c.accessToken(ctx, url.Values{"code": {code}}, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(url.Values)
+
+	for key, value := range raw {
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			s = string(value)
+		}
+
+		values.Set(key, s)
+	}
+
+	return groupTokenFromValues(values)
+}
+
+// ClientCredentials returns the service token of the application.
+//
+// See https://vk.com/dev/client_cred_flow
+func (c *Config) ClientCredentials(ctx context.Context) (*ServiceToken, error) {
+	var token ServiceToken
+
+	err := c.accessToken(ctx, url.Values{"grant_type": {"client_credentials"}}, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// accessToken requests access_token endpoint and unmarshals the response.
+func (c *Config) accessToken(ctx context.Context, q url.Values, obj interface{}) error {
+	u := c.AccessTokenURL
+	if u == "" {
+		u = AccessTokenURL
+	}
+
+	q.Set("client_id", strconv.Itoa(c.ClientID))
+	q.Set("client_secret", c.ClientSecret)
+	q.Set("v", c.version())
+
+	if q.Get("grant_type") == "" {
+		q.Set("redirect_uri", c.redirectURI())
+	}
+
+	req, err := http.NewRequest("POST", u, strings.NewReader(q.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := internal.DoRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body json.RawMessage
+
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil {
+		return &Error{
+			Type:        ErrInvalidResponse,
+			Description: fmt.Sprintf("%s: %s", resp.Status, err),
+		}
+	}
+
+	var oauthErr Error
+
+	err = json.Unmarshal(body, &oauthErr)
+	if err == nil && oauthErr.Type != "" {
+		return &oauthErr
+	}
+
+	return json.Unmarshal(body, obj)
+}
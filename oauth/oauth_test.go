@@ -0,0 +1,130 @@
+package oauth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/oauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestConfig(handler http.HandlerFunc) (*oauth.Config, *httptest.Server) {
+	ts := httptest.NewServer(handler)
+
+	return &oauth.Config{
+		ClientID:       1,
+		ClientSecret:   "secret",
+		RedirectURI:    "https://example.com/callback",
+		Version:        "5.103",
+		AccessTokenURL: ts.URL,
+	}, ts
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	conf := &oauth.Config{
+		ClientID: 1,
+		Scope:    oauth.ScopeUserFriends | oauth.ScopeUserOffline,
+		Display:  oauth.DisplayPage,
+		Revoke:   true,
+		Version:  "5.103",
+	}
+
+	f := func(rawURL, responseType, groupIDs string) {
+		t.Helper()
+
+		u, err := url.Parse(rawURL)
+		assert.NoError(t, err)
+
+		q := u.Query()
+		assert.Equal(t, "oauth.vk.com", u.Host)
+		assert.Equal(t, "/authorize", u.Path)
+		assert.Equal(t, "1", q.Get("client_id"))
+		assert.Equal(t, oauth.DefaultRedirectURI, q.Get("redirect_uri"))
+		assert.Equal(t, "65538", q.Get("scope"))
+		assert.Equal(t, "page", q.Get("display"))
+		assert.Equal(t, "1", q.Get("revoke"))
+		assert.Equal(t, "5.103", q.Get("v"))
+		assert.Equal(t, "xyz", q.Get("state"))
+		assert.Equal(t, responseType, q.Get("response_type"))
+		assert.Equal(t, groupIDs, q.Get("group_ids"))
+	}
+
+	f(conf.AuthCodeURL("xyz"), "code", "")
+	f(conf.ImplicitURL("xyz"), "token", "")
+	f(conf.GroupAuthCodeURL("xyz", 1, 2), "code", "1,2")
+	f(conf.GroupImplicitURL("xyz", 1), "token", "1")
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	t.Parallel()
+
+	conf, ts := newTestConfig(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.FormValue("client_id"))
+		assert.Equal(t, "secret", r.FormValue("client_secret"))
+		assert.Equal(t, "https://example.com/callback", r.FormValue("redirect_uri"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.FormValue("code") {
+		case "user":
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":86400,"user_id":1,"email":"a@b.c"}`))
+		case "group":
+			_, _ = w.Write([]byte(`{"access_token_1":"token1","access_token_2":"token2","expires_in":0}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Code is invalid or expired."}`))
+		}
+	})
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	token, err := conf.Exchange(ctx, "user")
+	assert.NoError(t, err)
+	assert.Equal(t, &oauth.UserToken{
+		AccessToken: "token",
+		ExpiresIn:   86400,
+		UserID:      1,
+		Email:       "a@b.c",
+	}, token)
+
+	groupToken, err := conf.ExchangeGroup(ctx, "group")
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "token1", 2: "token2"}, groupToken.Tokens)
+
+	_, err = conf.Exchange(ctx, "bad")
+	assert.Equal(t, oauth.ErrInvalidGrant, oauth.GetType(err))
+	assert.EqualError(t, err, "oauth: invalid_grant: Code is invalid or expired.")
+
+	_, err = conf.ExchangeGroup(ctx, "user")
+	assert.Equal(t, oauth.ErrInvalidResponse, oauth.GetType(err))
+}
+
+func TestConfig_ClientCredentials(t *testing.T) {
+	t.Parallel()
+
+	conf, ts := newTestConfig(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Empty(t, r.FormValue("redirect_uri"))
+
+		_, _ = w.Write([]byte(`{"access_token":"service","expires_in":0}`))
+	})
+	defer ts.Close()
+
+	token, err := conf.ClientCredentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "service", token.AccessToken)
+
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(`<html>`))
+	})
+
+	_, err = conf.ClientCredentials(context.Background())
+	assert.Equal(t, oauth.ErrInvalidResponse, oauth.GetType(err))
+}
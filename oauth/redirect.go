@@ -0,0 +1,128 @@
+package oauth // import "github.com/SevereCloud/vksdk/oauth"
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// errorFromValues returns an error passed in the redirect URI.
+func errorFromValues(values url.Values) error {
+	if values.Get("error") == "" {
+		return nil
+	}
+
+	return &Error{
+		Type:        ErrorType(values.Get("error")),
+		Reason:      values.Get("error_reason"),
+		Description: values.Get("error_description"),
+	}
+}
+
+// ParseCode returns the code and the state from the query of the redirect
+// URI of the authorization code flow.
+func ParseCode(u *url.URL) (code, state string, err error) {
+	values, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return "", "", err
+	}
+
+	state = values.Get("state")
+
+	err = errorFromValues(values)
+	if err != nil {
+		return "", state, err
+	}
+
+	code = values.Get("code")
+	if code == "" {
+		return "", state, &Error{
+			Type:        ErrInvalidResponse,
+			Description: "code not found",
+		}
+	}
+
+	return code, state, nil
+}
+
+// ParseUserToken returns the user token from the fragment of the redirect
+// URI of the implicit flow.
+func ParseUserToken(u *url.URL) (*UserToken, error) {
+	values, err := url.ParseQuery(u.Fragment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = errorFromValues(values)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &UserToken{
+		AccessToken: values.Get("access_token"),
+		Email:       values.Get("email"),
+		State:       values.Get("state"),
+	}
+
+	if token.AccessToken == "" {
+		return nil, &Error{
+			Type:        ErrInvalidResponse,
+			Description: "access_token not found",
+		}
+	}
+
+	token.ExpiresIn, _ = strconv.Atoi(values.Get("expires_in"))
+	token.UserID, _ = strconv.Atoi(values.Get("user_id"))
+
+	return token, nil
+}
+
+// ParseGroupToken returns the community tokens from the fragment of
+// the redirect URI of the implicit flow.
+func ParseGroupToken(u *url.URL) (*GroupToken, error) {
+	values, err := url.ParseQuery(u.Fragment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = errorFromValues(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupTokenFromValues(values)
+}
+
+// groupTokenFromValues collects access_token_{group_id} values.
+func groupTokenFromValues(values url.Values) (*GroupToken, error) {
+	const prefix = "access_token_"
+
+	token := &GroupToken{
+		Tokens: make(map[int]string),
+		State:  values.Get("state"),
+	}
+
+	for key := range values {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		groupID, err := strconv.Atoi(strings.TrimPrefix(key, prefix))
+		if err != nil {
+			continue
+		}
+
+		token.Tokens[groupID] = values.Get(key)
+	}
+
+	if len(token.Tokens) == 0 {
+		return nil, &Error{
+			Type:        ErrInvalidResponse,
+			Description: "access_token not found",
+		}
+	}
+
+	token.ExpiresIn, _ = strconv.Atoi(values.Get("expires_in"))
+
+	return token, nil
+}
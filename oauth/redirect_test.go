@@ -0,0 +1,76 @@
+package oauth_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/SevereCloud/vksdk/oauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	assert.NoError(t, err)
+
+	return u
+}
+
+func TestParseCode(t *testing.T) {
+	t.Parallel()
+
+	code, state, err := oauth.ParseCode(mustParse(t, "https://example.com/callback?code=abc&state=xyz"))
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", code)
+	assert.Equal(t, "xyz", state)
+
+	_, state, err = oauth.ParseCode(mustParse(t,
+		"https://example.com/callback?error=access_denied&error_reason=user_denied&error_description=User+denied+your+request&state=xyz",
+	))
+	assert.Equal(t, "xyz", state)
+	assert.Equal(t, &oauth.Error{
+		Type:        oauth.ErrAccessDenied,
+		Reason:      "user_denied",
+		Description: "User denied your request",
+	}, err)
+
+	_, _, err = oauth.ParseCode(mustParse(t, "https://example.com/callback"))
+	assert.Equal(t, oauth.ErrInvalidResponse, oauth.GetType(err))
+}
+
+func TestParseUserToken(t *testing.T) {
+	t.Parallel()
+
+	token, err := oauth.ParseUserToken(mustParse(t,
+		"https://oauth.vk.com/blank.html#access_token=token&expires_in=0&user_id=1&state=xyz",
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, &oauth.UserToken{
+		AccessToken: "token",
+		UserID:      1,
+		State:       "xyz",
+	}, token)
+
+	_, err = oauth.ParseUserToken(mustParse(t, "https://oauth.vk.com/blank.html#error=access_denied"))
+	assert.Equal(t, oauth.ErrAccessDenied, oauth.GetType(err))
+
+	_, err = oauth.ParseUserToken(mustParse(t, "https://oauth.vk.com/blank.html"))
+	assert.Equal(t, oauth.ErrInvalidResponse, oauth.GetType(err))
+}
+
+func TestParseGroupToken(t *testing.T) {
+	t.Parallel()
+
+	token, err := oauth.ParseGroupToken(mustParse(t,
+		"https://oauth.vk.com/blank.html#access_token_1=a&access_token_2=b&expires_in=86400",
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, &oauth.GroupToken{
+		Tokens:    map[int]string{1: "a", 2: "b"},
+		ExpiresIn: 86400,
+	}, token)
+
+	_, err = oauth.ParseGroupToken(mustParse(t, "https://oauth.vk.com/blank.html#error=access_denied"))
+	assert.Equal(t, oauth.ErrAccessDenied, oauth.GetType(err))
+}
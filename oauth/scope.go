@@ -0,0 +1,93 @@
+package oauth // import "github.com/SevereCloud/vksdk/oauth"
+
+// Access permissions for user token.
+//
+// See https://vk.com/dev/permissions
+const (
+	// User allowed to send notifications to him/her (for Flash/iFrame apps).
+	ScopeUserNotify = 1 << 0
+
+	// Access to friends.
+	ScopeUserFriends = 1 << 1
+
+	// Access to photos.
+	ScopeUserPhotos = 1 << 2
+
+	// Access to audio.
+	ScopeUserAudio = 1 << 3
+
+	// Access to video.
+	ScopeUserVideo = 1 << 4
+
+	// Access to stories.
+	ScopeUserStories = 1 << 6
+
+	// Access to wiki pages.
+	ScopeUserPages = 1 << 7
+
+	// Addition of link to the application in the left menu.
+	ScopeUserMenu = 1 << 8
+
+	// Access to user status.
+	ScopeUserStatus = 1 << 10
+
+	// Access to notes.
+	ScopeUserNotes = 1 << 11
+
+	// Access to advanced methods for messaging.
+	//
+	// Available only for Standalone applications.
+	ScopeUserMessages = 1 << 12
+
+	// Access to standard and advanced methods for the wall.
+	ScopeUserWall = 1 << 13
+
+	// Access to advanced methods for Ads API.
+	ScopeUserAds = 1 << 15
+
+	// Access to API at any time (you will receive expires_in = 0 in this
+	// case).
+	ScopeUserOffline = 1 << 16
+
+	// Access to docs.
+	ScopeUserDocs = 1 << 17
+
+	// Access to user communities.
+	ScopeUserGroups = 1 << 18
+
+	// Access to notifications about answers to the user.
+	ScopeUserNotifications = 1 << 19
+
+	// Access to statistics of user groups and applications where user
+	// is an administrator.
+	ScopeUserStats = 1 << 20
+
+	// Access to user email.
+	ScopeUserEmail = 1 << 22
+
+	// Access to market.
+	ScopeUserMarket = 1 << 27
+)
+
+// Access permissions for community token.
+//
+// See https://vk.com/dev/permissions
+const (
+	// Access to stories.
+	ScopeGroupStories = 1 << 0
+
+	// Access to photos.
+	ScopeGroupPhotos = 1 << 2
+
+	// Access to community app widgets.
+	ScopeGroupAppWidget = 1 << 6
+
+	// Access to community messages.
+	ScopeGroupMessages = 1 << 12
+
+	// Access to docs.
+	ScopeGroupDocs = 1 << 17
+
+	// Access to administrating the community.
+	ScopeGroupManage = 1 << 18
+)
@@ -430,3 +430,15 @@ type MessageReadObject struct {
 	PeerID        int `json:"peer_id"`
 	ReadMessageID int `json:"read_message_id"`
 }
+
+// MessageEventFunc func.
+type MessageEventFunc func(MessageEventObject, int)
+
+// MessageEventObject struct is sent when a callback button is pressed.
+type MessageEventObject struct {
+	UserID                int             `json:"user_id"`
+	PeerID                int             `json:"peer_id"`
+	EventID               string          `json:"event_id"`
+	Payload               json.RawMessage `json:"payload"`
+	ConversationMessageID int             `json:"conversation_message_id"`
+}
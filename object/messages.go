@@ -3,6 +3,7 @@ package object // import "github.com/SevereCloud/vksdk/object"
 import (
 	"encoding/json"
 	"fmt"
+	"unicode/utf8"
 )
 
 // MessagesAudioMessage struct.
@@ -83,6 +84,8 @@ type MessagesKeyboard struct {
 	Buttons  [][]MessagesKeyboardButton `json:"buttons"`
 	OneTime  BaseBoolInt                `json:"one_time,omitempty"` // Should this keyboard disappear on first use
 	Inline   BaseBoolInt                `json:"inline,omitempty"`
+
+	err error // error of payload encoding
 }
 
 // NewMessagesKeyboard return MessagesKeyboard.
@@ -118,12 +121,14 @@ func (keyboard *MessagesKeyboard) AddRow() *MessagesKeyboard {
 }
 
 // AddTextButton add Text button in last row.
-func (keyboard *MessagesKeyboard) AddTextButton(label string, payload string, color string) *MessagesKeyboard {
+//
+// Payload can be a JSON string or any value which is encoded to JSON.
+func (keyboard *MessagesKeyboard) AddTextButton(label string, payload interface{}, color string) *MessagesKeyboard {
 	button := MessagesKeyboardButton{
 		Action: MessagesKeyboardButtonAction{
 			Type:    ButtonText,
 			Label:   label,
-			Payload: payload,
+			Payload: keyboard.payload(payload),
 		},
 		Color: color,
 	}
@@ -135,11 +140,11 @@ func (keyboard *MessagesKeyboard) AddTextButton(label string, payload string, co
 }
 
 // AddOpenLinkButton add Open Link button in last row.
-func (keyboard *MessagesKeyboard) AddOpenLinkButton(link, label, payload string) *MessagesKeyboard {
+func (keyboard *MessagesKeyboard) AddOpenLinkButton(link, label string, payload interface{}) *MessagesKeyboard {
 	button := MessagesKeyboardButton{
 		Action: MessagesKeyboardButtonAction{
 			Type:    ButtonOpenLink,
-			Payload: payload,
+			Payload: keyboard.payload(payload),
 			Label:   label,
 			Link:    link,
 		},
@@ -152,11 +157,11 @@ func (keyboard *MessagesKeyboard) AddOpenLinkButton(link, label, payload string)
 }
 
 // AddLocationButton add Location button in last row.
-func (keyboard *MessagesKeyboard) AddLocationButton(payload string) *MessagesKeyboard {
+func (keyboard *MessagesKeyboard) AddLocationButton(payload interface{}) *MessagesKeyboard {
 	button := MessagesKeyboardButton{
 		Action: MessagesKeyboardButtonAction{
 			Type:    ButtonLocation,
-			Payload: payload,
+			Payload: keyboard.payload(payload),
 		},
 	}
 
@@ -167,11 +172,11 @@ func (keyboard *MessagesKeyboard) AddLocationButton(payload string) *MessagesKey
 }
 
 // AddVKPayButton add VK Pay button in last row.
-func (keyboard *MessagesKeyboard) AddVKPayButton(payload string, hash string) *MessagesKeyboard {
+func (keyboard *MessagesKeyboard) AddVKPayButton(payload interface{}, hash string) *MessagesKeyboard {
 	button := MessagesKeyboardButton{
 		Action: MessagesKeyboardButtonAction{
 			Type:    ButtonVKPay,
-			Payload: payload,
+			Payload: keyboard.payload(payload),
 			Hash:    hash,
 		},
 	}
@@ -183,13 +188,13 @@ func (keyboard *MessagesKeyboard) AddVKPayButton(payload string, hash string) *M
 }
 
 // AddVKAppsButton add VK Apps button in last row.
-func (keyboard *MessagesKeyboard) AddVKAppsButton(appID, ownerID int, payload, label, hash string) *MessagesKeyboard {
+func (keyboard *MessagesKeyboard) AddVKAppsButton(appID, ownerID int, payload interface{}, label, hash string) *MessagesKeyboard {
 	button := MessagesKeyboardButton{
 		Action: MessagesKeyboardButtonAction{
 			Type:    ButtonVKApp,
 			AppID:   appID,
 			OwnerID: ownerID,
-			Payload: payload,
+			Payload: keyboard.payload(payload),
 			Label:   label,
 			Hash:    hash,
 		},
@@ -201,12 +206,172 @@ func (keyboard *MessagesKeyboard) AddVKAppsButton(appID, ownerID int, payload, l
 	return keyboard
 }
 
+// AddCallbackButton add Callback button in last row.
+//
+// Pressing the button sends message_event event with the payload instead
+// of a message.
+func (keyboard *MessagesKeyboard) AddCallbackButton(label string, payload interface{}, color string) *MessagesKeyboard {
+	button := MessagesKeyboardButton{
+		Action: MessagesKeyboardButtonAction{
+			Type:    ButtonCallback,
+			Label:   label,
+			Payload: keyboard.payload(payload),
+		},
+		Color: color,
+	}
+
+	lastRow := len(keyboard.Buttons) - 1
+	keyboard.Buttons[lastRow] = append(keyboard.Buttons[lastRow], button)
+
+	return keyboard
+}
+
+// payload returns the JSON encoding of the payload of a button.
+func (keyboard *MessagesKeyboard) payload(v interface{}) string {
+	switch p := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return p
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		if keyboard.err == nil {
+			keyboard.err = err
+		}
+
+		return ""
+	}
+
+	return string(b)
+}
+
 // ToJSON returns the JSON encoding of MessagesKeyboard.
+//
+// ToJSON does not check the keyboard, use ToJSONErr. VK methods return
+// the error of Validate before sending the request.
 func (keyboard MessagesKeyboard) ToJSON() string {
 	b, _ := json.Marshal(keyboard)
 	return string(b)
 }
 
+// ToJSONErr returns the JSON encoding of MessagesKeyboard or the error
+// of Validate if the keyboard breaks VK limits.
+func (keyboard MessagesKeyboard) ToJSONErr() (string, error) {
+	if err := keyboard.Validate(); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(keyboard)
+
+	return string(b), err
+}
+
+// Keyboard limits.
+const (
+	KeyboardMaxRows          = 10
+	KeyboardInlineMaxRows    = 6
+	KeyboardMaxButtons       = 40
+	KeyboardInlineMaxButtons = 10
+	KeyboardMaxRowButtons    = 5
+	KeyboardMaxPayload       = 255
+	KeyboardMaxLabel         = 40
+)
+
+// KeyboardError is an error of MessagesKeyboard validation.
+//
+// Row and Button are indexes of the invalid button or -1 if the error
+// is not related to a button.
+type KeyboardError struct {
+	Row    int
+	Button int
+	Reason string
+}
+
+// Error returns the message of KeyboardError.
+func (e *KeyboardError) Error() string {
+	if e.Button < 0 {
+		return "object: keyboard: " + e.Reason
+	}
+
+	return fmt.Sprintf("object: keyboard button [%d][%d]: %s", e.Row, e.Button, e.Reason)
+}
+
+// Validate checks the keyboard against VK limits: the number of rows
+// and buttons, the length of payloads and labels, buttons which take
+// the whole row.
+func (keyboard MessagesKeyboard) Validate() error {
+	if keyboard.err != nil {
+		return keyboard.err
+	}
+
+	maxRows, maxButtons := KeyboardMaxRows, KeyboardMaxButtons
+	if keyboard.Inline {
+		maxRows, maxButtons = KeyboardInlineMaxRows, KeyboardInlineMaxButtons
+	}
+
+	if len(keyboard.Buttons) > maxRows {
+		return &KeyboardError{-1, -1, fmt.Sprintf("more than %d rows", maxRows)}
+	}
+
+	count := 0
+
+	for i, row := range keyboard.Buttons {
+		if len(row) > KeyboardMaxRowButtons {
+			return &KeyboardError{i, -1, fmt.Sprintf("more than %d buttons in row %d", KeyboardMaxRowButtons, i)}
+		}
+
+		for j, button := range row {
+			if err := button.validate(len(row)); err != "" {
+				return &KeyboardError{i, j, err}
+			}
+		}
+
+		count += len(row)
+	}
+
+	if count > maxButtons {
+		return &KeyboardError{-1, -1, fmt.Sprintf("more than %d buttons", maxButtons)}
+	}
+
+	return nil
+}
+
+// validate returns the reason why the button in the row of rowLen buttons
+// is invalid.
+func (button MessagesKeyboardButton) validate(rowLen int) string {
+	action := button.Action
+
+	if len(action.Payload) > KeyboardMaxPayload {
+		return fmt.Sprintf("payload is longer than %d bytes", KeyboardMaxPayload)
+	}
+
+	switch action.Type {
+	case ButtonText, ButtonCallback, ButtonOpenLink, ButtonVKApp:
+		if action.Label == "" {
+			return "empty label"
+		}
+
+		if utf8.RuneCountInString(action.Label) > KeyboardMaxLabel {
+			return fmt.Sprintf("label is longer than %d characters", KeyboardMaxLabel)
+		}
+	}
+
+	switch action.Type {
+	case ButtonLocation, ButtonVKPay, ButtonVKApp:
+		if rowLen > 1 {
+			return action.Type + " button must be alone in the row"
+		}
+	}
+
+	if button.Color != "" && action.Type != ButtonText && action.Type != ButtonCallback {
+		return "color is supported only by text and callback buttons"
+	}
+
+	return ""
+}
+
 // MessagesKeyboardButton struct.
 type MessagesKeyboardButton struct {
 	Action MessagesKeyboardButtonAction `json:"action"`
@@ -224,6 +389,32 @@ type MessagesKeyboardButtonAction struct {
 	Link    string `json:"link,omitempty"`     // Link URL
 }
 
+// Action types of MessagesEventData.
+const (
+	EventDataShowSnackbar = "show_snackbar"
+	EventDataOpenLink     = "open_link"
+	EventDataOpenApp      = "open_app"
+)
+
+// MessagesEventData struct is an action performed after pressing
+// a callback button.
+//
+// https://vk.com/dev/bots_docs_5
+type MessagesEventData struct {
+	Type    string `json:"type"`
+	Text    string `json:"text,omitempty"`     // Text of the snackbar
+	Link    string `json:"link,omitempty"`     // Link URL
+	AppID   int    `json:"app_id,omitempty"`   // VK Mini App ID
+	OwnerID int    `json:"owner_id,omitempty"` // VK Mini App owner ID
+	Hash    string `json:"hash,omitempty"`     // Fragment value in app link
+}
+
+// ToJSON returns the JSON encoding of MessagesEventData.
+func (data MessagesEventData) ToJSON() string {
+	b, _ := json.Marshal(data)
+	return string(b)
+}
+
 // MessagesTemplate struct
 // https://vk.com/dev/bot_docs_templates
 type MessagesTemplate struct {
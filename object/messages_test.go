@@ -1,6 +1,7 @@
 package object_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/SevereCloud/vksdk/object"
@@ -161,6 +162,137 @@ func TestMessagesKeyboard_ToJSON(t *testing.T) {
 	)
 }
 
+func TestMessagesKeyboard_ToJSONErr(t *testing.T) {
+	t.Parallel()
+
+	keyboard := object.NewMessagesKeyboardInline()
+	keyboard.AddRow()
+	keyboard.AddTextButton("label", "", "")
+
+	got, err := keyboard.ToJSONErr()
+	assert.NoError(t, err)
+	assert.Equal(t, keyboard.ToJSON(), got)
+
+	keyboard.AddTextButton("label", strings.Repeat("a", object.KeyboardMaxPayload+1), "")
+
+	got, err = keyboard.ToJSONErr()
+	assert.IsType(t, &object.KeyboardError{}, err)
+	assert.Empty(t, got)
+}
+
+func TestMessagesKeyboard_AddCallbackButton(t *testing.T) {
+	t.Parallel()
+
+	keyboard := object.NewMessagesKeyboardInline()
+
+	keyboard.AddRow()
+
+	keyboard.AddCallbackButton("label", map[string]int{"item": 1}, "primary")
+	assert.Equal(t, keyboard.Buttons[0][0].Action.Type, object.ButtonCallback)
+	assert.Equal(t, keyboard.Buttons[0][0].Action.Label, "label")
+	assert.Equal(t, keyboard.Buttons[0][0].Action.Payload, `{"item":1}`)
+	assert.Equal(t, keyboard.Buttons[0][0].Color, "primary")
+	assert.NoError(t, keyboard.Validate())
+}
+
+func TestMessagesKeyboard_payload(t *testing.T) {
+	t.Parallel()
+
+	keyboard := object.NewMessagesKeyboard(false)
+
+	keyboard.AddRow()
+	keyboard.AddTextButton("raw", `{"command":"start"}`, "")
+	keyboard.AddTextButton("struct", struct {
+		Command string `json:"command"`
+	}{"start"}, "")
+	keyboard.AddTextButton("nil", nil, "")
+
+	assert.Equal(t, `{"command":"start"}`, keyboard.Buttons[0][0].Action.Payload)
+	assert.Equal(t, `{"command":"start"}`, keyboard.Buttons[0][1].Action.Payload)
+	assert.Equal(t, "", keyboard.Buttons[0][2].Action.Payload)
+	assert.NoError(t, keyboard.Validate())
+
+	keyboard.AddTextButton("chan", make(chan int), "")
+	assert.Error(t, keyboard.Validate())
+}
+
+func TestMessagesKeyboard_Validate(t *testing.T) {
+	t.Parallel()
+
+	f := func(keyboard object.MessagesKeyboard, wantErr bool) {
+		t.Helper()
+
+		err := keyboard.Validate()
+		if wantErr {
+			assert.IsType(t, &object.KeyboardError{}, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	rows := func(keyboard object.MessagesKeyboard, rows, buttons int) object.MessagesKeyboard {
+		for i := 0; i < rows; i++ {
+			keyboard.AddRow()
+
+			for j := 0; j < buttons; j++ {
+				keyboard.AddTextButton("label", "", "")
+			}
+		}
+
+		return keyboard
+	}
+
+	f(rows(object.NewMessagesKeyboard(false), 10, 4), false)
+	f(rows(object.NewMessagesKeyboard(false), 11, 1), true)
+	f(rows(object.NewMessagesKeyboard(false), 9, 5), true)
+	f(rows(object.NewMessagesKeyboard(false), 1, 6), true)
+	f(rows(object.NewMessagesKeyboardInline(), 6, 1), false)
+	f(rows(object.NewMessagesKeyboardInline(), 7, 1), true)
+	f(rows(object.NewMessagesKeyboardInline(), 3, 4), true)
+
+	keyboard := object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton("", "", "")
+	f(keyboard, true)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton(strings.Repeat("л", 40), "", "")
+	f(keyboard, false)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton(strings.Repeat("л", 41), "", "")
+	f(keyboard, true)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddTextButton("label", strings.Repeat("a", 256), "")
+	f(keyboard, true)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddLocationButton("").AddTextButton("label", "", "")
+	f(keyboard, true)
+
+	keyboard = object.NewMessagesKeyboard(false)
+	keyboard.AddRow().AddLocationButton("")
+	keyboard.Buttons[0][0].Color = "primary"
+	f(keyboard, true)
+
+	err := &object.KeyboardError{Row: 1, Button: 2, Reason: "empty label"}
+	assert.Equal(t, "object: keyboard button [1][2]: empty label", err.Error())
+
+	err = &object.KeyboardError{Row: -1, Button: -1, Reason: "more than 10 rows"}
+	assert.Equal(t, "object: keyboard: more than 10 rows", err.Error())
+}
+
+func TestMessagesEventData_ToJSON(t *testing.T) {
+	t.Parallel()
+
+	data := object.MessagesEventData{
+		Type: object.EventDataShowSnackbar,
+		Text: "text",
+	}
+
+	assert.Equal(t, `{"type":"show_snackbar","text":"text"}`, data.ToJSON())
+}
+
 func TestMessagesTemplate_ToJSON(t *testing.T) {
 	t.Parallel()
 
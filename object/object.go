@@ -21,6 +21,13 @@ type JSONObject interface {
 	ToJSON() string
 }
 
+// Validator is implemented by objects which check VK limits, e.g.
+// MessagesKeyboard. VK methods return the error of Validate before
+// sending the request.
+type Validator interface {
+	Validate() error
+}
+
 // BaseBoolInt type.
 type BaseBoolInt bool
 
@@ -121,6 +128,7 @@ const (
 	EventLeadFormsNew         = "lead_forms_new"
 	EventAppPayload           = "app_payload"
 	EventMessageRead          = "message_read"
+	EventMessageEvent         = "message_event"
 )
 
 // GroupEvent struct.
@@ -135,6 +143,7 @@ type GroupEvent struct {
 // LongpollResponse struct.
 type LongpollResponse struct {
 	Ts      int             `json:"ts"`
+	Pts     int             `json:"pts"`
 	Updates [][]interface{} `json:"updates"`
 	Failed  int             `json:"failed"`
 }
@@ -546,6 +555,7 @@ const (
 	ButtonVKApp    = "open_app"
 	ButtonLocation = "location"
 	ButtonOpenLink = "open_link"
+	ButtonCallback = "callback"
 )
 
 // Platform content creation platform.
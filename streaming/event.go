@@ -1,6 +1,8 @@
 package streaming
 
 import (
+	"fmt"
+
 	"github.com/SevereCloud/vksdk/object"
 )
 
@@ -62,3 +64,88 @@ type Author struct {
 	SharedPostAuthorURL string          `json:"shared_post_author_url,omitempty"`
 	Platform            object.Platform `json:"platform"` // Platform used by author
 }
+
+// PostID identifies a wall post.
+type PostID struct {
+	OwnerID int
+	ID      int
+}
+
+// ToAttachment returns the post in the attachment format, e.g. wall-1_2.
+func (p PostID) ToAttachment() string {
+	return fmt.Sprintf("wall%d_%d", p.OwnerID, p.ID)
+}
+
+// URL returns the link to the post.
+func (p PostID) URL() string {
+	return "https://vk.com/" + p.ToAttachment()
+}
+
+// CommentID identifies a comment of a wall post.
+type CommentID struct {
+	Post PostID
+	ID   int
+}
+
+// URL returns the link to the comment.
+func (c CommentID) URL() string {
+	return fmt.Sprintf("%s?reply=%d", c.Post.URL(), c.ID)
+}
+
+// TopicPostID identifies a comment in a board topic.
+type TopicPostID struct {
+	OwnerID int // community ID
+	TopicID int
+	ID      int
+}
+
+// URL returns the link to the comment in the topic.
+func (t TopicPostID) URL() string {
+	return fmt.Sprintf("https://vk.com/topic%d_%d?post=%d", t.OwnerID, t.TopicID, t.ID)
+}
+
+// Post returns the post of the event: the new post for Post and Share,
+// the commented post for Comment.
+func (e Event) Post() (PostID, bool) {
+	switch e.EventType {
+	case Post, Share, Comment:
+		return PostID{e.EventID.PostOwnerID, e.EventID.PostID}, true
+	}
+
+	return PostID{}, false
+}
+
+// Comment returns the comment of Comment event.
+func (e Event) Comment() (CommentID, bool) {
+	if e.EventType != Comment {
+		return CommentID{}, false
+	}
+
+	return CommentID{
+		Post: PostID{e.EventID.PostOwnerID, e.EventID.PostID},
+		ID:   e.EventID.CommentID,
+	}, true
+}
+
+// SharedPost returns the original post of Share event. The owner of
+// the post is the author of the original post.
+func (e Event) SharedPost() (PostID, bool) {
+	if e.EventType != Share {
+		return PostID{}, false
+	}
+
+	return PostID{e.Author.SharedPostAuthorID, e.EventID.SharedPostID}, true
+}
+
+// TopicPost returns the comment of TopicPost event.
+func (e Event) TopicPost() (TopicPostID, bool) {
+	if e.EventType != TopicPost {
+		return TopicPostID{}, false
+	}
+
+	return TopicPostID{
+		OwnerID: e.EventID.TopicOwnerID,
+		TopicID: e.EventID.TopicID,
+		ID:      e.EventID.TopicPostID,
+	}, true
+}
@@ -0,0 +1,89 @@
+package streaming_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/streaming"
+)
+
+func event(t *testing.T, data string) streaming.Event {
+	t.Helper()
+
+	var e streaming.Event
+
+	err := json.Unmarshal([]byte(data), &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return e
+}
+
+func TestEvent_Post(t *testing.T) {
+	t.Parallel()
+
+	e := event(t, `{"event_type":"post","event_id":{"post_owner_id":-1,"post_id":2}}`)
+
+	post, ok := e.Post()
+	assert.True(t, ok)
+	assert.Equal(t, streaming.PostID{OwnerID: -1, ID: 2}, post)
+	assert.Equal(t, "wall-1_2", post.ToAttachment())
+	assert.Equal(t, "https://vk.com/wall-1_2", post.URL())
+
+	_, ok = e.Comment()
+	assert.False(t, ok)
+
+	_, ok = e.SharedPost()
+	assert.False(t, ok)
+
+	_, ok = e.TopicPost()
+	assert.False(t, ok)
+}
+
+func TestEvent_Comment(t *testing.T) {
+	t.Parallel()
+
+	e := event(t, `{"event_type":"comment","event_id":{"post_owner_id":1,"post_id":2,"comment_id":3}}`)
+
+	comment, ok := e.Comment()
+	assert.True(t, ok)
+	assert.Equal(t, "https://vk.com/wall1_2?reply=3", comment.URL())
+
+	post, ok := e.Post()
+	assert.True(t, ok)
+	assert.Equal(t, comment.Post, post)
+}
+
+func TestEvent_SharedPost(t *testing.T) {
+	t.Parallel()
+
+	e := event(t, `{
+		"event_type":"share",
+		"event_id":{"post_owner_id":1,"post_id":2,"shared_post_id":3},
+		"author":{"id":1,"shared_post_author_id":-4}
+	}`)
+
+	shared, ok := e.SharedPost()
+	assert.True(t, ok)
+	assert.Equal(t, "wall-4_3", shared.ToAttachment())
+
+	post, ok := e.Post()
+	assert.True(t, ok)
+	assert.Equal(t, "wall1_2", post.ToAttachment())
+}
+
+func TestEvent_TopicPost(t *testing.T) {
+	t.Parallel()
+
+	e := event(t, `{"event_type":"topic_post","event_id":{"topic_owner_id":-1,"topic_id":2,"topic_post_id":3}}`)
+
+	topicPost, ok := e.TopicPost()
+	assert.True(t, ok)
+	assert.Equal(t, "https://vk.com/topic-1_2?post=3", topicPost.URL())
+
+	_, ok = e.Post()
+	assert.False(t, ok)
+}
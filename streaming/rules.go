@@ -0,0 +1,266 @@
+package streaming // import "github.com/SevereCloud/vksdk/streaming"
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Limits of rules.
+const (
+	MaxRules       = 300  // maximum number of rules in a stream
+	MaxKeywords    = 100  // maximum number of keywords in a rule
+	MaxValueLength = 4096 // maximum length of a rule value in bytes
+	MaxTagLength   = 256  // maximum length of a rule tag in bytes
+)
+
+// Term is a keyword of a rule.
+type Term struct {
+	Word    string
+	Exact   bool // case-sensitive match without word forms
+	Exclude bool // objects with the keyword do not match
+}
+
+// Keyword returns a keyword which matches all word forms case-insensitively.
+func Keyword(word string) Term {
+	return Term{Word: word}
+}
+
+// Exact returns a keyword which matches exactly.
+func Exact(word string) Term {
+	return Term{Word: word, Exact: true}
+}
+
+// Exclude returns the term which excludes objects with the keyword.
+//
+//	streaming.Exclude(streaming.Keyword("spam"))
+func Exclude(t Term) Term {
+	t.Exclude = true
+	return t
+}
+
+// String returns the term in the rule format.
+func (t Term) String() string {
+	s := t.Word
+	if t.Exact {
+		s = `"` + s + `"`
+	}
+
+	if t.Exclude {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Query is a parsed rule value. An object matches the query if its text
+// contains all keywords and none of excluded keywords.
+//
+//	q := streaming.Query{
+//		streaming.Keyword("кот"),
+//		streaming.Exact("Барсик"),
+//		streaming.Exclude(streaming.Keyword("продам")),
+//	}
+//
+//	err := s.AddRule("cats", q.String())
+type Query []Term
+
+// ParseQuery parses and validates the value of a rule.
+func ParseQuery(value string) (Query, error) {
+	if len(value) > MaxValueLength {
+		return nil, InvalidRule.Newf("streaming: rule is longer than %d bytes", MaxValueLength)
+	}
+
+	var q Query
+
+	for i := 0; i < len(value); {
+		if value[i] == ' ' || value[i] == '\t' || value[i] == '\n' {
+			i++
+			continue
+		}
+
+		var t Term
+
+		if value[i] == '-' {
+			t.Exclude = true
+			i++
+		}
+
+		if i < len(value) && value[i] == '"' {
+			end := strings.IndexByte(value[i+1:], '"')
+			if end < 0 {
+				return nil, UnbalancedQuotes.New("streaming: unbalanced quotes")
+			}
+
+			t.Word = value[i+1 : i+1+end]
+			t.Exact = true
+			i += end + 2
+		} else {
+			end := strings.IndexAny(value[i:], " \t\n")
+			if end < 0 {
+				end = len(value) - i
+			}
+
+			t.Word = value[i : i+end]
+			i += end
+		}
+
+		q = append(q, t)
+	}
+
+	return q, q.Validate()
+}
+
+// Validate checks the query against the limits of rules.
+func (q Query) Validate() error {
+	if len(q) == 0 {
+		return InvalidRule.New("streaming: empty rule")
+	}
+
+	if len(q) > MaxKeywords {
+		return TooManyFilters.Newf("streaming: more than %d keywords in rule", MaxKeywords)
+	}
+
+	positive := 0
+
+	for _, t := range q {
+		if strings.TrimSpace(t.Word) == "" {
+			return InvalidRule.New("streaming: empty keyword")
+		}
+
+		if strings.Contains(t.Word, `"`) {
+			return UnbalancedQuotes.Newf("streaming: quote in keyword %q", t.Word)
+		}
+
+		if !t.Exact && strings.ContainsAny(t.Word, " \t\n") {
+			return InvalidRule.Newf("streaming: space in keyword %q", t.Word)
+		}
+
+		if !t.Exclude {
+			positive++
+		}
+	}
+
+	if positive == 0 {
+		return MinusKeywordsOnly.New("streaming: rule has only minus keywords")
+	}
+
+	if len(q.String()) > MaxValueLength {
+		return InvalidRule.Newf("streaming: rule is longer than %d bytes", MaxValueLength)
+	}
+
+	return nil
+}
+
+// String returns the value of the rule.
+func (q Query) String() string {
+	terms := make([]string, len(q))
+	for i, t := range q {
+		terms[i] = t.String()
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// Match reports whether the text matches the query.
+//
+// Word forms are not expanded: a keyword matches the same word in any case.
+// Use it to test rules offline against Event.Text.
+func (q Query) Match(text string) bool {
+	words := splitWords(text)
+	lower := splitWords(strings.ToLower(text))
+
+	for _, t := range q {
+		var found bool
+
+		if t.Exact {
+			found = containsWords(words, splitWords(t.Word))
+		} else {
+			found = containsWords(lower, splitWords(strings.ToLower(t.Word)))
+		}
+
+		if found == t.Exclude {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitWords splits the text into words of letters and digits.
+func splitWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// containsWords reports whether words contain the sequence.
+func containsWords(words, seq []string) bool {
+	if len(seq) == 0 {
+		return false
+	}
+
+	for i := 0; i+len(seq) <= len(words); i++ {
+		match := true
+
+		for j := range seq {
+			if words[i+j] != seq[j] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks the tag and the value of the rule.
+func (rule Rule) Validate() error {
+	if rule.Tag == "" {
+		return InvalidRule.New("streaming: empty tag")
+	}
+
+	if len(rule.Tag) > MaxTagLength {
+		return InvalidRule.Newf("streaming: tag is longer than %d bytes", MaxTagLength)
+	}
+
+	_, err := ParseQuery(rule.Value)
+
+	return err
+}
+
+// Match reports whether the text matches the value of the rule.
+func (rule Rule) Match(text string) (bool, error) {
+	q, err := ParseQuery(rule.Value)
+	if err != nil {
+		return false, err
+	}
+
+	return q.Match(text), nil
+}
+
+// ValidateRules checks the rules of a stream.
+func ValidateRules(rules []Rule) error {
+	if len(rules) > MaxRules {
+		return TooManyRules.Newf("streaming: more than %d rules", MaxRules)
+	}
+
+	tags := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		if tags[rule.Tag] {
+			return TagAlreadyExist.Newf("streaming: duplicate tag %q", rule.Tag)
+		}
+
+		tags[rule.Tag] = true
+
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package streaming_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/streaming"
+)
+
+func TestQuery_String(t *testing.T) {
+	t.Parallel()
+
+	q := streaming.Query{
+		streaming.Keyword("кот"),
+		streaming.Exact("Барсик"),
+		streaming.Exclude(streaming.Keyword("продам")),
+		streaming.Exclude(streaming.Exact("Куплю")),
+	}
+
+	assert.Equal(t, `кот "Барсик" -продам -"Куплю"`, q.String())
+
+	parsed, err := streaming.ParseQuery(q.String())
+	assert.NoError(t, err)
+	assert.Equal(t, q, parsed)
+}
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	f := func(value string, wantType streaming.ErrorType) {
+		t.Helper()
+
+		_, err := streaming.ParseQuery(value)
+		if wantType == streaming.NoType {
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, wantType, streaming.GetType(err), value)
+		}
+	}
+
+	f("кот", streaming.NoType)
+	f(`  кот   "Барсик"  `, streaming.NoType)
+	f(`"кот Барсик"`, streaming.NoType)
+	f("", streaming.InvalidRule)
+	f("-", streaming.InvalidRule)
+	f(`кот ""`, streaming.InvalidRule)
+	f(`"кот`, streaming.UnbalancedQuotes)
+	f(`ко"т`, streaming.UnbalancedQuotes)
+	f("-кот -пёс", streaming.MinusKeywordsOnly)
+	f(strings.Repeat("a ", 100), streaming.NoType)
+	f(strings.Repeat("a ", 101), streaming.TooManyFilters)
+	f(strings.Repeat("a", 4097), streaming.InvalidRule)
+}
+
+func TestQuery_Match(t *testing.T) {
+	t.Parallel()
+
+	f := func(value, text string, want bool) {
+		t.Helper()
+
+		match, err := streaming.Rule{Tag: "t", Value: value}.Match(text)
+		assert.NoError(t, err)
+		assert.Equal(t, want, match, "%s: %s", value, text)
+	}
+
+	f("кот", "Мой КОТ спит", true)
+	f("кот", "который час", false)
+	f("кот барсик", "Кот по имени Барсик", true)
+	f("кот барсик", "Кот по имени Мурзик", false)
+	f(`"Барсик"`, "кот Барсик", true)
+	f(`"Барсик"`, "кот барсик", false)
+	f(`"кот Барсик"`, "мой кот Барсик спит", true)
+	f(`"кот Барсик"`, "Барсик мой кот", false)
+	f("кот -продам", "Продам кота", false)
+	f("кот -продам", "Продам кот", false)
+	f("кот -продам", "Мой кот", true)
+	f(`кот -"Продам"`, "продам кот", true)
+
+	_, err := streaming.Rule{Tag: "t", Value: `"`}.Match("")
+	assert.Error(t, err)
+}
+
+func TestValidateRules(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, streaming.ValidateRules([]streaming.Rule{
+		{Tag: "a", Value: "кот"},
+		{Tag: "b", Value: "пёс"},
+	}))
+
+	err := streaming.ValidateRules([]streaming.Rule{
+		{Tag: "a", Value: "кот"},
+		{Tag: "a", Value: "пёс"},
+	})
+	assert.Equal(t, streaming.TagAlreadyExist, streaming.GetType(err))
+
+	err = streaming.ValidateRules([]streaming.Rule{{Tag: "", Value: "кот"}})
+	assert.Equal(t, streaming.InvalidRule, streaming.GetType(err))
+
+	err = streaming.ValidateRules([]streaming.Rule{{Tag: strings.Repeat("a", 257), Value: "кот"}})
+	assert.Equal(t, streaming.InvalidRule, streaming.GetType(err))
+
+	rules := make([]streaming.Rule, 301)
+	err = streaming.ValidateRules(rules)
+	assert.Equal(t, streaming.TooManyRules, streaming.GetType(err))
+}
+
+func TestStreaming_AddRule_invalid(t *testing.T) {
+	t.Parallel()
+
+	s := &streaming.Streaming{}
+
+	err := s.AddRule("t", "-кот")
+	assert.Equal(t, streaming.MinusKeywordsOnly, streaming.GetType(err))
+
+	err = s.SyncRules([]streaming.Rule{{Tag: "t", Value: `"кот`}})
+	assert.Equal(t, streaming.UnbalancedQuotes, streaming.GetType(err))
+
+	err = s.UpdateRules([]streaming.Rule{{Tag: "t", Value: ""}})
+	assert.Equal(t, streaming.InvalidRule, streaming.GetType(err))
+}
@@ -0,0 +1,289 @@
+package streaming // import "github.com/SevereCloud/vksdk/streaming"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SevereCloud/vksdk/api"
+	"github.com/SevereCloud/vksdk/internal"
+)
+
+// ErrNoVK is returned by methods which call VK API if Streaming.VK is nil.
+var ErrNoVK = fmt.Errorf("streaming: VK is nil")
+
+// StatsType is a type of statistics.
+type StatsType string
+
+// Possible values.
+const (
+	StatsPrepared StatsType = "prepared" // events matching the rules
+	StatsReceived StatsType = "received" // events delivered to the stream
+)
+
+// StatsInterval is an interval of statistics values.
+type StatsInterval string
+
+// Possible values.
+const (
+	Interval5m  StatsInterval = "5m"
+	Interval1h  StatsInterval = "1h"
+	Interval24h StatsInterval = "24h"
+)
+
+// MonthlyTier is a monthly limit of events.
+type MonthlyTier string
+
+// Possible values.
+const (
+	Tier1     MonthlyTier = "tier_1"
+	Tier2     MonthlyTier = "tier_2"
+	Tier3     MonthlyTier = "tier_3"
+	Tier4     MonthlyTier = "tier_4"
+	Tier5     MonthlyTier = "tier_5"
+	Tier6     MonthlyTier = "tier_6"
+	Unlimited MonthlyTier = "unlimited"
+)
+
+// StatsValue is a value of statistics for an interval.
+type StatsValue struct {
+	Time  time.Time // start of the interval
+	Value int
+}
+
+// Stats is statistics for a type of events.
+type Stats struct {
+	EventType EventType
+	Values    []StatsValue
+}
+
+// Total returns the sum of values.
+func (s Stats) Total() int {
+	total := 0
+	for _, v := range s.Values {
+		total += v.Value
+	}
+
+	return total
+}
+
+// GetStats returns statistics of events from start to end. Zero start
+// and end are not sent.
+//
+// https://vk.com/dev/streaming.getStats
+func (s *Streaming) GetStats(
+	ctx context.Context,
+	statsType StatsType,
+	interval StatsInterval,
+	start, end time.Time,
+) ([]Stats, error) {
+	if s.VK == nil {
+		return nil, ErrNoVK
+	}
+
+	params := api.Params{
+		"type":     string(statsType),
+		"interval": string(interval),
+	}
+
+	if !start.IsZero() {
+		params["start_time"] = start.Unix()
+	}
+
+	if !end.IsZero() {
+		params["end_time"] = end.Unix()
+	}
+
+	resp, err := s.VK.StreamingGetStatsContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]Stats, len(resp))
+
+	for i, item := range resp {
+		stats[i].EventType = EventType(item.EventType)
+		stats[i].Values = make([]StatsValue, len(item.Stats))
+
+		for j, v := range item.Stats {
+			stats[i].Values[j] = StatsValue{
+				Time:  time.Unix(int64(v.Timestamp), 0),
+				Value: v.Value,
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// GetStem returns the stem of the word. Keywords of rules match all words
+// with the same stem.
+//
+// https://vk.com/dev/streaming.getStem
+func (s *Streaming) GetStem(ctx context.Context, word string) (string, error) {
+	if s.VK == nil {
+		return "", ErrNoVK
+	}
+
+	resp, err := s.VK.StreamingGetStemContext(ctx, api.Params{
+		"word": word,
+	})
+
+	return resp.Stem, err
+}
+
+// GetMonthlyTier returns the monthly limit of events.
+//
+// https://vk.com/dev/streaming.getSettings
+func (s *Streaming) GetMonthlyTier(ctx context.Context) (MonthlyTier, error) {
+	if s.VK == nil {
+		return "", ErrNoVK
+	}
+
+	resp, err := s.VK.StreamingGetSettingsContext(ctx, api.Params{})
+
+	return MonthlyTier(resp.MonthlyLimit), err
+}
+
+// SetMonthlyTier sets the monthly limit of events.
+//
+// https://vk.com/dev/streaming.setSettings
+func (s *Streaming) SetMonthlyTier(ctx context.Context, tier MonthlyTier) error {
+	if s.VK == nil {
+		return ErrNoVK
+	}
+
+	_, err := s.VK.StreamingSetSettingsContext(ctx, api.Params{
+		"monthly_tier": string(tier),
+	})
+
+	return err
+}
+
+// StatsReport compares prepared and received events of a type.
+type StatsReport struct {
+	EventType EventType
+	Prepared  int // events matching the rules
+	Received  int // events delivered to the stream
+}
+
+// Ratio returns the share of received events or 0 if there are no
+// prepared events.
+func (r StatsReport) Ratio() float64 {
+	if r.Prepared == 0 {
+		return 0
+	}
+
+	return float64(r.Received) / float64(r.Prepared)
+}
+
+// StatsPoller periodically reports statistics of the stream.
+//
+//	p := streaming.NewStatsPoller(s, func(reports []streaming.StatsReport) {
+//		for _, r := range reports {
+//			log.Printf("%s: %d/%d", r.EventType, r.Received, r.Prepared)
+//		}
+//	})
+//
+//	go p.Run(ctx)
+type StatsPoller struct {
+	Streaming *Streaming
+
+	// Period between reports. Each report covers the last period.
+	// If zero, DefaultStatsPeriod is used.
+	Period time.Duration
+
+	// Interval of values requested from VK.
+	Interval StatsInterval
+
+	Handler      func(reports []StatsReport)
+	ErrorHandler func(err error)
+}
+
+// DefaultStatsPeriod is the period of StatsPoller.
+const DefaultStatsPeriod = time.Hour
+
+// NewStatsPoller returns a new StatsPoller with DefaultStatsPeriod.
+func NewStatsPoller(s *Streaming, handler func(reports []StatsReport)) *StatsPoller {
+	return &StatsPoller{
+		Streaming: s,
+		Period:    DefaultStatsPeriod,
+		Interval:  Interval5m,
+		Handler:   handler,
+	}
+}
+
+// period returns Period or DefaultStatsPeriod if Period is not positive.
+func (p *StatsPoller) period() time.Duration {
+	if p.Period <= 0 {
+		return DefaultStatsPeriod
+	}
+
+	return p.Period
+}
+
+// Poll returns reports for the last period.
+func (p *StatsPoller) Poll(ctx context.Context) ([]StatsReport, error) {
+	end := time.Now()
+	start := end.Add(-p.period())
+
+	prepared, err := p.Streaming.GetStats(ctx, StatsPrepared, p.Interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := p.Streaming.GetStats(ctx, StatsReceived, p.Interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []StatsReport
+
+	index := make(map[EventType]int)
+
+	report := func(eventType EventType) *StatsReport {
+		i, ok := index[eventType]
+		if !ok {
+			i = len(reports)
+			index[eventType] = i
+			reports = append(reports, StatsReport{EventType: eventType})
+		}
+
+		return &reports[i]
+	}
+
+	for _, stats := range prepared {
+		report(stats.EventType).Prepared += stats.Total()
+	}
+
+	for _, stats := range received {
+		report(stats.EventType).Received += stats.Total()
+	}
+
+	return reports, nil
+}
+
+// Run calls Handler every period until ctx is done and returns ctx.Err().
+func (p *StatsPoller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.period())
+	defer ticker.Stop()
+
+	for {
+		reports, err := p.Poll(ctx)
+
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil:
+			internal.HandleError(p.ErrorHandler, err)
+		case p.Handler != nil:
+			p.Handler(reports)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package streaming_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SevereCloud/vksdk/streaming"
+)
+
+// statsHandler is a fake of streaming methods of VK API.
+func statsHandler(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/method/streaming.getStats":
+		value := 10
+		if r.Form.Get("type") == "received" {
+			value = 1
+		}
+
+		fmt.Fprintf(w, `{"response":[
+			{"event_type":"post","stats":[{"timestamp":100,"value":%d},{"timestamp":400,"value":%d}]},
+			{"event_type":"comment","stats":[{"timestamp":100,"value":%d}]}
+		]}`, value, value, value)
+	case "/method/streaming.getStem":
+		fmt.Fprintf(w, `{"response":{"stem":"%s"}}`, r.Form.Get("word")[:2])
+	case "/method/streaming.getSettings":
+		fmt.Fprint(w, `{"response":{"monthly_limit":"tier_2"}}`)
+	case "/method/streaming.setSettings":
+		fmt.Fprint(w, `{"response":1}`)
+	}
+}
+
+func TestStreaming_GetStats(t *testing.T) {
+	t.Parallel()
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(statsHandler))
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	stats, err := s.GetStats(ctx, streaming.StatsPrepared, streaming.Interval5m, time.Time{}, time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, streaming.Post, stats[0].EventType)
+	assert.Equal(t, time.Unix(400, 0), stats[0].Values[1].Time)
+	assert.Equal(t, 20, stats[0].Total())
+
+	stem, err := s.GetStem(ctx, "коты")
+	assert.NoError(t, err)
+	assert.Equal(t, "к", stem)
+
+	tier, err := s.GetMonthlyTier(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, streaming.Tier2, tier)
+
+	assert.NoError(t, s.SetMonthlyTier(ctx, streaming.Tier1))
+}
+
+func TestStreaming_noVK(t *testing.T) {
+	t.Parallel()
+
+	s := &streaming.Streaming{}
+	ctx := context.Background()
+
+	_, err := s.GetStats(ctx, streaming.StatsPrepared, streaming.Interval5m, time.Time{}, time.Time{})
+	assert.Equal(t, streaming.ErrNoVK, err)
+
+	_, err = s.GetStem(ctx, "кот")
+	assert.Equal(t, streaming.ErrNoVK, err)
+
+	_, err = s.GetMonthlyTier(ctx)
+	assert.Equal(t, streaming.ErrNoVK, err)
+
+	assert.Equal(t, streaming.ErrNoVK, s.SetMonthlyTier(ctx, streaming.Tier1))
+}
+
+func TestStatsPoller(t *testing.T) {
+	t.Parallel()
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(statsHandler))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var reports [][]streaming.StatsReport
+
+	p := streaming.NewStatsPoller(s, func(r []streaming.StatsReport) {
+		reports = append(reports, r)
+		if len(reports) == 2 {
+			cancel()
+		}
+	})
+	p.Period = time.Millisecond
+
+	assert.Equal(t, context.Canceled, p.Run(ctx))
+	assert.Len(t, reports, 2)
+	assert.Equal(t, []streaming.StatsReport{
+		{EventType: streaming.Post, Prepared: 20, Received: 2},
+		{EventType: streaming.Comment, Prepared: 10, Received: 1},
+	}, reports[0])
+	assert.Equal(t, 0.1, reports[0][0].Ratio())
+	assert.Equal(t, 0.0, streaming.StatsReport{}.Ratio())
+}
+
+func TestStatsPoller_error(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errs []error
+
+	p := streaming.NewStatsPoller(&streaming.Streaming{}, nil)
+	p.Period = time.Millisecond
+	p.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+		cancel()
+	}
+
+	assert.Equal(t, context.Canceled, p.Run(ctx))
+	assert.Equal(t, []error{streaming.ErrNoVK}, errs)
+}
+
+func TestStatsPoller_zeroPeriod(t *testing.T) {
+	t.Parallel()
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(statsHandler))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handled := 0
+
+	// a literal without Period uses DefaultStatsPeriod
+	p := &streaming.StatsPoller{
+		Streaming: s,
+		Handler: func(r []streaming.StatsReport) {
+			handled++
+			cancel()
+		},
+	}
+
+	assert.Equal(t, context.Canceled, p.Run(ctx))
+	assert.Equal(t, 1, handled)
+}
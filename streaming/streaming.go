@@ -50,6 +50,16 @@ Limitations
 
 - the maximum length of a rule (value) in bytes — 4096;
 
+Rules are checked locally before requests. Query builds and parses rules
+and matches them against a text offline:
+
+	q := streaming.Query{
+		streaming.Keyword("кот"),
+		streaming.Exclude(streaming.Exact("Продам")),
+	}
+
+	q.Match(event.Text)
+
 Methods List
 
 You need the following methods to work with Streaming API:
@@ -58,6 +68,7 @@ You need the following methods to work with Streaming API:
 	s.AddRule(tag, value) // adds a new rule to a stream
 	s.DeleteRule(tag) // removes a rule from a stream
 	s.UpdateRules(rules) // removes all rules and adds a new rule to a stream
+	s.SyncRules(rules) // adds and removes only changed rules
 
 Getting Stream
 
@@ -77,17 +88,63 @@ For stop streaming:
 
 	s.Shutdown()
 
+RunResilient reconnects with increasing delay after errors and returns
+errors of the key or the stream, e.g. BadKey:
+
+	s.ErrorHandler = func(err error) {
+		log.Print(err)
+	}
+	s.PingInterval = 30 * time.Second
+
+	err := s.RunResilient()
+
+Statistics and settings
+
+These methods use the VK field which is set by NewStreaming:
+
+	stats, err := s.GetStats(ctx, streaming.StatsReceived, streaming.Interval1h, start, end)
+	stem, err := s.GetStem(ctx, "коты")
+	err := s.SetMonthlyTier(ctx, streaming.Tier1)
+
+StatsPoller reports how many of prepared events are received:
+
+	p := streaming.NewStatsPoller(s, func(reports []streaming.StatsReport) {
+		...
+	})
+	go p.Run(ctx)
+
+Handlers for a tag of a rule or a type of events:
+
+	s.OnTag("cats", func(e streaming.Event) {
+		post, _ := e.Post()
+		...
+	})
+
+	s.OnEventType(streaming.Comment, func(e streaming.Event) {
+		comment, _ := e.Comment()
+		...
+	})
+
+Service messages (code 300), e.g. the notice of the stream restart:
+
+	s.OnServiceMessage(func(m streaming.ServiceMessage) {
+		...
+	})
+
 */
 package streaming
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 
@@ -99,20 +156,20 @@ type response struct {
 	Code           int            `json:"code"`                      // reply code.
 	Event          Event          `json:"event,omitempty"`           // (for code = 100) event
 	Rules          []Rule         `json:"rules,omitempty"`           // (for code = 200) info about rules in the stream.
-	ServiceMessage serviceMessage `json:"service_message,omitempty"` // (for code = 300) service message
+	ServiceMessage ServiceMessage `json:"service_message,omitempty"` // (for code = 300) service message
 	Error          Error          `json:"error,omitempty"`           // (for code = 400) error description.
 }
 
 const (
 	codeEvent = 100
 	// codeOk      = 200 // is unused (deadcode)
-	// codeService = 300 // is unused (deadcode)
-	codeError = 400
+	codeService = 300
+	codeError   = 400
 )
 
-// serviceMessage describes a service message. It is returned for replies with
+// ServiceMessage describes a service message. It is returned for replies with
 // code=300 field.
-type serviceMessage struct {
+type ServiceMessage struct {
 	Message     string `json:"message"`      // Service message text
 	ServiceCode int    `json:"service_code"` // Service message code
 }
@@ -132,12 +189,40 @@ type Streaming struct {
 	Key      string // access key for streaming api
 	StreamID int
 
+	// VK is used for statistics and settings, see GetStats.
+	VK *api.VK
+
 	Client    *http.Client      // A Client is an HTTP client
 	Dialer    *websocket.Dialer // A Dialer contains options for connecting to WebSocket server
 	UserAgent string            // UserAgent sent in the request.
 
-	inShutdown int32
-	eventFunc  []func(Event)
+	// ErrorHandler is called by RunResilient on errors before reconnect.
+	ErrorHandler func(err error)
+
+	// ReconnectDelay is the pause before RunResilient opens a new
+	// WebSocket connection after the previous one is dropped. Pauses grow
+	// twice while connections fail, up to MaxReconnectDelay, and start
+	// over once a connection is established.
+	ReconnectDelay    time.Duration
+	MaxReconnectDelay time.Duration
+
+	// PingInterval enables keepalive: a ping is sent every PingInterval
+	// and the connection is closed if nothing is received during two
+	// intervals. If zero, pings are not sent.
+	PingInterval time.Duration
+
+	inShutdown  int32
+	shutdown    internal.Shutdown
+	eventFunc   []func(Event)
+	tagFunc     map[string][]func(Event)
+	typeFunc    map[EventType][]func(Event)
+	serviceFunc []func(ServiceMessage)
+
+	tagCount map[string]int64
+	countMux sync.Mutex
+
+	conn *websocket.Conn
+	mux  sync.Mutex
 }
 
 func (s *Streaming) doRequest(req *http.Request) (*response, error) {
@@ -183,7 +268,13 @@ func (s *Streaming) GetRules() ([]Rule, error) {
 // AddRule adds a new rule to a stream.
 //
 // - the maximum length of a rule's tag (tag) in bytes — 256.
+//
+// The rule is validated before the request, see Rule.Validate.
 func (s *Streaming) AddRule(tag, value string) error {
+	if err := (Rule{Tag: tag, Value: value}).Validate(); err != nil {
+		return err
+	}
+
 	url := fmt.Sprintf("https://%s/rules/?key=%s", s.Endpoint, s.Key)
 
 	type content struct {
@@ -241,6 +332,10 @@ func (s *Streaming) DeleteRule(tag string) error {
 
 // UpdateRules removes all rules and adds a new rule to a stream.
 func (s *Streaming) UpdateRules(rules []Rule) error {
+	if err := ValidateRules(rules); err != nil {
+		return err
+	}
+
 	oldRules, err := s.GetRules()
 	if err != nil {
 		return err
@@ -263,11 +358,59 @@ func (s *Streaming) UpdateRules(rules []Rule) error {
 	return nil
 }
 
+// SyncRules makes the rules of the stream equal to rules. Unlike
+// UpdateRules, only missing rules are added and only removed or changed
+// rules are deleted. Rules are validated before requests.
+func (s *Streaming) SyncRules(rules []Rule) error {
+	if err := ValidateRules(rules); err != nil {
+		return err
+	}
+
+	oldRules, err := s.GetRules()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]string, len(oldRules))
+	for _, rule := range oldRules {
+		current[rule.Tag] = rule.Value
+	}
+
+	wanted := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		wanted[rule.Tag] = rule.Value
+	}
+
+	for _, rule := range oldRules {
+		if value, ok := wanted[rule.Tag]; ok && value == rule.Value {
+			continue
+		}
+
+		if err := s.DeleteRule(rule.Tag); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range rules {
+		if value, ok := current[rule.Tag]; ok && value == rule.Value {
+			continue
+		}
+
+		if err := s.AddRule(rule.Tag, rule.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Streaming) handlerWebsocket(r response) error {
 	switch r.Code {
 	case codeEvent:
-		for _, f := range s.eventFunc {
-			f(r.Event)
+		s.handleEvent(r.Event)
+	case codeService:
+		for _, f := range s.serviceFunc {
+			f(r.ServiceMessage)
 		}
 	case codeError:
 		return NewError(r.Error)
@@ -281,10 +424,123 @@ func (s *Streaming) OnEvent(f func(Event)) {
 	s.eventFunc = append(s.eventFunc, f)
 }
 
+// OnTag handler of events which match the rule with the tag.
+func (s *Streaming) OnTag(tag string, f func(Event)) {
+	if s.tagFunc == nil {
+		s.tagFunc = make(map[string][]func(Event))
+	}
+
+	s.tagFunc[tag] = append(s.tagFunc[tag], f)
+}
+
+// OnEventType handler of events with the type.
+func (s *Streaming) OnEventType(eventType EventType, f func(Event)) {
+	if s.typeFunc == nil {
+		s.typeFunc = make(map[EventType][]func(Event))
+	}
+
+	s.typeFunc[eventType] = append(s.typeFunc[eventType], f)
+}
+
+// handleEvent counts the tags of the event and calls handlers: OnEvent,
+// then OnEventType, then OnTag for each tag.
+func (s *Streaming) handleEvent(e Event) {
+	tags := make([]string, 0, len(e.Tags))
+	seen := make(map[string]bool, len(e.Tags))
+
+	for _, tag := range e.Tags {
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	s.countMux.Lock()
+
+	if s.tagCount == nil {
+		s.tagCount = make(map[string]int64)
+	}
+
+	for _, tag := range tags {
+		s.tagCount[tag]++
+	}
+
+	s.countMux.Unlock()
+
+	for _, f := range s.eventFunc {
+		f(e)
+	}
+
+	for _, f := range s.typeFunc[e.EventType] {
+		f(e)
+	}
+
+	for _, tag := range tags {
+		for _, f := range s.tagFunc[tag] {
+			f(e)
+		}
+	}
+}
+
+// TagCounts returns the number of received events for each tag.
+func (s *Streaming) TagCounts() map[string]int64 {
+	s.countMux.Lock()
+	defer s.countMux.Unlock()
+
+	counts := make(map[string]int64, len(s.tagCount))
+	for tag, n := range s.tagCount {
+		counts[tag] = n
+	}
+
+	return counts
+}
+
+// OnServiceMessage service message handler.
+func (s *Streaming) OnServiceMessage(f func(ServiceMessage)) {
+	s.serviceFunc = append(s.serviceFunc, f)
+}
+
 // Run starting stream.
 func (s *Streaming) Run() error {
 	atomic.StoreInt32(&s.inShutdown, 0)
 
+	return s.run(nil)
+}
+
+// RunResilient starts the stream and reconnects after errors until
+// Shutdown. Errors are passed to ErrorHandler.
+//
+// Errors which cannot be fixed by a reconnect, e.g. BadKey or HTTP 400
+// and 401 on connect, are returned.
+func (s *Streaming) RunResilient() error {
+	atomic.StoreInt32(&s.inShutdown, 0)
+	s.shutdown.Reset()
+
+	backoff := internal.Backoff{Min: s.ReconnectDelay, Max: s.MaxReconnectDelay}
+
+	for atomic.LoadInt32(&s.inShutdown) == 0 {
+		err := s.run(backoff.Reset)
+		if atomic.LoadInt32(&s.inShutdown) != 0 {
+			break
+		}
+
+		if isPermanent(err) {
+			return err
+		}
+
+		if err != nil {
+			internal.HandleError(s.ErrorHandler, err)
+		}
+
+		internal.Sleep(context.Background(), backoff.Next(), s.shutdown.Done())
+	}
+
+	return nil
+}
+
+// run connects to the stream and reads messages. onConnect is called
+// after the connection is established.
+func (s *Streaming) run(onConnect func()) error {
 	u := url.URL{
 		Scheme: "wss",
 		Host:   s.Endpoint,
@@ -303,9 +559,8 @@ func (s *Streaming) Run() error {
 		if err == websocket.ErrBadHandshake {
 			var r response
 
-			err = json.NewDecoder(wsResp.Body).Decode(&r)
-			if err != nil {
-				return err
+			if json.NewDecoder(wsResp.Body).Decode(&r) != nil || r.Code != codeError {
+				return &handshakeError{StatusCode: wsResp.StatusCode}
 			}
 
 			return s.handlerWebsocket(r)
@@ -316,16 +571,38 @@ func (s *Streaming) Run() error {
 	defer wsResp.Body.Close()
 	defer c.Close()
 
+	s.setConn(c)
+	defer s.setConn(nil)
+
+	if onConnect != nil {
+		onConnect()
+	}
+
 	c.SetPingHandler(nil)
 
+	extend := func() {}
+
+	if s.PingInterval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+
+		extend = s.keepalive(c, done)
+	}
+
 	for atomic.LoadInt32(&s.inShutdown) == 0 {
 		var r response
 
 		_, message, err := c.ReadMessage()
 		if err != nil {
+			if atomic.LoadInt32(&s.inShutdown) != 0 {
+				return nil
+			}
+
 			return err
 		}
 
+		extend()
+
 		err = json.Unmarshal(message, &r)
 		if err != nil {
 			return err
@@ -341,13 +618,105 @@ func (s *Streaming) Run() error {
 		websocket.CloseMessage,
 		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
 	)
+	if err == websocket.ErrCloseSent {
+		return nil
+	}
 
 	return err
 }
 
+// keepalive sends pings until done is closed. The read deadline is
+// extended on pings and pongs; the returned function extends it and is
+// called on received messages.
+func (s *Streaming) keepalive(c *websocket.Conn, done chan struct{}) func() {
+	wait := 2 * s.PingInterval
+
+	extend := func() {
+		_ = c.SetReadDeadline(time.Now().Add(wait))
+	}
+
+	extend()
+
+	pingHandler := c.PingHandler()
+
+	c.SetPingHandler(func(data string) error {
+		extend()
+		return pingHandler(data)
+	})
+
+	c.SetPongHandler(func(string) error {
+		extend()
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(s.PingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := c.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.PingInterval))
+				if err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return extend
+}
+
+// handshakeError is an error of the connection without the description
+// of Streaming API.
+type handshakeError struct {
+	StatusCode int
+}
+
+func (e *handshakeError) Error() string {
+	return "streaming: bad handshake: " + strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode)
+}
+
+// isPermanent reports whether the error cannot be fixed by a reconnect.
+func isPermanent(err error) bool {
+	if err, ok := err.(*handshakeError); ok {
+		return err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnauthorized
+	}
+
+	switch GetType(err) {
+	case UpgradeWebsocket, UnsupportedHTTP, ContentType, MissingKey, BadKey, BadStreamID:
+		return true
+	}
+
+	return false
+}
+
+func (s *Streaming) setConn(c *websocket.Conn) {
+	s.mux.Lock()
+	s.conn = c
+	s.mux.Unlock()
+}
+
 // Shutdown gracefully shuts down the stream.
+//
+// The close message is sent to the active connection, so Run returns
+// without waiting for the next event.
 func (s *Streaming) Shutdown() {
 	atomic.StoreInt32(&s.inShutdown, 1)
+	s.shutdown.Close()
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.conn != nil {
+		_ = s.conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(time.Second),
+		)
+	}
 }
 
 // NewStreaming returns a new Streaming.
@@ -364,6 +733,7 @@ func NewStreaming(vk *api.VK) (*Streaming, error) {
 	}
 
 	s := &Streaming{
+		VK:        vk,
 		Endpoint:  resp.Endpoint,
 		Key:       resp.Key,
 		StreamID:  0,
@@ -1,8 +1,14 @@
 package streaming_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -207,3 +213,324 @@ func TestInit(t *testing.T) {
 	_, err := streaming.Init(api.NewVK(""))
 	assert.Error(t, err)
 }
+
+// newTestStreaming returns Streaming connected to a test server. VK API
+// methods are requested from the same server.
+func newTestStreaming(t *testing.T, handler http.Handler) (*streaming.Streaming, *httptest.Server) {
+	t.Helper()
+
+	ts := httptest.NewTLSServer(handler)
+
+	vk := api.NewVK("token")
+	vk.MethodURL = ts.URL + "/method/"
+	vk.Client = ts.Client()
+
+	s := &streaming.Streaming{
+		Endpoint: strings.TrimPrefix(ts.URL, "https://"),
+		Key:      "key",
+		VK:       vk,
+		Client:   ts.Client(),
+		Dialer: &websocket.Dialer{
+			TLSClientConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig,
+		},
+	}
+
+	return s, ts
+}
+
+func TestStreaming_RunResilient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		connections int32
+		upgrader    websocket.Upgrader
+	)
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&connections, 1)
+		if n == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		if n == 2 {
+			_ = c.WriteMessage(websocket.TextMessage, []byte(
+				`{"code":300,"service_message":{"message":"restart","service_code":3000}}`,
+			))
+
+			return
+		}
+
+		_ = c.WriteMessage(websocket.TextMessage, []byte(
+			`{"code":100,"event":{"event_type":"post","tags":["t"]}}`,
+		))
+
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	s.ReconnectDelay = time.Millisecond
+	s.PingInterval = time.Second
+
+	var (
+		errs    int32
+		service []streaming.ServiceMessage
+	)
+
+	s.ErrorHandler = func(err error) {
+		atomic.AddInt32(&errs, 1)
+	}
+
+	s.OnServiceMessage(func(m streaming.ServiceMessage) {
+		service = append(service, m)
+	})
+
+	s.OnEvent(func(e streaming.Event) {
+		go s.Shutdown()
+	})
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- s.RunResilient()
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&connections))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&errs))
+	assert.Equal(t, []streaming.ServiceMessage{{Message: "restart", ServiceCode: 3000}}, service)
+}
+
+func TestStreaming_Run_keepalive(t *testing.T) {
+	t.Parallel()
+
+	var upgrader websocket.Upgrader
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		// Pings are not answered, the client reads nothing
+		time.Sleep(time.Second)
+	}))
+	defer ts.Close()
+
+	s.PingInterval = 50 * time.Millisecond
+
+	err := s.Run()
+	assert.Error(t, err)
+}
+
+func TestStreaming_Run_keepaliveMessages(t *testing.T) {
+	t.Parallel()
+
+	var upgrader websocket.Upgrader
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		// Pings are not answered, but events extend the read deadline
+		for i := 0; i < 10; i++ {
+			time.Sleep(20 * time.Millisecond)
+
+			err = c.WriteMessage(websocket.TextMessage, []byte(`{"code":100,"event":{"event_type":"post"}}`))
+			if err != nil {
+				return
+			}
+		}
+
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	s.PingInterval = 50 * time.Millisecond
+
+	received := 0
+
+	s.OnEvent(func(e streaming.Event) {
+		received++
+		if received == 10 {
+			go s.Shutdown()
+		}
+	})
+
+	assert.NoError(t, s.Run())
+	assert.Equal(t, 10, received)
+}
+
+func TestStreaming_RunResilient_permanent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		errType streaming.ErrorType
+	}{
+		{"BadKey", http.StatusBadRequest, `{"code":400,"error":{"message":"bad key","error_code":1004}}`, streaming.BadKey},
+		{"Unauthorized", http.StatusUnauthorized, ``, streaming.NoType},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var connections int32
+
+			s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&connections, 1)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			s.ReconnectDelay = time.Millisecond
+			s.ErrorHandler = func(err error) {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			err := s.RunResilient()
+			assert.Error(t, err)
+			assert.Equal(t, tt.errType, streaming.GetType(err))
+			assert.Equal(t, int32(1), atomic.LoadInt32(&connections))
+		})
+	}
+}
+
+func TestStreaming_SyncRules(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mux   sync.Mutex
+		calls []string
+	)
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Tag  string         `json:"tag"`
+			Rule streaming.Rule `json:"rule"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		mux.Lock()
+		defer mux.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"code":200,"rules":[
+				{"tag":"keep","value":"a"},
+				{"tag":"change","value":"b"},
+				{"tag":"remove","value":"c"}
+			]}`)
+
+			return
+		case http.MethodPost:
+			calls = append(calls, "add "+body.Rule.Tag+"="+body.Rule.Value)
+		case http.MethodDelete:
+			calls = append(calls, "delete "+body.Tag)
+		}
+
+		fmt.Fprint(w, `{"code":200}`)
+	}))
+	defer ts.Close()
+
+	err := s.SyncRules([]streaming.Rule{
+		{Tag: "keep", Value: "a"},
+		{Tag: "change", Value: "bb"},
+		{Tag: "new", Value: "d"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"delete change",
+		"delete remove",
+		"add change=bb",
+		"add new=d",
+	}, calls)
+}
+
+func TestStreaming_OnTag(t *testing.T) {
+	t.Parallel()
+
+	var upgrader websocket.Upgrader
+
+	s, ts := newTestStreaming(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		for _, msg := range []string{
+			`{"code":100,"event":{"event_type":"post","tags":["cats","dogs"]}}`,
+			`{"code":100,"event":{"event_type":"comment","tags":["cats","cats"]}}`,
+			`{"code":100,"event":{"event_type":"share","tags":["end"]}}`,
+		} {
+			_ = c.WriteMessage(websocket.TextMessage, []byte(msg))
+		}
+
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer ts.Close()
+
+	var calls []string
+
+	s.OnEvent(func(e streaming.Event) {
+		calls = append(calls, "event")
+	})
+	s.OnEventType(streaming.Comment, func(e streaming.Event) {
+		calls = append(calls, "comment")
+	})
+	s.OnTag("cats", func(e streaming.Event) {
+		calls = append(calls, "cats")
+	})
+	s.OnTag("dogs", func(e streaming.Event) {
+		calls = append(calls, "dogs")
+	})
+	s.OnTag("end", func(e streaming.Event) {
+		go s.Shutdown()
+	})
+
+	assert.NoError(t, s.Run())
+
+	assert.Equal(t, []string{
+		"event", "cats", "dogs",
+		"event", "comment", "cats",
+		"event",
+	}, calls)
+	assert.Equal(t, map[string]int64{"cats": 2, "dogs": 1, "end": 1}, s.TagCounts())
+}